@@ -120,6 +120,9 @@ func (s *SQL) SearchAccount(req *paccount.SearchRequest) ([]*account.Account, er
 	if len(req.RoleId) > 0 {
 		query = query.Where("role_id = ?", req.RoleId)
 	}
+	if len(req.Uuids) > 0 {
+		query = query.Where("uuid IN (?)", req.Uuids)
+	}
 	err := query.Find(&result).Error
 
 	if err != nil {
@@ -414,6 +414,8 @@ type SearchRequest struct {
 	Name        string                     `protobuf:"bytes,7,opt,name=name" json:"name,omitempty"`
 	IsPublic    *google_protobuf.BoolValue `protobuf:"bytes,8,opt,name=is_public,json=isPublic" json:"is_public,omitempty"`
 	RoleId      string                     `protobuf:"bytes,9,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	// only the accounts with these uuids
+	Uuids []string `protobuf:"bytes,10,rep,name=uuids" json:"uuids,omitempty"`
 }
 
 func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
@@ -484,6 +486,13 @@ func (m *SearchRequest) GetRoleId() string {
 	return ""
 }
 
+func (m *SearchRequest) GetUuids() []string {
+	if m != nil {
+		return m.Uuids
+	}
+	return nil
+}
+
 type SearchResponse struct {
 	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
 }
@@ -660,55 +669,55 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 791 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x4e, 0xdb, 0x48,
-	0x14, 0xc6, 0xc4, 0x71, 0xe2, 0x93, 0x38, 0x8b, 0x46, 0x68, 0xb1, 0xb2, 0x62, 0x15, 0x0c, 0xbb,
-	0xa5, 0x95, 0x70, 0x54, 0x90, 0x5a, 0xa9, 0xaa, 0xaa, 0x02, 0xa5, 0x28, 0xad, 0x54, 0x21, 0xa7,
-	0xe1, 0xa2, 0x5c, 0x44, 0x8e, 0x3d, 0x01, 0xab, 0x4e, 0xec, 0x7a, 0xec, 0x00, 0x6f, 0xd2, 0xa7,
-	0xa8, 0x7a, 0xdd, 0xa7, 0xab, 0xe6, 0x2f, 0xc4, 0x29, 0x71, 0x90, 0xda, 0x2b, 0x7b, 0xce, 0x9c,
-	0xf3, 0xcd, 0xf9, 0xf9, 0xce, 0x07, 0xaf, 0x2e, 0x83, 0xf4, 0x2a, 0x1b, 0xd8, 0x5e, 0x34, 0x6a,
-	0xdf, 0x8c, 0xbc, 0x3d, 0x1f, 0x4f, 0xe8, 0xb7, 0xed, 0x7a, 0x5e, 0x94, 0x8d, 0xd3, 0x3d, 0x92,
-	0x4c, 0xda, 0x71, 0x12, 0xa5, 0x91, 0xb4, 0xc8, 0xaf, 0xcd, 0xac, 0x68, 0xe3, 0x66, 0xe4, 0xd9,
-	0x24, 0x99, 0xd8, 0xd2, 0x2c, 0xbe, 0xcd, 0x7f, 0x2f, 0xa3, 0xe8, 0x32, 0xc4, 0x3c, 0x78, 0x90,
-	0x0d, 0xdb, 0xd7, 0x89, 0x1b, 0xc7, 0x38, 0x21, 0x3c, 0xd0, 0x7a, 0x0d, 0xfa, 0xc7, 0xdb, 0x18,
-	0x9f, 0xbb, 0x61, 0x86, 0xd1, 0x01, 0x94, 0x27, 0xf4, 0xc7, 0x54, 0x5a, 0xca, 0x6e, 0x63, 0x7f,
-	0xd3, 0x5e, 0x80, 0x6a, 0xd3, 0x10, 0x87, 0xfb, 0x5a, 0x5f, 0x4b, 0x50, 0x39, 0xe4, 0x76, 0x84,
-	0x40, 0xcd, 0xb2, 0xc0, 0x67, 0xf1, 0xba, 0xc3, 0xfe, 0xd1, 0x53, 0x50, 0xd3, 0xdb, 0x18, 0x9b,
-	0xab, 0x0f, 0xc1, 0x64, 0xae, 0xe8, 0x1f, 0xd0, 0xbd, 0x30, 0xc0, 0xe3, 0xb4, 0x1f, 0xf8, 0x66,
-	0x89, 0x61, 0x55, 0xb9, 0xa1, 0xe3, 0xa3, 0x6d, 0x30, 0xc4, 0x25, 0xc1, 0x5e, 0x82, 0x53, 0x53,
-	0x65, 0x0e, 0x75, 0x6e, 0xec, 0x32, 0x1b, 0x4d, 0x64, 0xec, 0x8e, 0xb0, 0x59, 0xe3, 0x89, 0xd0,
-	0x7f, 0xb4, 0x09, 0x10, 0x5d, 0x8f, 0x71, 0xd2, 0x67, 0x29, 0x56, 0xd9, 0x8d, 0xce, 0x2c, 0x3d,
-	0x9a, 0xe7, 0x16, 0xd4, 0x3d, 0x37, 0x0c, 0x07, 0xae, 0xf7, 0xb9, 0x9f, 0x25, 0xa1, 0xa9, 0x33,
-	0x87, 0x9a, 0xb4, 0xf5, 0x92, 0x10, 0xed, 0x40, 0x23, 0x20, 0xfd, 0x61, 0x90, 0x90, 0xb4, 0x1f,
-	0xbb, 0x49, 0x7a, 0x6b, 0x42, 0x4b, 0xd9, 0xad, 0x3a, 0xf5, 0x80, 0xbc, 0xa5, 0xc6, 0x33, 0x6a,
-	0xa3, 0xd9, 0x07, 0xa4, 0x1f, 0x67, 0x83, 0x30, 0xf0, 0x4c, 0x83, 0x39, 0x54, 0x03, 0x72, 0xc6,
-	0xce, 0x68, 0x1d, 0xca, 0xc4, 0x8b, 0x62, 0x6c, 0xd6, 0x19, 0x3c, 0x3f, 0xd0, 0xd4, 0xbc, 0x04,
-	0xbb, 0x29, 0xf6, 0xfb, 0x6e, 0x6a, 0x6a, 0x2d, 0x65, 0xb7, 0xe4, 0xe8, 0xc2, 0x72, 0x98, 0xd2,
-	0xeb, 0x2c, 0xf6, 0xe5, 0x75, 0x85, 0x5f, 0x0b, 0xcb, 0x61, 0x8a, 0x36, 0xa0, 0x92, 0x44, 0x21,
-	0xa6, 0xcd, 0x6a, 0x30, 0x54, 0x8d, 0x1e, 0x3b, 0xbe, 0xf5, 0x1e, 0x8c, 0x63, 0x06, 0xe2, 0xe0,
-	0x2f, 0x19, 0x26, 0x29, 0x7a, 0x01, 0x15, 0xd1, 0x6e, 0x36, 0xa2, 0xda, 0x7e, 0x6b, 0xe1, 0x38,
-	0xc4, 0x48, 0x1d, 0x19, 0x60, 0x0d, 0xa1, 0x21, 0xc1, 0x48, 0x1c, 0x8d, 0xc9, 0xdc, 0x98, 0x94,
-	0x65, 0x63, 0x5a, 0xbd, 0x7f, 0x4c, 0x6c, 0x18, 0xa5, 0x3b, 0xbe, 0x58, 0x5b, 0x50, 0x73, 0xb0,
-	0xeb, 0xcb, 0x94, 0xef, 0xa1, 0x94, 0xf5, 0x0e, 0xea, 0xdc, 0x45, 0x24, 0xf2, 0x3b, 0x65, 0x3d,
-	0x06, 0x38, 0xc5, 0xa9, 0x7c, 0xad, 0xa8, 0x24, 0xab, 0x03, 0x35, 0xe6, 0xfa, 0x07, 0x5e, 0xfd,
-	0xa6, 0x80, 0xd1, 0x63, 0x03, 0x2c, 0xa8, 0xf3, 0x61, 0x3d, 0x9c, 0xe7, 0x6d, 0xe9, 0x57, 0xde,
-	0xca, 0x6d, 0x50, 0x67, 0xb6, 0x61, 0x4a, 0xc4, 0xf2, 0x2c, 0x11, 0x67, 0xa8, 0xa4, 0xe5, 0xa8,
-	0xb4, 0x06, 0x0d, 0x99, 0x2f, 0x2f, 0xdf, 0xda, 0x06, 0xe3, 0x0d, 0x0e, 0x71, 0x61, 0x05, 0x34,
-	0x4c, 0x3a, 0x89, 0xb0, 0x1f, 0xab, 0x60, 0x74, 0xb1, 0x9b, 0x78, 0x57, 0x32, 0x6e, 0x1d, 0xca,
-	0x61, 0x30, 0x0a, 0x78, 0x17, 0x0d, 0x87, 0x1f, 0xd0, 0xdf, 0xa0, 0x45, 0xc3, 0x21, 0x11, 0x45,
-	0x1b, 0x8e, 0x38, 0x15, 0x6b, 0xc3, 0x33, 0xa1, 0x35, 0x2a, 0x9b, 0x87, 0x55, 0xa8, 0x35, 0x4c,
-	0xf2, 0x84, 0xe0, 0xe4, 0xa5, 0xa1, 0xbc, 0x4c, 0x1a, 0xb4, 0xc5, 0x2d, 0xae, 0xcc, 0xb4, 0xf8,
-	0xf9, 0xac, 0x10, 0x54, 0x59, 0x4a, 0x4d, 0x9b, 0xeb, 0xb1, 0x2d, 0xf5, 0xd8, 0x3e, 0x8a, 0xa2,
-	0x90, 0xa7, 0x72, 0x27, 0x12, 0x33, 0x53, 0xd0, 0x73, 0x53, 0xf8, 0x00, 0x0d, 0xd9, 0x3b, 0x41,
-	0xc2, 0x97, 0x50, 0x15, 0x45, 0x11, 0x53, 0x69, 0x95, 0x1e, 0xc4, 0xc2, 0x69, 0xc4, 0x93, 0x4d,
-	0x50, 0x69, 0x2b, 0x50, 0x15, 0xd4, 0x5e, 0xf7, 0xc4, 0x59, 0x5b, 0x41, 0x35, 0xa8, 0x74, 0x4f,
-	0x9c, 0xf3, 0xce, 0xf1, 0xc9, 0x9a, 0xb2, 0xff, 0x5d, 0x85, 0xbf, 0x44, 0x10, 0xe9, 0xe2, 0x64,
-	0x12, 0x78, 0x18, 0x5d, 0x80, 0xc6, 0x65, 0x00, 0xfd, 0xbf, 0xf0, 0xa1, 0x9c, 0xe8, 0x34, 0x1f,
-	0x2d, 0xf5, 0x13, 0xd4, 0x58, 0x41, 0x3d, 0x50, 0xe9, 0x62, 0xa3, 0x9d, 0x85, 0x21, 0x33, 0xd2,
-	0xd0, 0xfc, 0x6f, 0x89, 0xd7, 0x14, 0xd6, 0x81, 0xd2, 0x29, 0x4e, 0xd1, 0xf6, 0x42, 0xff, 0x3b,
-	0x05, 0x68, 0xee, 0x14, 0x3b, 0x4d, 0x31, 0x2f, 0x40, 0xe3, 0x0b, 0x51, 0xd0, 0x87, 0xdc, 0x86,
-	0x17, 0xf4, 0x61, 0x6e, 0xb3, 0x18, 0x38, 0x5f, 0x9b, 0x02, 0xf0, 0xdc, 0xf2, 0x15, 0x80, 0xcf,
-	0xed, 0x1f, 0x03, 0xe7, 0x24, 0x2a, 0x00, 0xcf, 0x6d, 0x68, 0x01, 0x78, 0x9e, 0x8d, 0xd6, 0xca,
-	0x91, 0xfe, 0x49, 0x6a, 0xdc, 0x40, 0x63, 0x1c, 0x3f, 0xf8, 0x19, 0x00, 0x00, 0xff, 0xff, 0x8c,
-	0xde, 0x0c, 0xaa, 0xdc, 0x08, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xed, 0x4e, 0xdb, 0x56,
+	0x18, 0xc6, 0xc4, 0x71, 0xe2, 0x37, 0x71, 0x86, 0x8e, 0xd0, 0xb0, 0x3c, 0x31, 0x05, 0xc3, 0x16,
+	0x36, 0x09, 0x47, 0x03, 0x69, 0x93, 0xa6, 0x69, 0x1a, 0x30, 0x8a, 0xd2, 0x4a, 0x15, 0x72, 0x1a,
+	0x7e, 0x94, 0x1f, 0x91, 0x63, 0x9f, 0x80, 0x55, 0x27, 0x76, 0x7d, 0xec, 0x00, 0x77, 0xd2, 0xab,
+	0xa8, 0x7a, 0x29, 0xbd, 0xa4, 0xea, 0x7c, 0x05, 0x87, 0x12, 0x07, 0xa9, 0xfd, 0xe5, 0x73, 0xde,
+	0xf3, 0xbc, 0x8f, 0xdf, 0xcf, 0x07, 0xfe, 0xbd, 0x0e, 0xb3, 0x9b, 0x7c, 0xe4, 0xf8, 0xf1, 0xa4,
+	0x7b, 0x37, 0xf1, 0x0f, 0x02, 0x3c, 0xa3, 0xdf, 0xae, 0xe7, 0xfb, 0x71, 0x3e, 0xcd, 0x0e, 0x48,
+	0x3a, 0xeb, 0x26, 0x69, 0x9c, 0xc5, 0xd2, 0x22, 0xbf, 0x0e, 0xb3, 0xa2, 0xad, 0xbb, 0x89, 0xef,
+	0x90, 0x74, 0xe6, 0x48, 0xb3, 0xf8, 0x5a, 0x3f, 0x5f, 0xc7, 0xf1, 0x75, 0x84, 0xb9, 0xf3, 0x28,
+	0x1f, 0x77, 0x6f, 0x53, 0x2f, 0x49, 0x70, 0x4a, 0xb8, 0xa3, 0xfd, 0x1f, 0xe8, 0x6f, 0xee, 0x13,
+	0x7c, 0xe9, 0x45, 0x39, 0x46, 0x47, 0x50, 0x9d, 0xd1, 0x83, 0xa9, 0xb4, 0x95, 0xfd, 0xd6, 0xe1,
+	0xb6, 0xb3, 0x84, 0xd5, 0xa1, 0x2e, 0x2e, 0xc7, 0xda, 0x1f, 0x2a, 0x50, 0x3b, 0xe6, 0x76, 0x84,
+	0x40, 0xcd, 0xf3, 0x30, 0x60, 0xfe, 0xba, 0xcb, 0xce, 0xe8, 0x0f, 0x50, 0xb3, 0xfb, 0x04, 0x9b,
+	0xeb, 0xcf, 0xe1, 0x64, 0x50, 0xf4, 0x13, 0xe8, 0x7e, 0x14, 0xe2, 0x69, 0x36, 0x0c, 0x03, 0xb3,
+	0xc2, 0xb8, 0xea, 0xdc, 0xd0, 0x0b, 0xd0, 0x2e, 0x18, 0xe2, 0x91, 0x60, 0x3f, 0xc5, 0x99, 0xa9,
+	0x32, 0x40, 0x93, 0x1b, 0xfb, 0xcc, 0x46, 0x03, 0x99, 0x7a, 0x13, 0x6c, 0x36, 0x78, 0x20, 0xf4,
+	0x8c, 0xb6, 0x01, 0xe2, 0xdb, 0x29, 0x4e, 0x87, 0x2c, 0xc4, 0x3a, 0x7b, 0xd1, 0x99, 0x65, 0x40,
+	0xe3, 0xdc, 0x81, 0xa6, 0xef, 0x45, 0xd1, 0xc8, 0xf3, 0xdf, 0x0d, 0xf3, 0x34, 0x32, 0x75, 0x06,
+	0x68, 0x48, 0xdb, 0x20, 0x8d, 0xd0, 0x1e, 0xb4, 0x42, 0x32, 0x1c, 0x87, 0x29, 0xc9, 0x86, 0x89,
+	0x97, 0x66, 0xf7, 0x26, 0xb4, 0x95, 0xfd, 0xba, 0xdb, 0x0c, 0xc9, 0x0b, 0x6a, 0xbc, 0xa0, 0x36,
+	0x1a, 0x7d, 0x48, 0x86, 0x49, 0x3e, 0x8a, 0x42, 0xdf, 0x34, 0x18, 0xa0, 0x1e, 0x92, 0x0b, 0x76,
+	0x47, 0x9b, 0x50, 0x25, 0x7e, 0x9c, 0x60, 0xb3, 0xc9, 0xe8, 0xf9, 0x85, 0x86, 0xe6, 0xa7, 0xd8,
+	0xcb, 0x70, 0x30, 0xf4, 0x32, 0x53, 0x6b, 0x2b, 0xfb, 0x15, 0x57, 0x17, 0x96, 0xe3, 0x8c, 0x3e,
+	0xe7, 0x49, 0x20, 0x9f, 0x6b, 0xfc, 0x59, 0x58, 0x8e, 0x33, 0xb4, 0x05, 0xb5, 0x34, 0x8e, 0x30,
+	0x2d, 0x56, 0x8b, 0xb1, 0x6a, 0xf4, 0xda, 0x0b, 0xec, 0x57, 0x60, 0x9c, 0x32, 0x12, 0x17, 0xbf,
+	0xcf, 0x31, 0xc9, 0xd0, 0xdf, 0x50, 0x13, 0xe5, 0x66, 0x2d, 0x6a, 0x1c, 0xb6, 0x97, 0xb6, 0x43,
+	0xb4, 0xd4, 0x95, 0x0e, 0xf6, 0x18, 0x5a, 0x92, 0x8c, 0x24, 0xf1, 0x94, 0x3c, 0x6a, 0x93, 0xb2,
+	0xaa, 0x4d, 0xeb, 0x4f, 0xb7, 0x29, 0xcf, 0xe7, 0x3d, 0x66, 0x67, 0x7b, 0x07, 0x1a, 0x2e, 0xf6,
+	0x02, 0x19, 0xf2, 0x13, 0x23, 0x65, 0xbf, 0x84, 0x26, 0x87, 0x88, 0x40, 0xbe, 0x25, 0xad, 0xdf,
+	0x00, 0xce, 0x71, 0x26, 0xff, 0x56, 0x96, 0x92, 0xdd, 0x83, 0x06, 0x83, 0x7e, 0x87, 0xbf, 0x7e,
+	0x54, 0xc0, 0x18, 0xb0, 0x06, 0x96, 0xe4, 0xf9, 0xbc, 0x1a, 0x3e, 0x9e, 0xdb, 0xca, 0xd7, 0x73,
+	0x2b, 0xb7, 0x41, 0x2d, 0x6c, 0xc3, 0x7c, 0x10, 0xab, 0xc5, 0x41, 0x2c, 0x8c, 0x92, 0xb6, 0x30,
+	0x4a, 0x1b, 0xd0, 0x92, 0xf1, 0xf2, 0xf4, 0xed, 0x5d, 0x30, 0xfe, 0xc7, 0x11, 0x2e, 0xcd, 0x80,
+	0xba, 0x49, 0x90, 0x70, 0xfb, 0xbc, 0x0e, 0x46, 0x1f, 0x7b, 0xa9, 0x7f, 0x23, 0xfd, 0x36, 0xa1,
+	0x1a, 0x85, 0x93, 0x90, 0x57, 0xd1, 0x70, 0xf9, 0x05, 0xfd, 0x08, 0x5a, 0x3c, 0x1e, 0x13, 0x91,
+	0xb4, 0xe1, 0x8a, 0x5b, 0xb9, 0x36, 0xfc, 0x29, 0xb4, 0x46, 0x65, 0xfd, 0xb0, 0x4b, 0xb5, 0x86,
+	0x49, 0x9e, 0x10, 0x9c, 0x45, 0x69, 0xa8, 0xae, 0x92, 0x06, 0x6d, 0x79, 0x89, 0x6b, 0x85, 0x12,
+	0xff, 0x55, 0x14, 0x82, 0x3a, 0x0b, 0xc9, 0x72, 0xb8, 0x1e, 0x3b, 0x52, 0x8f, 0x9d, 0x93, 0x38,
+	0x8e, 0x78, 0x28, 0x0f, 0x22, 0x51, 0xe8, 0x82, 0x5e, 0xec, 0x02, 0x2d, 0x15, 0x8d, 0x90, 0x98,
+	0xd0, 0xae, 0xd0, 0xa6, 0xb1, 0x8b, 0xfd, 0x1a, 0x5a, 0xb2, 0xa2, 0x62, 0x34, 0xff, 0x81, 0xba,
+	0x48, 0x95, 0x98, 0x4a, 0xbb, 0xf2, 0xac, 0xd9, 0x9c, 0x7b, 0xfc, 0xbe, 0x0d, 0x2a, 0x2d, 0x10,
+	0xaa, 0x83, 0x3a, 0xe8, 0x9f, 0xb9, 0x1b, 0x6b, 0xa8, 0x01, 0xb5, 0xfe, 0x99, 0x7b, 0xd9, 0x3b,
+	0x3d, 0xdb, 0x50, 0x0e, 0x3f, 0xa9, 0xf0, 0x83, 0x70, 0x22, 0x7d, 0x9c, 0xce, 0x42, 0x1f, 0xa3,
+	0x2b, 0xd0, 0xb8, 0x38, 0xa0, 0x5f, 0x97, 0xfe, 0x68, 0x41, 0x8a, 0xac, 0xce, 0x4a, 0x9c, 0x18,
+	0x98, 0x35, 0x34, 0x00, 0x95, 0xae, 0x3b, 0xda, 0x5b, 0xea, 0x52, 0x10, 0x0c, 0xeb, 0x97, 0x15,
+	0xa8, 0x39, 0xad, 0x0b, 0x95, 0x73, 0x9c, 0xa1, 0xdd, 0xa5, 0xf8, 0x07, 0x5d, 0xb0, 0xf6, 0xca,
+	0x41, 0x73, 0xce, 0x2b, 0xd0, 0xf8, 0x9a, 0x94, 0xd4, 0x61, 0x61, 0xef, 0xad, 0xce, 0x4a, 0x5c,
+	0x91, 0x9c, 0x2f, 0x53, 0x09, 0xf9, 0xc2, 0x4a, 0x5a, 0x9d, 0x95, 0xb8, 0x22, 0x39, 0x1f, 0xa2,
+	0x12, 0xf2, 0x85, 0xbd, 0xb5, 0x3a, 0x2b, 0x71, 0x92, 0xfc, 0x44, 0x7f, 0x2b, 0x95, 0x6f, 0xa4,
+	0xb1, 0xc9, 0x3f, 0xfa, 0x32, 0x00, 0x7c, 0xa9, 0x5a, 0xad, 0xf2, 0x08, 0x00, 0x00,
 }
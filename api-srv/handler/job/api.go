@@ -0,0 +1,115 @@
+package job
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/micro/go-micro/client"
+	merrors "github.com/micro/go-micro/errors"
+	"github.com/micro/protobuf/ptypes"
+	"github.com/pkg/errors"
+	e "github.com/xmc-dev/xmc/api-srv/errors"
+	"github.com/xmc-dev/xmc/api-srv/handler"
+	"github.com/xmc-dev/xmc/api-srv/util"
+	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
+)
+
+// Handler is the job API handler. It is meant for the administrators.
+type Handler struct {
+	r *gin.RouterGroup
+}
+
+var cl = job.NewJobsServiceClient("xmc.srv.dispatcher", client.DefaultClient)
+
+func (h *Handler) SetRouter(r *gin.RouterGroup) {
+	h.r = r
+	h.r.GET("/", h.queryEndpoint)
+	h.r.GET("/:uuid", h.readEndpoint)
+}
+
+func timestampRange(begin, end time.Time) *tsrange.TimestampRange {
+	if begin.IsZero() && end.IsZero() {
+		return nil
+	}
+	beginP, _ := ptypes.TimestampProto(begin)
+	endP, _ := ptypes.TimestampProto(end)
+
+	return &tsrange.TimestampRange{Begin: beginP, End: endP}
+}
+
+func (h *Handler) queryEndpoint(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("perPage"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	state := c.Query("state")
+	order := c.Query("order")
+	createdAtBegin, _ := time.Parse(time.RFC3339, c.Query("createdAtBegin"))
+	createdAtEnd, _ := time.Parse(time.RFC3339, c.Query("createdAtEnd"))
+	finishedAtBegin, _ := time.Parse(time.RFC3339, c.Query("finishedAtBegin"))
+	finishedAtEnd, _ := time.Parse(time.RFC3339, c.Query("finishedAtEnd"))
+
+	var stateValue *job.StateValue
+	if len(state) != 0 {
+		val, ok := job.State_value[strings.ToUpper(state)]
+		if !ok {
+			e.BadRequest(c)
+			return
+		}
+		stateValue = &job.StateValue{
+			Value: job.State(val),
+		}
+	}
+	var orderValue job.Order
+	if len(order) != 0 {
+		val, ok := job.Order_value[strings.ToUpper(order)]
+		if !ok {
+			e.BadRequest(c)
+			return
+		}
+		orderValue = job.Order(val)
+	}
+
+	req := &job.SearchRequest{
+		Limit:        uint64(limit),
+		Offset:       uint64(offset),
+		TaskId:       c.Query("taskId"),
+		DatasetId:    c.Query("datasetId"),
+		Language:     c.Query("language"),
+		EvalId:       c.Query("evalId"),
+		SubmissionId: c.Query("submissionId"),
+		UserId:       c.Query("userId"),
+		State:        stateValue,
+		CreatedAt:    timestampRange(createdAtBegin, createdAtEnd),
+		FinishedAt:   timestampRange(finishedAtBegin, finishedAtEnd),
+		Order:        orderValue,
+	}
+	rsp, err := cl.Search(handler.C(c), req)
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't read jobs"))
+		return
+	}
+	ms := []json.RawMessage{}
+	for _, j := range rsp.Jobs {
+		ms = append(ms, util.Marshal(j))
+	}
+	meta := util.Marshal(rsp.Meta)
+	c.JSON(http.StatusOK, gin.H{
+		"meta": meta,
+		"jobs": ms,
+	})
+}
+
+func (h *Handler) readEndpoint(c *gin.Context) {
+	rsp, err := cl.Read(handler.C(c), &job.ReadRequest{Uuid: c.Param("uuid")})
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't read job"))
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"job": util.Marshal(rsp.Job)})
+}
@@ -0,0 +1,43 @@
+package language
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/micro/go-micro/client"
+	merrors "github.com/micro/go-micro/errors"
+	"github.com/pkg/errors"
+	e "github.com/xmc-dev/xmc/api-srv/errors"
+	"github.com/xmc-dev/xmc/api-srv/handler"
+	"github.com/xmc-dev/xmc/api-srv/util"
+	"github.com/xmc-dev/xmc/xmc-core/proto/language"
+)
+
+// Handler is the language API handler
+type Handler struct {
+	r *gin.RouterGroup
+}
+
+var cl = language.NewLanguageServiceClient("xmc.srv.core", client.DefaultClient)
+
+func (h *Handler) SetRouter(r *gin.RouterGroup) {
+	h.r = r
+	h.r.GET("/", h.listEndpoint)
+}
+
+func (h *Handler) listEndpoint(c *gin.Context) {
+	rsp, err := cl.List(handler.C(c), &language.ListRequest{})
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't read languages"))
+		return
+	}
+	ml := []json.RawMessage{}
+	for _, l := range rsp.Languages {
+		ml = append(ml, util.Marshal(l))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"languages": ml,
+	})
+}
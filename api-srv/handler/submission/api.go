@@ -17,6 +17,7 @@ import (
 	e "github.com/xmc-dev/xmc/api-srv/errors"
 	"github.com/xmc-dev/xmc/api-srv/handler"
 	"github.com/xmc-dev/xmc/api-srv/util"
+	"github.com/xmc-dev/xmc/xmc-core/proto/result"
 	"github.com/xmc-dev/xmc/xmc-core/proto/submission"
 	"github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
 )
@@ -42,9 +43,11 @@ func (h *Handler) queryEndpoint(c *gin.Context) {
 	offset, _ := strconv.Atoi(c.Query("offset"))
 	taskID := c.Query("taskId")
 	datasetID := c.Query("datasetId")
+	taskListID := c.Query("taskListId")
 	userID := c.Query("userId")
 	evalID := c.Query("evalId")
 	state := c.Query("state")
+	verdict := c.Query("verdict")
 	language := c.Query("language")
 	createdAtBegin, _ := time.Parse(time.RFC3339, c.Query("createdAtBegin"))
 	createdAtEnd, _ := time.Parse(time.RFC3339, c.Query("createdAtEnd"))
@@ -57,6 +60,7 @@ func (h *Handler) queryEndpoint(c *gin.Context) {
 
 	var createdAt, finishedAt *tsrange.TimestampRange
 	var stateValue *submission.StateValue
+	var verdictValue *result.VerdictValue
 
 	if len(state) != 0 {
 		val, ok := submission.State_value[strings.ToUpper(state)]
@@ -66,6 +70,14 @@ func (h *Handler) queryEndpoint(c *gin.Context) {
 			}
 		}
 	}
+	if len(verdict) != 0 {
+		val, ok := result.Verdict_value[strings.ToUpper(verdict)]
+		if ok {
+			verdictValue = &result.VerdictValue{
+				Value: result.Verdict(val),
+			}
+		}
+	}
 	if !createdAtBegin.IsZero() || !createdAtEnd.IsZero() {
 		beginP, _ := ptypes.TimestampProto(createdAtBegin)
 		endP, _ := ptypes.TimestampProto(createdAtEnd)
@@ -82,9 +94,11 @@ func (h *Handler) queryEndpoint(c *gin.Context) {
 		Offset:             uint32(offset),
 		TaskId:             taskID,
 		DatasetId:          datasetID,
+		TaskListId:         taskListID,
 		UserId:             userID,
 		EvalId:             evalID,
 		State:              stateValue,
+		Verdict:            verdictValue,
 		Language:           language,
 		CreatedAt:          createdAt,
 		FinishedAt:         finishedAt,
@@ -59,6 +59,10 @@ func (h *Handler) SetRouter(r *gin.RouterGroup) {
 	h.r.GET("/:id/participate", h.participateEndpoint)
 	h.r.GET("/:id/cancelparticipation", h.cancelParticipationEndpoint)
 	h.r.GET("/:id/participants", h.participantsEndpoint)
+	h.r.GET("/:id/startparticipation", h.startParticipationEndpoint)
+	h.r.GET("/:id/participation", h.participationEndpoint)
+	h.r.GET("/:id/scoreboard", h.scoreboardEndpoint)
+	h.r.POST("/:id/scoreboard/reveal", h.revealScoreboardEndpoint)
 }
 
 func (h *Handler) createEndpoint(c *gin.Context) {
@@ -209,6 +213,32 @@ func (*Handler) cancelParticipationEndpoint(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{})
 }
 
+func (*Handler) startParticipationEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	rsp, err := cl.StartParticipation(handler.C(c), &tasklist.StartParticipationRequest{
+		TaskListId: id,
+	})
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't start task list participation"))
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"participation": util.Marshal(rsp.Participation)})
+}
+
+func (*Handler) participationEndpoint(c *gin.Context) {
+	id := c.Param("id")
+	rsp, err := cl.ReadParticipation(handler.C(c), &tasklist.ReadParticipationRequest{
+		TaskListId: id,
+	})
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't read task list participation"))
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"participation": util.Marshal(rsp.Participation)})
+}
+
 func (*Handler) participantsEndpoint(c *gin.Context) {
 	id := c.Param("id")
 	rsp, err := cl.GetParticipants(handler.C(c), &tasklist.GetParticipantsRequest{TaskListId: id})
@@ -219,3 +249,70 @@ func (*Handler) participantsEndpoint(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, util.Marshal(rsp))
 }
+
+func (*Handler) scoreboardEndpoint(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("perPage"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	t, err := getTaskList(handler.C(c), c.Param("id"))
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't read task list"))
+		return
+	}
+	rsp, err := cl.GetScoreboard(handler.C(c), &tasklist.GetScoreboardRequest{
+		TaskListId: t.Id,
+		Limit:      uint32(limit),
+		Offset:     uint32(offset),
+	})
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't get task list scoreboard"))
+		return
+	}
+	tasks := []json.RawMessage{}
+	for _, t := range rsp.Tasks {
+		tasks = append(tasks, util.Marshal(t))
+	}
+	entries := []json.RawMessage{}
+	for _, en := range rsp.Entries {
+		entries = append(entries, util.Marshal(en))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"meta":    util.Marshal(rsp.Meta),
+		"tasks":   tasks,
+		"entries": entries,
+		"frozen":  rsp.Frozen,
+	})
+}
+
+func (*Handler) revealScoreboardEndpoint(c *gin.Context) {
+	buf, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		e.BadRequest(c)
+		return
+	}
+	req := &tasklist.RevealScoreboardRequest{}
+	if len(buf) > 0 {
+		err = util.Unmarshal(string(buf), req)
+		if err != nil {
+			e.BadRequest(c)
+			return
+		}
+	}
+
+	t, err := getTaskList(handler.C(c), c.Param("id"))
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't read task list"))
+		return
+	}
+	req.TaskListId = t.Id
+	rsp, err := cl.RevealScoreboard(handler.C(c), req)
+	if err != nil {
+		me := merrors.Parse(err.Error())
+		e.Error(c, me, errors.Wrap(me, "couldn't reveal task list scoreboard"))
+		return
+	}
+	c.JSON(http.StatusOK, util.Marshal(rsp))
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/xmc-dev/xmc/api-srv/handler/attachment"
 	"github.com/xmc-dev/xmc/api-srv/handler/dataset"
 	"github.com/xmc-dev/xmc/api-srv/handler/grader"
+	"github.com/xmc-dev/xmc/api-srv/handler/job"
+	"github.com/xmc-dev/xmc/api-srv/handler/language"
 	"github.com/xmc-dev/xmc/api-srv/handler/page"
 	"github.com/xmc-dev/xmc/api-srv/handler/role"
 	"github.com/xmc-dev/xmc/api-srv/handler/submission"
@@ -59,6 +61,8 @@ func main() {
 	register("/accounts", &account.Handler{})
 	register("/tasklists", &tasklist.Handler{})
 	register("/roles", &role.Handler{})
+	register("/languages", &language.Handler{})
+	register("/jobs", &job.Handler{})
 
 	if err := srv.Web.Run(); err != nil {
 		log.Fatal("Couldn't run service: ", err)
@@ -0,0 +1,96 @@
+// Package metrics serves the Prometheus metrics of the services and reads back
+// the values of the metrics reported by their RPCs.
+package metrics
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "metrics")
+
+// DurationBuckets are histogram buckets, in seconds, fit for the durations of jobs
+var DurationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600}
+
+// Bucket is a bucket of a histogram snapshot
+type Bucket struct {
+	UpperBound float64
+	// Count is the number of observations less than or equal to UpperBound
+	Count uint64
+}
+
+// HistogramSnapshot holds the state of a histogram at some moment
+type HistogramSnapshot struct {
+	Count   uint64
+	Sum     float64
+	Buckets []Bucket
+}
+
+// collect returns the metrics collected from c
+func collect(c prometheus.Collector) []*dto.Metric {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+	ms := []*dto.Metric{}
+	for m := range ch {
+		pm := &dto.Metric{}
+		if err := m.Write(pm); err != nil {
+			log.WithError(err).Error("Couldn't read metric")
+			continue
+		}
+		ms = append(ms, pm)
+	}
+
+	return ms
+}
+
+// Sum returns the sum of the values of the counters and gauges collected from c,
+// like all the label values of a CounterVec
+func Sum(c prometheus.Collector) float64 {
+	sum := 0.0
+	for _, m := range collect(c) {
+		switch {
+		case m.Counter != nil:
+			sum += m.Counter.GetValue()
+		case m.Gauge != nil:
+			sum += m.Gauge.GetValue()
+		}
+	}
+
+	return sum
+}
+
+// Snapshot returns the state of a histogram, including the +Inf bucket
+func Snapshot(h prometheus.Histogram) HistogramSnapshot {
+	hs := HistogramSnapshot{}
+	ms := collect(h)
+	if len(ms) == 0 || ms[0].Histogram == nil {
+		return hs
+	}
+	ph := ms[0].Histogram
+	hs.Count = ph.GetSampleCount()
+	hs.Sum = ph.GetSampleSum()
+	for _, b := range ph.Bucket {
+		hs.Buckets = append(hs.Buckets, Bucket{UpperBound: b.GetUpperBound(), Count: b.GetCumulativeCount()})
+	}
+	hs.Buckets = append(hs.Buckets, Bucket{UpperBound: math.Inf(1), Count: hs.Count})
+
+	return hs
+}
+
+// Serve serves the metrics at /metrics on the given address. It doesn't return unless the server fails.
+func Serve(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	log.WithField("addr", addr).Info("Serving metrics")
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.WithError(err).Error("Couldn't serve metrics")
+	}
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestSum(t *testing.T) {
+	c := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_total", Help: "Test."}, []string{"kind"})
+	if s := Sum(c); s != 0 {
+		t.Errorf("Expected empty sum 0, got %v", s)
+	}
+	c.WithLabelValues("a").Add(2)
+	c.WithLabelValues("b").Inc()
+	if s := Sum(c); s != 3 {
+		t.Errorf("Expected sum 3, got %v", s)
+	}
+	if s := Sum(c.WithLabelValues("a")); s != 2 {
+		t.Errorf("Expected value of a 2, got %v", s)
+	}
+
+	g := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test", Help: "Test."})
+	g.Set(0.5)
+	if s := Sum(g); s != 0.5 {
+		t.Errorf("Expected gauge 0.5, got %v", s)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	h := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_seconds",
+		Help:    "Test.",
+		Buckets: []float64{1, 5},
+	})
+	for _, v := range []float64{0.5, 1, 3, 10} {
+		h.Observe(v)
+	}
+
+	hs := Snapshot(h)
+	if hs.Count != 4 || hs.Sum != 14.5 {
+		t.Errorf("Expected count 4 and sum 14.5, got %d and %v", hs.Count, hs.Sum)
+	}
+	expected := []Bucket{
+		{UpperBound: 1, Count: 2},
+		{UpperBound: 5, Count: 3},
+		{UpperBound: math.Inf(1), Count: 4},
+	}
+	if len(hs.Buckets) != len(expected) {
+		t.Fatalf("Expected %d buckets, got %d", len(expected), len(hs.Buckets))
+	}
+	for i, b := range expected {
+		if hs.Buckets[i] != b {
+			t.Errorf("Expected bucket %d to be %v, got %v", i, b, hs.Buckets[i])
+		}
+	}
+}
@@ -11,7 +11,7 @@ import (
 
 func InitAuth() error {
 	return cred.InitAuth("xmc.srv.dispatcher", service.MainService.Consul.KV(), service.MainService.OAuth2Token,
-		"xmc.core/manage/submission xmc.eval/assign")
+		"xmc.core/manage/submission xmc.core/manage/attachment xmc.eval/assign")
 }
 
 func C() context.Context {
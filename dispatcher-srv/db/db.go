@@ -12,6 +12,7 @@ type DB interface {
 	Deinit() error
 	Job
 	QueueItem
+	Rejudge
 }
 
 var db DB
@@ -19,6 +20,9 @@ var srv *service.Service
 
 var ErrNotFound = errors.New("not found")
 
+// ErrLeaseLost is returned when a job is not leased anymore by an eval
+var ErrLeaseLost = errors.New("job lease lost")
+
 func Register(d DB) {
 	db = d
 }
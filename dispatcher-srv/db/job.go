@@ -1,22 +1,29 @@
 package db
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
 )
 
 type Job interface {
-	CreateJob(j *pjob.Job) (uuid.UUID, error)
+	CreateJob(j *pjob.Job, priority int32) (uuid.UUID, error)
 	IsFinished(uuid string) bool
 	ReadJob(uuid string) (*job.Job, error)
-	SearchJob(req *pjob.SearchRequest) ([]*job.Job, error)
-	FinishJob(req *pjob.FinishRequest) error
-	SetJobStateAndEvalID(jobUUID uuid.UUID, state job.State, evalID string) error
+	SearchJob(req *pjob.SearchRequest) ([]*job.Job, uint32, error)
+	FinishJob(req *pjob.FinishRequest, evalID string) error
+	ExtendLease(jobUUID uuid.UUID, evalID string, until time.Time) error
+	ExpiredJobs(now time.Time) ([]*job.Job, error)
+	RequeueJob(jobUUID uuid.UUID, now time.Time) error
+	FailJob(jobUUID uuid.UUID, now time.Time) error
+	UnfinishedJobs(submissionID string) ([]*job.Job, error)
+	CancelJob(jobUUID uuid.UUID) error
 }
 
-func CreateJob(j *pjob.Job) (uuid.UUID, error) {
-	return db.CreateJob(j)
+func CreateJob(j *pjob.Job, priority int32) (uuid.UUID, error) {
+	return db.CreateJob(j, priority)
 }
 
 func IsFinished(uuid string) bool {
@@ -27,14 +34,45 @@ func ReadJob(uuid string) (*job.Job, error) {
 	return db.ReadJob(uuid)
 }
 
-func SearchJob(req *pjob.SearchRequest) ([]*job.Job, error) {
+// SearchJob returns the jobs that match all the filters of the request, along with their total number
+func SearchJob(req *pjob.SearchRequest) ([]*job.Job, uint32, error) {
 	return db.SearchJob(req)
 }
 
-func FinishJob(req *pjob.FinishRequest) error {
-	return db.FinishJob(req)
+// FinishJob marks the job as done. It returns ErrLeaseLost if the job is not being processed by the eval.
+func FinishJob(req *pjob.FinishRequest, evalID string) error {
+	return db.FinishJob(req, evalID)
+}
+
+// ExtendLease extends the lease of a job. It returns ErrLeaseLost if the job is not being processed by the eval.
+func ExtendLease(jobUUID uuid.UUID, evalID string, until time.Time) error {
+	return db.ExtendLease(jobUUID, evalID, until)
+}
+
+// ExpiredJobs returns the jobs whose lease expired before now
+func ExpiredJobs(now time.Time) ([]*job.Job, error) {
+	return db.ExpiredJobs(now)
+}
+
+// RequeueJob puts a job with an expired lease back in the queue.
+// It returns ErrLeaseLost if the lease was extended or the job was finished in the meantime.
+func RequeueJob(jobUUID uuid.UUID, now time.Time) error {
+	return db.RequeueJob(jobUUID, now)
+}
+
+// FailJob marks a job with an expired lease as done.
+// It returns ErrLeaseLost if the lease was extended or the job was finished in the meantime.
+func FailJob(jobUUID uuid.UUID, now time.Time) error {
+	return db.FailJob(jobUUID, now)
+}
+
+// UnfinishedJobs returns the jobs of a submission that are waiting or being processed
+func UnfinishedJobs(submissionID string) ([]*job.Job, error) {
+	return db.UnfinishedJobs(submissionID)
 }
 
-func SetJobStateAndEvalID(jobUUID uuid.UUID, state job.State, evalID string) error {
-	return db.SetJobStateAndEvalID(jobUUID, state, evalID)
+// CancelJob removes the job from the queue and marks it as done.
+// It returns ErrLeaseLost if the job was already done.
+func CancelJob(jobUUID uuid.UUID) error {
+	return db.CancelJob(jobUUID)
 }
@@ -1,11 +1,13 @@
 package job
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/micro/protobuf/ptypes"
 	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 )
 
 // Code holds source code
@@ -28,11 +30,49 @@ const (
 	DONE State = 2
 )
 
+// PriorityClass groups the jobs by their urgency
+type PriorityClass int32
+
+const (
+	// PRACTICE is the class of the submissions made outside of a running contest
+	PRACTICE PriorityClass = 0
+
+	// CONTEST_LIVE is the class of the submissions made during a running contest
+	CONTEST_LIVE PriorityClass = 1
+
+	// REJUDGE is the class of the jobs created by rejudges
+	REJUDGE PriorityClass = 2
+)
+
+// Rank returns how urgent the jobs of the class are. Jobs with a higher rank are dispatched first.
+func (pc PriorityClass) Rank() int {
+	switch pc {
+	case CONTEST_LIVE:
+		return 2
+	case PRACTICE:
+		return 1
+	}
+
+	return 0
+}
+
+// ShareKey returns the group of jobs that shares the evals fairly with the other groups of the same class.
+// Rejudges are shared between task lists, so that rejudging a contest doesn't starve the others,
+// and the other jobs are shared between users.
+func ShareKey(class PriorityClass, userID, taskListID string) string {
+	if class == REJUDGE {
+		return "task_list:" + taskListID
+	}
+
+	return "user:" + userID
+}
+
 // Job is an evaluation job
 type Job struct {
 	UUID         uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v1mc()"`
 	DatasetID    string
 	Code         []byte
+	AttachmentID string // the attachment that holds the code, used when Code is empty
 	Language     string
 	EvalID       string
 	State        State
@@ -41,6 +81,52 @@ type Job struct {
 	TaskID       string
 	CreatedAt    time.Time
 	FinishedAt   *time.Time
+	// Priority is the priority with which the job is queued again when its lease expires
+	Priority int32
+	// LeaseExpiresAt is set while the job is PROCESSING. If the eval doesn't finish
+	// the job or extend the lease until then, the job is requeued.
+	LeaseExpiresAt *time.Time `gorm:"index"`
+	// StartedAt is the time at which the job was last acquired by an eval
+	StartedAt *time.Time
+	// Retries is the number of times the job was requeued after its lease expired
+	Retries       int32
+	UserID        string `gorm:"index"`
+	TaskListID    string
+	PriorityClass PriorityClass
+
+	// RequiredLabels holds the labels required from the eval node, encoded as a JSON object
+	RequiredLabels    string
+	MinBenchmarkScore float64
+	RequiredCPUModel  string
+}
+
+// SetRequirements sets the requirements of the eval nodes that can process the job
+func (j *Job) SetRequirements(r *dataset.NodeRequirements) {
+	j.RequiredLabels = ""
+	j.MinBenchmarkScore = 0
+	j.RequiredCPUModel = ""
+	if r == nil {
+		return
+	}
+	if len(r.Labels) > 0 {
+		labels, _ := json.Marshal(r.Labels)
+		j.RequiredLabels = string(labels)
+	}
+	j.MinBenchmarkScore = r.MinBenchmarkScore
+	j.RequiredCPUModel = r.CpuModel
+}
+
+// Requirements returns the requirements of the eval nodes that can process the job
+func (j *Job) Requirements() *dataset.NodeRequirements {
+	r := &dataset.NodeRequirements{
+		MinBenchmarkScore: j.MinBenchmarkScore,
+		CpuModel:          j.RequiredCPUModel,
+	}
+	if len(j.RequiredLabels) > 0 {
+		json.Unmarshal([]byte(j.RequiredLabels), &r.Labels)
+	}
+
+	return r
 }
 
 func FromProto(j *job.Job) *Job {
@@ -50,20 +136,40 @@ func FromProto(j *job.Job) *Job {
 	jb.UUID, _ = uuid.Parse(j.Uuid)
 	jb.DatasetID = j.DatasetId
 	jb.Code = j.Code
+	jb.AttachmentID = j.AttachmentId
 	jb.Language = j.Language
 	jb.EvalID = j.EvalId
 	// extra safe-guard
 	jb.State = State(j.State)
 	jb.SubmissionID = j.SubmissionId
 	jb.TaskID = j.TaskId
+	jb.Retries = j.Retries
+	jb.UserID = j.UserId
+	jb.TaskListID = j.TaskListId
+	jb.PriorityClass = PriorityClass(j.PriorityClass)
+	jb.SetRequirements(j.Requirements)
 	jb.CreatedAt, err = ptypes.Timestamp(j.CreatedAt)
 	if err != nil {
 		panic(err)
 	}
-	finishedAt, err := ptypes.Timestamp(j.FinishedAt)
-	jb.FinishedAt = &finishedAt
-	if err != nil {
-		jb.FinishedAt = nil
+	// unfinished jobs have no finished_at, not a zero one
+	if j.FinishedAt != nil {
+		finishedAt, err := ptypes.Timestamp(j.FinishedAt)
+		if err == nil && !finishedAt.IsZero() {
+			jb.FinishedAt = &finishedAt
+		}
+	}
+	if j.LeaseExpiresAt != nil {
+		leaseExpiresAt, err := ptypes.Timestamp(j.LeaseExpiresAt)
+		if err == nil {
+			jb.LeaseExpiresAt = &leaseExpiresAt
+		}
+	}
+	if j.StartedAt != nil {
+		startedAt, err := ptypes.Timestamp(j.StartedAt)
+		if err == nil {
+			jb.StartedAt = &startedAt
+		}
 	}
 
 	return jb
@@ -72,14 +178,20 @@ func FromProto(j *job.Job) *Job {
 func (j *Job) ToProto() *job.Job {
 	var err error
 	pj := job.Job{
-		Uuid:         j.UUID.String(),
-		DatasetId:    j.DatasetID,
-		Code:         j.Code,
-		Language:     j.Language,
-		EvalId:       j.EvalID,
-		State:        job.State(j.State),
-		SubmissionId: j.SubmissionID,
-		TaskId:       j.TaskID,
+		Uuid:          j.UUID.String(),
+		DatasetId:     j.DatasetID,
+		Code:          j.Code,
+		AttachmentId:  j.AttachmentID,
+		Language:      j.Language,
+		EvalId:        j.EvalID,
+		State:         job.State(j.State),
+		SubmissionId:  j.SubmissionID,
+		TaskId:        j.TaskID,
+		Retries:       j.Retries,
+		UserId:        j.UserID,
+		TaskListId:    j.TaskListID,
+		PriorityClass: job.PriorityClass(j.PriorityClass),
+		Requirements:  j.Requirements(),
 	}
 	pj.CreatedAt, err = ptypes.TimestampProto(j.CreatedAt)
 	if err != nil {
@@ -91,6 +203,18 @@ func (j *Job) ToProto() *job.Job {
 			panic(err)
 		}
 	}
+	if j.LeaseExpiresAt != nil {
+		pj.LeaseExpiresAt, err = ptypes.TimestampProto(*j.LeaseExpiresAt)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if j.StartedAt != nil {
+		pj.StartedAt, err = ptypes.TimestampProto(*j.StartedAt)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	return &pj
 }
@@ -0,0 +1,61 @@
+package rejudge
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/micro/protobuf/ptypes"
+	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+)
+
+// Rejudge creates new jobs for the submissions that match its filters. The submissions
+// are processed in batches in the background, Processed keeps the progress
+// so that an interrupted rejudge resumes where it stopped.
+type Rejudge struct {
+	UUID         uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v1mc()"`
+	SubmissionID string
+	TaskID       string
+	DatasetID    string
+	TaskListID   string
+	Priority     int32
+	Processed    uint32
+	Failures     []Failure `gorm:"foreignkey:RejudgeUUID"`
+	CreatedAt    time.Time
+	FinishedAt   *time.Time `gorm:"index"`
+}
+
+// Failure is a submission that couldn't be rejudged
+type Failure struct {
+	ID           uint      `gorm:"primary_key"`
+	RejudgeUUID  uuid.UUID `gorm:"type:uuid;index"`
+	SubmissionID string
+	Error        string
+}
+
+func (Failure) TableName() string {
+	return "rejudge_failures"
+}
+
+func (r *Rejudge) ToProto() *job.Rejudge {
+	pr := &job.Rejudge{
+		Uuid:         r.UUID.String(),
+		SubmissionId: r.SubmissionID,
+		TaskId:       r.TaskID,
+		DatasetId:    r.DatasetID,
+		TaskListId:   r.TaskListID,
+		Priority:     r.Priority,
+		Processed:    r.Processed,
+	}
+	for _, f := range r.Failures {
+		pr.Failures = append(pr.Failures, &job.RejudgeFailure{
+			SubmissionId: f.SubmissionID,
+			Error:        f.Error,
+		})
+	}
+	pr.CreatedAt, _ = ptypes.TimestampProto(r.CreatedAt)
+	if r.FinishedAt != nil {
+		pr.FinishedAt, _ = ptypes.TimestampProto(*r.FinishedAt)
+	}
+
+	return pr
+}
@@ -1,24 +1,34 @@
 package db
 
 import (
+	"time"
+
 	"github.com/google/uuid"
-	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/queueitem"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
+	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 )
 
+// Matcher returns whether an eval node can process a job written in language
+// whose dataset has the given requirements
+type Matcher func(language string, r *dataset.NodeRequirements) bool
+
 type QueueItem interface {
 	EnqueueJob(priority int, jobUUID uuid.UUID) error
-	GetFirstJobInQueue() (*queueitem.QueueItem, error)
-	DequeueJob() (*queueitem.QueueItem, error)
+	AcquireJob(match Matcher, evalID string, until time.Time) (*job.Job, error)
+	QueueDepth() (map[job.PriorityClass]int64, error)
 }
 
 func EnqueueJob(priority int, jobUUID uuid.UUID) error {
 	return db.EnqueueJob(priority, jobUUID)
 }
 
-func GetFirstJobInQueue() (*queueitem.QueueItem, error) {
-	return db.GetFirstJobInQueue()
+// AcquireJob removes the next job matched by match from the queue and leases it to the eval until the given time.
+// It returns ErrNotFound if there is no such job.
+func AcquireJob(match Matcher, evalID string, until time.Time) (*job.Job, error) {
+	return db.AcquireJob(match, evalID, until)
 }
 
-func DequeueJob() (*queueitem.QueueItem, error) {
-	return db.DequeueJob()
+// QueueDepth returns the number of jobs in the queue of each priority class
+func QueueDepth() (map[job.PriorityClass]int64, error) {
+	return db.QueueDepth()
 }
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/rejudge"
+)
+
+type Rejudge interface {
+	CreateRejudge(r *rejudge.Rejudge) (uuid.UUID, error)
+	ReadRejudge(uuid string) (*rejudge.Rejudge, error)
+	UnfinishedRejudges() ([]*rejudge.Rejudge, error)
+	AdvanceRejudge(rejudgeUUID uuid.UUID, processed uint32, failure *rejudge.Failure) error
+	FinishRejudge(rejudgeUUID uuid.UUID, now time.Time) error
+}
+
+func CreateRejudge(r *rejudge.Rejudge) (uuid.UUID, error) {
+	return db.CreateRejudge(r)
+}
+
+// ReadRejudge returns a rejudge along with its failures
+func ReadRejudge(uuid string) (*rejudge.Rejudge, error) {
+	return db.ReadRejudge(uuid)
+}
+
+// UnfinishedRejudges returns the rejudges that are still running, oldest first
+func UnfinishedRejudges() ([]*rejudge.Rejudge, error) {
+	return db.UnfinishedRejudges()
+}
+
+// AdvanceRejudge saves the number of submissions processed by a rejudge and,
+// if the last one couldn't be rejudged, the failure.
+func AdvanceRejudge(rejudgeUUID uuid.UUID, processed uint32, failure *rejudge.Failure) error {
+	return db.AdvanceRejudge(rejudgeUUID, processed, failure)
+}
+
+// FinishRejudge marks a rejudge as finished
+func FinishRejudge(rejudgeUUID uuid.UUID, now time.Time) error {
+	return db.FinishRejudge(rejudgeUUID, now)
+}
@@ -4,12 +4,18 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/micro/protobuf/ptypes"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/queueitem"
 	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
 )
 
-func (s *SQL) CreateJob(j *pjob.Job) (uuid.UUID, error) {
+func (s *SQL) CreateJob(j *pjob.Job, priority int32) (uuid.UUID, error) {
 	jb := job.FromProto(j)
+	jb.Priority = priority
 
 	return jb.UUID, s.db.Create(jb).Error
 }
@@ -35,53 +41,184 @@ func (s *SQL) ReadJob(uuid string) (*job.Job, error) {
 	return &jb, nil
 }
 
-func (s *SQL) SearchJob(req *pjob.SearchRequest) ([]*job.Job, error) {
-	where := make(map[string]interface{})
-	switch {
-	case len(req.TaskId) > 0:
-		where["task_id"] = req.TaskId
-	case len(req.DatasetId) > 0:
-		where["dataset_id"] = req.DatasetId
-	case len(req.Language) > 0:
-		where["language"] = req.Language
-	case len(req.EvalId) > 0:
-		where["eval_id"] = req.EvalId
-	case req.State != nil:
-		where["state"] = req.State.Value
+func (s *SQL) SearchJob(req *pjob.SearchRequest) ([]*job.Job, uint32, error) {
+	query := s.db.Model(&job.Job{})
+	if len(req.TaskId) > 0 {
+		query = query.Where("task_id = ?", req.TaskId)
+	}
+	if len(req.DatasetId) > 0 {
+		query = query.Where("dataset_id = ?", req.DatasetId)
+	}
+	if len(req.Language) > 0 {
+		query = query.Where("language = ?", req.Language)
+	}
+	if len(req.EvalId) > 0 {
+		query = query.Where("eval_id = ?", req.EvalId)
+	}
+	if req.State != nil {
+		query = query.Where("state = ?", req.State.Value)
+	}
+	if len(req.SubmissionId) > 0 {
+		query = query.Where("submission_id = ?", req.SubmissionId)
+	}
+	if len(req.UserId) > 0 {
+		query = query.Where("user_id = ?", req.UserId)
+	}
+	if req.CreatedAt != nil {
+		query = whereInRange(query, "created_at", req.CreatedAt)
+	}
+	if req.FinishedAt != nil {
+		query = whereInRange(query, "finished_at", req.FinishedAt)
+	}
+
+	var cnt uint32
+	err := query.Count(&cnt).Error
+	if err != nil {
+		return nil, 0, err
 	}
 
+	switch req.Order {
+	case pjob.Order_CREATED_AT_ASC:
+		query = query.Order("created_at ASC")
+	case pjob.Order_FINISHED_AT_DESC:
+		query = query.Order("finished_at DESC NULLS LAST")
+	case pjob.Order_FINISHED_AT_ASC:
+		query = query.Order("finished_at ASC NULLS LAST")
+	default:
+		query = query.Order("created_at DESC")
+	}
 	result := []*job.Job{}
-	query := s.db.Where(where)
-	if len(req.ErrorMessage) > 0 {
-		query = query.Where("error_message ~* ?", req.ErrorMessage)
+	err = query.Limit(int(req.Limit)).Offset(int(req.Offset)).Find(&result).Error
+	if err != nil {
+		return nil, 0, err
 	}
-	if req.Limit > 0 {
-		query = query.Limit(int(req.Limit))
+
+	return result, cnt, nil
+}
+
+// whereInRange restricts the query to the rows whose field is inside the range. The ends of the range are optional.
+func whereInRange(query *gorm.DB, field string, r *tsrange.TimestampRange) *gorm.DB {
+	if r.Begin != nil {
+		begin, err := ptypes.Timestamp(r.Begin)
+		if err == nil && !begin.IsZero() {
+			query = query.Where(field+" >= ?", begin)
+		}
 	}
-	if req.Offset > 0 {
-		query = query.Offset(int(req.Offset))
+	if r.End != nil {
+		end, err := ptypes.Timestamp(r.End)
+		if err == nil && !end.IsZero() {
+			query = query.Where(field+" <= ?", end)
+		}
 	}
-	err := query.Find(&result).Error
 
-	if err != nil {
-		return nil, err
+	return query
+}
+
+func (s *SQL) FinishJob(req *pjob.FinishRequest, evalID string) error {
+	if _, err := s.ReadJob(req.JobUuid); err != nil {
+		return err
 	}
 
-	return result, nil
+	result := s.db.Exec("UPDATE jobs SET state = ?, finished_at = ?, lease_expires_at = NULL WHERE uuid = ? AND state = ? AND eval_id = ?",
+		job.DONE, time.Now(), req.JobUuid, job.PROCESSING, evalID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrLeaseLost
+	}
+
+	return nil
 }
 
-func (s *SQL) FinishJob(req *pjob.FinishRequest) error {
-	j, err := s.ReadJob(req.JobUuid)
+func (s *SQL) ExtendLease(jobUUID uuid.UUID, evalID string, until time.Time) error {
+	result := s.db.Exec("UPDATE jobs SET lease_expires_at = ? WHERE uuid = ? AND state = ? AND eval_id = ?", until, jobUUID, job.PROCESSING, evalID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrLeaseLost
+	}
+
+	return nil
+}
+
+func (s *SQL) ExpiredJobs(now time.Time) ([]*job.Job, error) {
+	jobs := []*job.Job{}
+	err := s.db.Where("state = ? AND lease_expires_at < ?", job.PROCESSING, now).Find(&jobs).Error
+
+	return jobs, err
+}
+
+// expireLease ends the expired lease of a job, setting the given state.
+// It returns db.ErrLeaseLost if the lease isn't expired anymore.
+func expireLease(tx *gorm.DB, jobUUID uuid.UUID, now time.Time, set string, values ...interface{}) error {
+	values = append(values, jobUUID, job.PROCESSING, now)
+	result := tx.Exec("UPDATE jobs SET "+set+", lease_expires_at = NULL WHERE uuid = ? AND state = ? AND lease_expires_at < ?", values...)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.ErrLeaseLost
+	}
+
+	return nil
+}
+
+func (s *SQL) RequeueJob(jobUUID uuid.UUID, now time.Time) error {
+	tx := s.db.Begin()
+	err := expireLease(tx, jobUUID, now, "state = ?, eval_id = '', retries = retries + 1", job.WAITING)
 	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	j := job.Job{}
+	if err := tx.First(&j, "uuid = ?", jobUUID).Error; err != nil {
+		tx.Rollback()
+		return e(err)
+	}
+	qi := queueitem.QueueItem{
+		Priority: int(j.Priority),
+		JobUUID:  jobUUID,
+	}
+	if err := tx.Create(&qi).Error; err != nil {
+		tx.Rollback()
 		return err
 	}
 
-	j.State = job.DONE
-	t := time.Now()
-	j.FinishedAt = &t
-	return s.db.Save(&j).Error
+	return tx.Commit().Error
+}
+
+func (s *SQL) FailJob(jobUUID uuid.UUID, now time.Time) error {
+	return expireLease(s.db, jobUUID, now, "state = ?, finished_at = ?", job.DONE, now)
 }
 
-func (s *SQL) SetJobStateAndEvalID(jobUUID uuid.UUID, state job.State, evalID string) error {
-	return s.db.Exec("UPDATE jobs SET state = (CASE WHEN ? > state THEN ? ELSE state END), eval_id=? WHERE uuid=?", state, state, evalID, jobUUID).Error
+func (s *SQL) UnfinishedJobs(submissionID string) ([]*job.Job, error) {
+	jobs := []*job.Job{}
+	err := s.db.Where("submission_id = ? AND state <> ?", submissionID, job.DONE).Find(&jobs).Error
+
+	return jobs, err
+}
+
+func (s *SQL) CancelJob(jobUUID uuid.UUID) error {
+	tx := s.db.Begin()
+	s.lockTables(tx, "queue_items", "finished_queue_items")
+	err := tx.Exec("INSERT INTO finished_queue_items (id, priority, job_uuid) SELECT id, priority, job_uuid FROM queue_items WHERE job_uuid = ? AND id NOT IN (SELECT id FROM finished_queue_items)", jobUUID).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	result := tx.Exec("UPDATE jobs SET state = ?, finished_at = ?, lease_expires_at = NULL WHERE uuid = ? AND state <> ?", job.DONE, time.Now(), jobUUID, job.DONE)
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return db.ErrLeaseLost
+	}
+
+	return tx.Commit().Error
 }
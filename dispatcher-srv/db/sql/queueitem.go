@@ -1,9 +1,14 @@
 package sql
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/queueitem"
+	"github.com/xmc-dev/xmc/dispatcher-srv/service"
 )
 
 func (s *SQL) EnqueueJob(priority int, jobUUID uuid.UUID) error {
@@ -14,49 +19,205 @@ func (s *SQL) EnqueueJob(priority int, jobUUID uuid.UUID) error {
 	return s.db.Create(&qi).Error
 }
 
-func (s *SQL) getFirstJobInQueue(tx *gorm.DB) (*queueitem.QueueItem, error) {
-	qi := &queueitem.QueueItem{}
-	err := tx.
-		Joins("LEFT JOIN finished_queue_items on queue_items.id=finished_queue_items.id").
-		Where("finished_queue_items.id IS NULL").
-		Order("priority desc").
-		First(qi).Error
-	return qi, e(err)
+// pendingItem is a queue item that hasn't been dequeued, along with the fields of its job used for scheduling
+type pendingItem struct {
+	ID            uint
+	Priority      int
+	JobUUID       uuid.UUID
+	UserID        string
+	TaskListID    string
+	PriorityClass job.PriorityClass
+
+	Language          string
+	RequiredLabels    string
+	MinBenchmarkScore float64
+	RequiredCPUModel  string
 }
 
-func (s *SQL) GetFirstJobInQueue() (*queueitem.QueueItem, error) {
-	tx := s.db.Begin()
-	s.lockTables(tx, "queue_items", "finished_queue_items")
-	qi, err := s.getFirstJobInQueue(tx)
+func (pi *pendingItem) matches(match db.Matcher) bool {
+	j := &job.Job{
+		RequiredLabels:    pi.RequiredLabels,
+		MinBenchmarkScore: pi.MinBenchmarkScore,
+		RequiredCPUModel:  pi.RequiredCPUModel,
+	}
+
+	return match(pi.Language, j.Requirements())
+}
+
+// runningJob holds the fields used for scheduling of a job that is being processed
+type runningJob struct {
+	UserID        string
+	TaskListID    string
+	PriorityClass job.PriorityClass
+}
+
+// candidatesPerShare is the number of items of each user or task list that are considered
+// when choosing the next item. The others wait until the first ones are dispatched.
+const candidatesPerShare = 20
+
+// shareKeySQL groups the jobs like job.ShareKey
+const shareKeySQL = "CASE WHEN jobs.priority_class = ? THEN 'task_list:' || jobs.task_list_id ELSE 'user:' || jobs.user_id END"
+
+// getFirstJobInQueue returns the next queue item to be dispatched.
+//
+// The item is chosen from the most urgent priority class that has items. Inside the class,
+// the evals are shared fairly: the item belongs to the user or task list (see job.ShareKey)
+// with the fewest jobs being processed. Among the items of the same user or task list,
+// the one with the highest priority is chosen, then the oldest.
+// The items of users which have at least MaxJobsPerUser jobs being processed are skipped,
+// as are the items whose jobs are not matched by match.
+//
+// Only the first candidatesPerShare items of each user or task list are read from the queue.
+func (s *SQL) getFirstJobInQueue(tx *gorm.DB, match db.Matcher) (*queueitem.QueueItem, error) {
+	items := []*pendingItem{}
+	err := tx.Raw(`SELECT * FROM (
+		SELECT queue_items.id, queue_items.priority, queue_items.job_uuid, jobs.user_id, jobs.task_list_id, jobs.priority_class,
+			jobs.language, jobs.required_labels, jobs.min_benchmark_score, jobs.required_cpu_model,
+			row_number() OVER (PARTITION BY jobs.priority_class, `+shareKeySQL+` ORDER BY queue_items.priority DESC, queue_items.id) AS share_rank
+		FROM queue_items
+		JOIN jobs ON jobs.uuid = queue_items.job_uuid
+		LEFT JOIN finished_queue_items ON queue_items.id = finished_queue_items.id
+		WHERE finished_queue_items.id IS NULL
+	) AS candidates WHERE share_rank <= ?`, job.REJUDGE, candidatesPerShare).
+		Scan(&items).Error
 	if err != nil {
-		s.unlockTables(tx)
-		tx.Rollback()
-		return qi, err
+		return nil, err
 	}
-	s.unlockTables(tx)
-	tx.Commit()
-	return qi, nil
+	running := []*runningJob{}
+	err = tx.Table("jobs").
+		Select("user_id, task_list_id, priority_class").
+		Where("state = ?", job.PROCESSING).
+		Scan(&running).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pi := pickItem(items, running, match, service.MainService.MaxJobsPerUser)
+	if pi == nil {
+		return nil, db.ErrNotFound
+	}
+
+	return &queueitem.QueueItem{
+		ID:       pi.ID,
+		Priority: pi.Priority,
+		JobUUID:  pi.JobUUID,
+	}, nil
+}
+
+// pickItem chooses the next item to be dispatched, as described by getFirstJobInQueue.
+// The limit of jobs per user applies only to the jobs of submissions, the rejudges are
+// neither limited nor counted against the limit of the authors of the rejudged submissions.
+func pickItem(items []*pendingItem, running []*runningJob, match db.Matcher, maxJobsPerUser int) *pendingItem {
+	perUser := map[string]int{}
+	perShare := map[string]int{}
+	for _, r := range running {
+		if r.PriorityClass != job.REJUDGE {
+			perUser[r.UserID]++
+		}
+		perShare[job.ShareKey(r.PriorityClass, r.UserID, r.TaskListID)]++
+	}
+
+	// before returns true if a is dispatched before b, without taking fair sharing into account
+	before := func(a, b *pendingItem) bool {
+		if a.PriorityClass.Rank() != b.PriorityClass.Rank() {
+			return a.PriorityClass.Rank() > b.PriorityClass.Rank()
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		return a.ID < b.ID
+	}
+	// best holds the first item of each user or task list
+	best := map[string]*pendingItem{}
+	for _, it := range items {
+		if maxJobsPerUser > 0 && it.PriorityClass != job.REJUDGE && len(it.UserID) > 0 && perUser[it.UserID] >= maxJobsPerUser {
+			continue
+		}
+		if !it.matches(match) {
+			continue
+		}
+		key := job.ShareKey(it.PriorityClass, it.UserID, it.TaskListID)
+		if b, ok := best[key]; !ok || before(it, b) {
+			best[key] = it
+		}
+	}
+
+	var chosen *pendingItem
+	chosenKey := ""
+	for key, it := range best {
+		switch {
+		case chosen == nil:
+		case it.PriorityClass.Rank() != chosen.PriorityClass.Rank():
+			if it.PriorityClass.Rank() < chosen.PriorityClass.Rank() {
+				continue
+			}
+		case perShare[key] != perShare[chosenKey]:
+			if perShare[key] > perShare[chosenKey] {
+				continue
+			}
+		case !before(it, chosen):
+			continue
+		}
+		chosen = it
+		chosenKey = key
+	}
+
+	return chosen
 }
 
-func (s *SQL) DequeueJob() (*queueitem.QueueItem, error) {
+func (s *SQL) AcquireJob(match db.Matcher, evalID string, until time.Time) (*job.Job, error) {
 	tx := s.db.Begin()
-	s.lockTables(tx, "queue_items", "finished_queue_items")
-	qi, err := s.getFirstJobInQueue(tx)
+	if err := s.lockTables(tx, "queue_items", "finished_queue_items"); err != nil {
+		tx.Rollback()
+		return nil, e(err)
+	}
+	qi, err := s.getFirstJobInQueue(tx, match)
 	if err != nil {
-		s.unlockTables(tx)
 		tx.Rollback()
-		return qi, err
+		return nil, err
 	}
-	sqi := &queueitem.FinishedQueueItem{
-		QueueItem: *qi,
+	err = tx.Create(&queueitem.FinishedQueueItem{QueueItem: *qi}).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, e(err)
 	}
-	err = tx.Create(sqi).Error
+	err = tx.Exec("UPDATE jobs SET state = ?, eval_id = ?, lease_expires_at = ?, started_at = ? WHERE uuid = ?",
+		job.PROCESSING, evalID, until, time.Now(), qi.JobUUID).Error
 	if err != nil {
 		tx.Rollback()
-		s.unlockTables(tx)
-		return qi, e(err)
+		return nil, e(err)
+	}
+	j := &job.Job{}
+	err = tx.First(j, "uuid = ?", qi.JobUUID).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, e(err)
 	}
 	s.unlockTables(tx)
-	tx.Commit()
-	return qi, nil
+
+	return j, e(tx.Commit().Error)
+}
+
+func (s *SQL) QueueDepth() (map[job.PriorityClass]int64, error) {
+	rows := []struct {
+		PriorityClass job.PriorityClass
+		Jobs          int64
+	}{}
+	err := s.db.Table("queue_items").
+		Select("jobs.priority_class, count(*) AS jobs").
+		Joins("JOIN jobs ON jobs.uuid = queue_items.job_uuid").
+		Joins("LEFT JOIN finished_queue_items on queue_items.id=finished_queue_items.id").
+		Where("finished_queue_items.id IS NULL").
+		Group("jobs.priority_class").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	depth := make(map[job.PriorityClass]int64)
+	for _, r := range rows {
+		depth[r.PriorityClass] = r.Jobs
+	}
+
+	return depth, nil
 }
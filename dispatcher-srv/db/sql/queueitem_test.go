@@ -0,0 +1,147 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
+	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+)
+
+func matchAll(string, *dataset.NodeRequirements) bool {
+	return true
+}
+
+func matchLanguage(lang string) func(string, *dataset.NodeRequirements) bool {
+	return func(l string, _ *dataset.NodeRequirements) bool {
+		return l == lang
+	}
+}
+
+func TestPickItem(t *testing.T) {
+	tests := []struct {
+		name           string
+		items          []*pendingItem
+		running        []*runningJob
+		match          func(string, *dataset.NodeRequirements) bool
+		maxJobsPerUser int
+		expected       uint
+	}{
+		{
+			name:     "empty queue",
+			match:    matchAll,
+			expected: 0,
+		},
+		{
+			name: "oldest first",
+			items: []*pendingItem{
+				{ID: 2, UserID: "a"},
+				{ID: 1, UserID: "a"},
+			},
+			match:    matchAll,
+			expected: 1,
+		},
+		{
+			name: "higher priority first",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a", Priority: 1},
+				{ID: 2, UserID: "a", Priority: 2},
+			},
+			match:    matchAll,
+			expected: 2,
+		},
+		{
+			name: "urgent class first",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a", PriorityClass: job.REJUDGE, Priority: 10},
+				{ID: 2, UserID: "b", PriorityClass: job.PRACTICE},
+				{ID: 3, UserID: "c", PriorityClass: job.CONTEST_LIVE},
+			},
+			match:    matchAll,
+			expected: 3,
+		},
+		{
+			name: "user with fewer running jobs first",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a"},
+				{ID: 2, UserID: "b"},
+			},
+			running: []*runningJob{
+				{UserID: "a"},
+			},
+			match:    matchAll,
+			expected: 2,
+		},
+		{
+			name: "rejudges are shared between task lists",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a", TaskListID: "x", PriorityClass: job.REJUDGE},
+				{ID: 2, UserID: "b", TaskListID: "y", PriorityClass: job.REJUDGE},
+			},
+			running: []*runningJob{
+				{UserID: "c", TaskListID: "x", PriorityClass: job.REJUDGE},
+			},
+			match:    matchAll,
+			expected: 2,
+		},
+		{
+			name: "user at the limit is skipped",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a"},
+				{ID: 2, UserID: "b"},
+			},
+			running: []*runningJob{
+				{UserID: "a"},
+				{UserID: "b"},
+				{UserID: "b"},
+			},
+			match:          matchAll,
+			maxJobsPerUser: 2,
+			expected:       1,
+		},
+		{
+			name: "rejudges don't count against the user limit",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a"},
+			},
+			running: []*runningJob{
+				{UserID: "a", TaskListID: "x", PriorityClass: job.REJUDGE},
+				{UserID: "a", TaskListID: "x", PriorityClass: job.REJUDGE},
+			},
+			match:          matchAll,
+			maxJobsPerUser: 1,
+			expected:       1,
+		},
+		{
+			name: "rejudges aren't limited per user",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a", TaskListID: "x", PriorityClass: job.REJUDGE},
+			},
+			running: []*runningJob{
+				{UserID: "a"},
+			},
+			match:          matchAll,
+			maxJobsPerUser: 1,
+			expected:       1,
+		},
+		{
+			name: "unmatched items are skipped",
+			items: []*pendingItem{
+				{ID: 1, UserID: "a", Language: "c", PriorityClass: job.CONTEST_LIVE},
+				{ID: 2, UserID: "b", Language: "go"},
+			},
+			match:    matchLanguage("go"),
+			expected: 2,
+		},
+	}
+
+	for _, test := range tests {
+		pi := pickItem(test.items, test.running, test.match, test.maxJobsPerUser)
+		var got uint
+		if pi != nil {
+			got = pi.ID
+		}
+		if got != test.expected {
+			t.Errorf("%s: expected item %d, got %d", test.name, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package sql
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/rejudge"
+)
+
+func (s *SQL) CreateRejudge(r *rejudge.Rejudge) (uuid.UUID, error) {
+	err := s.db.Create(r).Error
+
+	return r.UUID, err
+}
+
+func (s *SQL) ReadRejudge(uuid string) (*rejudge.Rejudge, error) {
+	r := &rejudge.Rejudge{}
+	err := s.db.Preload("Failures", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id")
+	}).First(r, "uuid = ?", uuid).Error
+
+	return r, e(err)
+}
+
+func (s *SQL) UnfinishedRejudges() ([]*rejudge.Rejudge, error) {
+	rs := []*rejudge.Rejudge{}
+	err := s.db.Where("finished_at IS NULL").Order("created_at").Find(&rs).Error
+
+	return rs, err
+}
+
+func (s *SQL) AdvanceRejudge(rejudgeUUID uuid.UUID, processed uint32, failure *rejudge.Failure) error {
+	tx := s.db.Begin()
+	err := tx.Exec("UPDATE rejudges SET processed = ? WHERE uuid = ?", processed, rejudgeUUID).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if failure != nil {
+		failure.RejudgeUUID = rejudgeUUID
+		if err := tx.Create(failure).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (s *SQL) FinishRejudge(rejudgeUUID uuid.UUID, now time.Time) error {
+	return s.db.Exec("UPDATE rejudges SET finished_at = ? WHERE uuid = ?", now, rejudgeUUID).Error
+}
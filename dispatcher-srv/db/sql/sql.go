@@ -1,10 +1,13 @@
 package sql
 
 import (
+	"time"
+
 	"github.com/jinzhu/gorm"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/queueitem"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/rejudge"
 	"github.com/xmc-dev/xmc/dispatcher-srv/service"
 	// db dialects
 	_ "github.com/jinzhu/gorm/dialects/postgres"
@@ -29,7 +32,8 @@ func (s *SQL) lockTables(tx *gorm.DB, tables ...string) error {
 			lck += ", "
 		}
 	}
-	return tx.Exec("LOCK TABLE " + lck + " IN ACCESS EXCLUSIVE MODE;").Error
+	// the lock serializes the changes to the queue, while still allowing it to be read
+	return tx.Exec("LOCK TABLE " + lck + " IN EXCLUSIVE MODE;").Error
 }
 
 func (s *SQL) unlockTables(tx *gorm.DB) error {
@@ -45,6 +49,14 @@ func (s *SQL) migrate() error {
 	if err := mq.Error; err != nil {
 		return err
 	}
+	if err := s.db.AutoMigrate(rejudge.Rejudge{}, rejudge.Failure{}).Error; err != nil {
+		return err
+	}
+	// unfinished jobs used to be stored with a zero finished_at instead of NULL
+	err := s.db.Exec("UPDATE jobs SET finished_at = NULL WHERE finished_at = ?", time.Time{}).Error
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
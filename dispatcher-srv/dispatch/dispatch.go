@@ -1,13 +1,18 @@
 package dispatch
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/micro/go-micro/client"
 	"github.com/sirupsen/logrus"
 	"github.com/xmc-dev/xmc/dispatcher-srv/auth"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
-	"github.com/xmc-dev/xmc/dispatcher-srv/status"
-	"github.com/xmc-dev/xmc/eval-srv/proto/eval"
+	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/dispatcher-srv/service"
+	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 	"github.com/xmc-dev/xmc/xmc-core/proto/submission"
 )
 
@@ -15,80 +20,108 @@ var log = logrus.WithField("prefix", "dispatch")
 
 var submissionService = submission.NewSubmissionServiceClient("xmc.srv.core", client.DefaultClient)
 
-func next() {
-	qi, err := db.GetFirstJobInQueue()
-	if err != nil {
-		if err == db.ErrNotFound {
-			log.Info("Nothing to dispatch")
-		} else {
-			handleError("couldn't get first job in queue", err)
-		}
-		return
-	}
-	log.Info("Dispatching job", qi.JobUUID)
-	success := false
-	nis := status.HealthCheck()
-	if len(nis) == 0 {
-		log.Error("No evals available")
+// queued is closed when jobs are added to the queue, waking up the evals that wait for jobs
+var queued = make(chan struct{})
+var queuedM = &sync.Mutex{}
+
+// Notify wakes up the evals that wait for jobs. It must be called after jobs are added to the queue.
+func Notify() {
+	queuedM.Lock()
+	close(queued)
+	queued = make(chan struct{})
+	queuedM.Unlock()
+}
+
+func queuedSignal() <-chan struct{} {
+	queuedM.Lock()
+	defer queuedM.Unlock()
+
+	return queued
+}
+
+// Matcher returns a db.Matcher which matches the jobs that an eval with the given capabilities can process
+func Matcher(c *pjob.NodeCapabilities) db.Matcher {
+	languages := make(map[string]bool)
+	for _, l := range c.Languages {
+		languages[l] = true
 	}
-	for _, ni := range nis {
-		if !ni.Idle || ni.Disabled {
-			continue
+
+	return func(language string, r *dataset.NodeRequirements) bool {
+		if !languages[language] {
+			return false
 		}
-		err := db.SetJobStateAndEvalID(qi.JobUUID, job.PROCESSING, ni.Name)
-		if err != nil {
-			handleError("couldn't set job state and eval_id", err)
-		} else {
-			job, err := db.ReadJob(qi.JobUUID.String())
-			if err != nil {
-				handleError("couldn't get job", err)
-			} else {
-				req := client.NewRequest("xmc.srv.eval", "EvalService.Assign",
-					&eval.AssignRequest{
-						Job: job.ToProto(),
-					})
-				rsp := &eval.AssignResponse{}
-				err := client.Call(auth.C(), req, rsp, client.WithAddress(ni.Address))
-				if err != nil {
-					handleError("assigning the job failed", err)
-				} else {
-					success = true
-					log.WithFields(logrus.Fields{
-						"qi": qi,
-						"ni": ni,
-					}).Info("job assigned successfully")
-					_, err = submissionService.Update(auth.C(), &submission.UpdateRequest{
-						Job: job.ToProto(),
-					})
-					if err != nil {
-						handleError("updating the submission failed", err)
-						success = false
-					}
-					break
-				}
+		for k, v := range r.Labels {
+			if l, ok := c.Labels[k]; !ok || l != v {
+				return false
 			}
 		}
-	}
-	if !success {
-		err := db.SetJobStateAndEvalID(qi.JobUUID, job.WAITING, "")
-		if err != nil {
-			handleError("couldn't set back job state and eval_id", err)
-			return
+		if c.BenchmarkScore < r.MinBenchmarkScore {
+			return false
 		}
-		log.WithField("qi", qi).Info("Job not dispatched")
-	} else {
-		_, err := db.DequeueJob()
-		if err != nil {
-			handleError("couldn't remove job from queue", err)
+		if len(r.CpuModel) > 0 && c.CpuModel != r.CpuModel {
+			return false
 		}
+
+		return true
 	}
 }
 
-func handleError(reason string, err error) {
-	log.WithError(err).Error("error while trying to dispatch job: ", reason)
+// Acquire leases at most capacity jobs to the eval. If there are no jobs the eval can process,
+// it waits for them until the wait time passes or ctx is done. The wait time is capped by MaxAcquireWait.
+//
+// If the eval doesn't receive the jobs, for example because its request timed out,
+// they are requeued when their leases expire.
+func Acquire(ctx context.Context, evalName string, capacity int, c *pjob.NodeCapabilities, wait time.Duration) ([]*job.Job, error) {
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > service.MainService.MaxAcquireWait {
+		wait = service.MainService.MaxAcquireWait
+	}
+	match := Matcher(c)
+	timeout := time.NewTimer(wait)
+	defer timeout.Stop()
+	for {
+		// the signal is taken before looking at the queue, so that no job added meanwhile is missed
+		signal := queuedSignal()
+		jobs, err := acquire(match, evalName, capacity)
+		if err != nil || len(jobs) > 0 {
+			return jobs, err
+		}
+
+		select {
+		case <-signal:
+		case <-timeout.C:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, nil
+		}
+	}
 }
 
-// Next dispatches the next job in the queue
-func Next() {
-	go next()
+func acquire(match db.Matcher, evalName string, capacity int) ([]*job.Job, error) {
+	jobs := []*job.Job{}
+	for len(jobs) < capacity {
+		j, err := db.AcquireJob(match, evalName, LeaseExpiry())
+		if err == db.ErrNotFound {
+			break
+		} else if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, j)
+		observeWait(j)
+		log.WithFields(logrus.Fields{
+			"job_uuid": j.UUID,
+			"eval":     evalName,
+		}).Info("Job acquired")
+
+		_, err = submissionService.Update(auth.C(), &submission.UpdateRequest{
+			Job: j.ToProto(),
+		})
+		if err != nil {
+			log.WithError(err).WithField("job_uuid", j.UUID).Error("Updating the submission failed")
+		}
+	}
+
+	return jobs, nil
 }
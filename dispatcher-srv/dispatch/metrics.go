@@ -0,0 +1,70 @@
+package dispatch
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/xmc-dev/xmc/common/metrics"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
+	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+)
+
+var queueDepthDesc = prometheus.NewDesc("xmc_dispatcher_queue_depth",
+	"Number of jobs waiting in the queue.", []string{"priority_class"}, nil)
+var jobWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "xmc_dispatcher_job_wait_seconds",
+	Help:    "Time the jobs waited in the queue before they were acquired by an eval.",
+	Buckets: metrics.DurationBuckets,
+}, []string{"priority_class"})
+var evaluationDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    "xmc_dispatcher_evaluation_duration_seconds",
+	Help:    "Time between the acquisition and the finishing of the jobs.",
+	Buckets: metrics.DurationBuckets,
+})
+
+// queueDepthCollector reads the depth of the queue from the database when the metrics are scraped
+type queueDepthCollector struct{}
+
+func (queueDepthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+}
+
+func (queueDepthCollector) Collect(ch chan<- prometheus.Metric) {
+	depth, err := db.QueueDepth()
+	if err != nil {
+		log.WithError(err).Error("Couldn't get queue depth")
+		return
+	}
+	for class, name := range pjob.PriorityClass_name {
+		ch <- prometheus.MustNewConstMetric(queueDepthDesc, prometheus.GaugeValue, float64(depth[job.PriorityClass(class)]), name)
+	}
+}
+
+func init() {
+	prometheus.MustRegister(queueDepthCollector{}, jobWait, evaluationDuration)
+}
+
+func observeWait(j *job.Job) {
+	if j.StartedAt == nil {
+		return
+	}
+	class := pjob.PriorityClass(j.PriorityClass).String()
+	jobWait.WithLabelValues(class).Observe(j.StartedAt.Sub(j.CreatedAt).Seconds())
+}
+
+// ObserveEvaluation records the evaluation duration of a job that was finished
+func ObserveEvaluation(j *job.Job) {
+	if j.StartedAt == nil || j.FinishedAt == nil {
+		return
+	}
+	evaluationDuration.Observe(j.FinishedAt.Sub(*j.StartedAt).Seconds())
+}
+
+// JobWait returns the times the jobs of the priority class waited in the queue
+func JobWait(class pjob.PriorityClass) metrics.HistogramSnapshot {
+	return metrics.Snapshot(jobWait.WithLabelValues(class.String()).(prometheus.Histogram))
+}
+
+// EvaluationDuration returns the evaluation durations of the finished jobs
+func EvaluationDuration() metrics.HistogramSnapshot {
+	return metrics.Snapshot(evaluationDuration)
+}
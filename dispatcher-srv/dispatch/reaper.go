@@ -0,0 +1,105 @@
+package dispatch
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/xmc-dev/xmc/dispatcher-srv/auth"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
+	"github.com/xmc-dev/xmc/dispatcher-srv/service"
+	"github.com/xmc-dev/xmc/xmc-core/proto/result"
+	"github.com/xmc-dev/xmc/xmc-core/proto/submission"
+)
+
+// LeaseExpiry returns the time at which a lease that starts now expires
+func LeaseExpiry() time.Time {
+	return time.Now().Add(service.MainService.LeaseDuration)
+}
+
+// Reap requeues the jobs whose lease expired, because the eval that processed them
+// crashed or stopped responding. Jobs that were requeued too many times fail with a system error.
+func Reap() {
+	now := time.Now()
+	jobs, err := db.ExpiredJobs(now)
+	if err != nil {
+		log.WithError(err).Error("Couldn't get jobs with expired leases")
+		return
+	}
+
+	requeued := false
+	for _, j := range jobs {
+		l := log.WithFields(logrus.Fields{
+			"job_uuid": j.UUID,
+			"eval_id":  j.EvalID,
+			"retries":  j.Retries,
+		})
+		if int(j.Retries) < service.MainService.MaxRetries {
+			err = requeue(j, now)
+			if err == nil {
+				requeued = true
+				l.Warn("Job lease expired, requeued job")
+			}
+		} else {
+			err = fail(j, now)
+			if err == nil {
+				l.Error("Job lease expired too many times, job failed")
+			}
+		}
+		if err == db.ErrLeaseLost {
+			l.Info("Job was finished or its lease was extended while reaping")
+		} else if err != nil {
+			l.WithError(err).Error("Couldn't reap job")
+		}
+	}
+
+	if requeued {
+		Notify()
+	}
+}
+
+func requeue(j *job.Job, now time.Time) error {
+	if err := db.RequeueJob(j.UUID, now); err != nil {
+		return err
+	}
+	j, err := db.ReadJob(j.UUID.String())
+	if err != nil {
+		return err
+	}
+	_, err = submissionService.Update(auth.C(), &submission.UpdateRequest{Job: j.ToProto()})
+
+	return err
+}
+
+func fail(j *job.Job, now time.Time) error {
+	if err := db.FailJob(j.UUID, now); err != nil {
+		return err
+	}
+	j, err := db.ReadJob(j.UUID.String())
+	if err != nil {
+		return err
+	}
+	pj := j.ToProto()
+	pj.Result = &result.Result{
+		Score:        "0",
+		Verdict:      result.Verdict_SYSTEM_ERROR,
+		ErrorMessage: fmt.Sprintf("err_lease_expired:the job was lost by the evals %d times", j.Retries+1),
+	}
+	// a rejudged submission still has the old result, which must not be mixed with this one
+	_, err = submissionService.Update(auth.C(), &submission.UpdateRequest{
+		Job:         pj,
+		ResetResult: true,
+	})
+
+	return err
+}
+
+// RunReaper reaps expired jobs periodically
+func RunReaper() {
+	t := time.NewTicker(service.MainService.ReaperInterval)
+
+	for range t.C {
+		Reap()
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/micro/go-micro/client"
 	"github.com/micro/go-micro/errors"
-	"github.com/micro/go-micro/metadata"
 	"github.com/micro/protobuf/ptypes"
 	"github.com/sirupsen/logrus"
 	"github.com/xmc-dev/xmc/common/perms"
@@ -18,6 +17,11 @@ import (
 	dbjob "github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	"github.com/xmc-dev/xmc/dispatcher-srv/dispatch"
 	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/dispatcher-srv/status"
+	econsts "github.com/xmc-dev/xmc/eval-srv/consts"
+	"github.com/xmc-dev/xmc/eval-srv/proto/eval"
+	"github.com/xmc-dev/xmc/xmc-core/proto/result"
+	"github.com/xmc-dev/xmc/xmc-core/proto/searchmeta"
 	"github.com/xmc-dev/xmc/xmc-core/proto/submission"
 )
 
@@ -56,52 +60,45 @@ func (*JobsService) Create(ctx context.Context, req *job.CreateRequest, rsp *job
 		return errors.Forbidden(methodName, "you are not allowed to create jobs")
 	}
 
+	u, err := createJob(j, req.Priority)
+	if err != nil {
+		return errors.InternalServerError(methodName, err.Error())
+	}
+	rsp.Uuid = u.String()
+
+	return nil
+}
+
+// createJob queues a new job for a submission and updates the submission.
+// The previous result of the submission is kept until the job finishes.
+func createJob(j *job.Job, priority int32) (uuid.UUID, error) {
 	j.Result = nil
 	j.EvalId = ""
 	j.State = job.State_WAITING
 	j.CreatedAt, _ = ptypes.TimestampProto(time.Time{})
-	j.FinishedAt, _ = ptypes.TimestampProto(time.Time{})
-	u, err := db.CreateJob(j)
+	j.FinishedAt = nil
+	u, err := db.CreateJob(j, priority)
 	if err != nil {
-		return errors.InternalServerError(methodName, err.Error())
+		return u, err
 	}
 	j.Uuid = u.String()
 
 	jj, err := db.ReadJob(u.String())
 	if err != nil {
-		return errors.InternalServerError(methodName, err.Error())
+		return u, err
 	}
-	_, err = submissionClient.Update(auth.C(), &submission.UpdateRequest{
-		Job: jj.ToProto(),
-	})
+	_, err = submissionClient.Update(auth.C(), &submission.UpdateRequest{Job: jj.ToProto()})
 	if err != nil {
-		return errors.InternalServerError(methodName, err.Error())
+		return u, err
 	}
 
-	err = db.EnqueueJob(int(req.Priority), u)
+	err = db.EnqueueJob(int(priority), u)
 	if err != nil {
-		return errors.InternalServerError(methodName, err.Error())
+		return u, err
 	}
-	go func() {
-		qi, err := db.GetFirstJobInQueue()
-		if err != nil {
-			log.WithFields(logrus.Fields{
-				"req": req,
-				"qi":  qi,
-				"err": err,
-			}).Error("couldn't get first job in queue in Create")
-		}
-		log.WithFields(logrus.Fields{
-			"first_in_queue": qi.JobUUID,
-			"current":        j.Uuid,
-		}).Debug("First job in queue vs current job")
-		if qi.JobUUID.String() == j.Uuid {
-			dispatch.Next()
-		}
-	}()
-	rsp.Uuid = u.String()
+	dispatch.Notify()
 
-	return nil
+	return u, nil
 }
 
 func (*JobsService) Read(ctx context.Context, req *job.ReadRequest, rsp *job.ReadResponse) error {
@@ -110,6 +107,10 @@ func (*JobsService) Read(ctx context.Context, req *job.ReadRequest, rsp *job.Rea
 		return errors.BadRequest(methodName, "invalid uuid")
 	}
 
+	if !perms.HasScope(ctx, "read") {
+		return errors.Forbidden(methodName, "you are not allowed to read jobs")
+	}
+
 	j, err := db.ReadJob(req.Uuid)
 	if err != nil {
 		if err == db.ErrNotFound {
@@ -125,9 +126,18 @@ func (*JobsService) Search(ctx context.Context, req *job.SearchRequest, rsp *job
 	methodName := jobSName("Search")
 	if req.Limit == 0 {
 		req.Limit = 10
+	} else if req.Limit > 250 {
+		req.Limit = 250
+	}
+	if _, ok := job.Order_name[int32(req.Order)]; !ok {
+		return errors.BadRequest(methodName, "invalid order")
+	}
+
+	if !perms.HasScope(ctx, "read") {
+		return errors.Forbidden(methodName, "you are not allowed to search jobs")
 	}
 
-	jobs, err := db.SearchJob(req)
+	jobs, cnt, err := db.SearchJob(req)
 	if err != nil {
 		return errors.InternalServerError(methodName, err.Error())
 	}
@@ -135,6 +145,41 @@ func (*JobsService) Search(ctx context.Context, req *job.SearchRequest, rsp *job
 	for _, j := range jobs {
 		rsp.Jobs = append(rsp.Jobs, j.ToProto())
 	}
+	rsp.Meta = &searchmeta.Meta{
+		PerPage: uint32(req.Limit),
+		Count:   uint32(len(jobs)),
+		Total:   cnt,
+	}
+
+	return nil
+}
+
+func (*JobsService) Acquire(ctx context.Context, req *job.AcquireRequest, rsp *job.AcquireResponse) error {
+	methodName := jobSName("Acquire")
+	switch {
+	case len(req.EvalName) == 0:
+		return errors.BadRequest(methodName, "invalid eval_name")
+	case req.Capacity < 1:
+		return errors.BadRequest(methodName, "invalid capacity")
+	case req.Capabilities == nil:
+		return errors.BadRequest(methodName, "invalid capabilities")
+	}
+
+	if !perms.HasScope(ctx, "acquire") {
+		return errors.Forbidden(methodName, "you are not allowed to acquire jobs")
+	}
+
+	wait, err := ptypes.Duration(req.Wait)
+	if err != nil {
+		wait = 0
+	}
+	jobs, err := dispatch.Acquire(ctx, req.EvalName, int(req.Capacity), req.Capabilities, wait)
+	for _, j := range jobs {
+		rsp.Jobs = append(rsp.Jobs, j.ToProto())
+	}
+	if err != nil && len(jobs) == 0 {
+		return errors.InternalServerError(methodName, err.Error())
+	}
 
 	return nil
 }
@@ -144,6 +189,9 @@ func (*JobsService) Finish(ctx context.Context, req *job.FinishRequest, rsp *job
 	if _, err := uuid.Parse(req.JobUuid); err != nil {
 		return errors.BadRequest(methodName, "invalid job_uuid")
 	}
+	if len(req.EvalName) == 0 {
+		return errors.BadRequest(methodName, "invalid eval_name")
+	}
 	if req.Result == nil {
 		return errors.BadRequest(methodName, "invalid result")
 	}
@@ -155,10 +203,12 @@ func (*JobsService) Finish(ctx context.Context, req *job.FinishRequest, rsp *job
 		return errors.Forbidden(methodName, "you are not allowed to finish jobs")
 	}
 
-	err := db.FinishJob(req)
+	err := db.FinishJob(req, req.EvalName)
 	if err != nil {
 		if err == db.ErrNotFound {
 			return errors.NotFound(methodName, "job not found")
+		} else if err == db.ErrLeaseLost {
+			return errors.Conflict(methodName, "job is not leased by this eval")
 		}
 		return errors.InternalServerError(methodName, err.Error())
 	}
@@ -166,32 +216,121 @@ func (*JobsService) Finish(ctx context.Context, req *job.FinishRequest, rsp *job
 	if err != nil {
 		return errors.InternalServerError(methodName, err.Error())
 	}
+	dispatch.ObserveEvaluation(j)
 	pj := j.ToProto()
 	pj.Result = req.Result
-	_, err = submissionClient.Update(auth.C(), &submission.UpdateRequest{Job: pj})
+	// the result of a rejudged submission replaces the previous one only now
+	_, err = submissionClient.Update(auth.C(), &submission.UpdateRequest{
+		Job:         pj,
+		ResetResult: true,
+	})
 	if err != nil {
 		return errors.InternalServerError(methodName, err.Error())
 	}
-	qi, err := db.DequeueJob()
-	if err == db.ErrNotFound {
-		rsp.NextJob = nil
-	} else if err != nil {
+
+	return nil
+}
+
+func (*JobsService) Heartbeat(ctx context.Context, req *job.HeartbeatRequest, rsp *job.HeartbeatResponse) error {
+	methodName := jobSName("Heartbeat")
+	jobUUID, err := uuid.Parse(req.JobUuid)
+	if err != nil {
+		return errors.BadRequest(methodName, "invalid job_uuid")
+	}
+	if len(req.EvalName) == 0 {
+		return errors.BadRequest(methodName, "invalid eval_name")
+	}
+
+	if !perms.HasScope(ctx, "finish") {
+		return errors.Forbidden(methodName, "you are not allowed to send heartbeats")
+	}
+
+	until := dispatch.LeaseExpiry()
+	err = db.ExtendLease(jobUUID, req.EvalName, until)
+	if err != nil {
+		if err == db.ErrLeaseLost {
+			return errors.Conflict(methodName, "job is not leased by this eval")
+		}
 		return errors.InternalServerError(methodName, err.Error())
-	} else {
-		j, err := db.ReadJob(qi.JobUUID.String())
-		if err != nil {
-			return errors.InternalServerError(methodName, err.Error())
+	}
+	rsp.LeaseExpiresAt, _ = ptypes.TimestampProto(until)
+
+	return nil
+}
+
+// abortJob removes the job from the queue, or tells its eval to stop evaluating it
+func abortJob(j *dbjob.Job) error {
+	if err := db.CancelJob(j.UUID); err != nil {
+		return err
+	}
+	if j.State != dbjob.PROCESSING {
+		return nil
+	}
+
+	l := log.WithFields(logrus.Fields{
+		"job_uuid": j.UUID,
+		"eval_id":  j.EvalID,
+	})
+	ni := status.NodeByName(j.EvalID)
+	if ni == nil {
+		l.Warn("The eval of the cancelled job is not alive")
+		return nil
+	}
+	req := client.NewRequest(econsts.ServiceName, "EvalService.Abort", &eval.AbortRequest{JobUuid: j.UUID.String()})
+	err := client.Call(auth.C(), req, &eval.AbortResponse{}, client.WithAddress(ni.Address))
+	if err != nil {
+		// the eval finished the job in the meantime, its result is discarded
+		l.WithError(err).Warn("Couldn't abort job")
+	}
+
+	return nil
+}
+
+func (*JobsService) Cancel(ctx context.Context, req *job.CancelRequest, rsp *job.CancelResponse) error {
+	methodName := jobSName("Cancel")
+	if _, err := uuid.Parse(req.JobUuid); err != nil {
+		return errors.BadRequest(methodName, "invalid job_uuid")
+	}
+
+	if !perms.HasScope(ctx, "cancel") {
+		return errors.Forbidden(methodName, "you are not allowed to cancel jobs")
+	}
+
+	j, err := db.ReadJob(req.JobUuid)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "job not found")
 		}
-		// if the context doesn't have metadata then it's a problem with micro
-		meta, _ := metadata.FromContext(ctx)
-		// and if there's no x-eval-name, the server will panic.
-		// i will read the stack trace and punish myself somehow for letting that happen
-		evalName := meta["X-Eval-Name"]
-		err = db.SetJobStateAndEvalID(j.UUID, dbjob.PROCESSING, evalName)
-		if err != nil {
-			return errors.InternalServerError(methodName, err.Error())
+		return errors.InternalServerError(methodName, err.Error())
+	}
+	if j.State == dbjob.DONE {
+		return errors.BadRequest(methodName, "job is finished")
+	}
+
+	err = abortJob(j)
+	if err != nil {
+		if err == db.ErrLeaseLost {
+			return errors.BadRequest(methodName, "job is finished")
 		}
-		rsp.NextJob = j.ToProto()
+		return errors.InternalServerError(methodName, err.Error())
 	}
+
+	j, err = db.ReadJob(req.JobUuid)
+	if err != nil {
+		return errors.InternalServerError(methodName, err.Error())
+	}
+	pj := j.ToProto()
+	pj.Result = &result.Result{
+		Score:   "0",
+		Verdict: result.Verdict_CANCELLED,
+	}
+	_, err = submissionClient.Update(auth.C(), &submission.UpdateRequest{
+		Job:         pj,
+		ResetResult: true,
+	})
+	if err != nil {
+		return errors.InternalServerError(methodName, err.Error())
+	}
+
 	return nil
 }
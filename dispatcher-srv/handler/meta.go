@@ -2,8 +2,16 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
+	"github.com/micro/go-micro/errors"
+	"github.com/xmc-dev/xmc/common/metrics"
+	"github.com/xmc-dev/xmc/dispatcher-srv/consts"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db"
+	dbjob "github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	"github.com/xmc-dev/xmc/dispatcher-srv/dispatch"
+	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
 	"github.com/xmc-dev/xmc/dispatcher-srv/proto/meta"
 	"github.com/xmc-dev/xmc/dispatcher-srv/status"
 	"github.com/xmc-dev/xmc/eval-srv/proto/eval"
@@ -13,6 +21,10 @@ type MetaService struct {
 	NodeInfos *[]*status.NodeInfo
 }
 
+func metaSName(method string) string {
+	return fmt.Sprintf("%s.MetaService.%s", consts.ServiceName, method)
+}
+
 func (ms *MetaService) GetEvals(ctx context.Context, req *meta.GetEvalsRequest, rsp *meta.GetEvalsResponse) error {
 	if req.Refresh {
 		*ms.NodeInfos = status.HealthCheck()
@@ -26,7 +38,63 @@ func (ms *MetaService) GetEvals(ctx context.Context, req *meta.GetEvalsRequest,
 	return nil
 }
 
+// DispatchNext wakes up the evals that wait for jobs
 func (*MetaService) DispatchNext(ctx context.Context, req *meta.DispatchNextRequest, rsp *meta.DispatchNextResponse) error {
-	dispatch.Next()
+	dispatch.Notify()
+	return nil
+}
+
+func durationStats(hs metrics.HistogramSnapshot) *meta.DurationStats {
+	ds := &meta.DurationStats{
+		Count:      hs.Count,
+		SumSeconds: hs.Sum,
+	}
+	for _, b := range hs.Buckets {
+		ds.Buckets = append(ds.Buckets, &meta.Bucket{
+			UpperBoundSeconds: b.UpperBound,
+			Count:             b.Count,
+		})
+	}
+
+	return ds
+}
+
+func (ms *MetaService) GetMetrics(ctx context.Context, req *meta.GetMetricsRequest, rsp *meta.GetMetricsResponse) error {
+	methodName := metaSName("GetMetrics")
+	depth, err := db.QueueDepth()
+	if err != nil {
+		return errors.InternalServerError(methodName, err.Error())
+	}
+
+	classes := []int{}
+	for class := range job.PriorityClass_name {
+		classes = append(classes, int(class))
+	}
+	sort.Ints(classes)
+	rsp.JobWait = make(map[string]*meta.DurationStats)
+	for _, c := range classes {
+		class := job.PriorityClass(c)
+		rsp.QueueDepth = append(rsp.QueueDepth, &meta.QueueDepth{
+			PriorityClass: class,
+			Jobs:          depth[dbjob.PriorityClass(class)],
+		})
+		rsp.JobWait[class.String()] = durationStats(dispatch.JobWait(class))
+	}
+	rsp.EvaluationDuration = durationStats(dispatch.EvaluationDuration())
+
+	for _, ni := range *ms.NodeInfos {
+		rsp.Nodes = append(rsp.Nodes, &meta.NodeMetrics{
+			Id:                  ni.ID,
+			Name:                ni.Name,
+			BusyRatio:           ni.BusyRatio(),
+			CompileFailures:     ni.CompileFailures,
+			SandboxErrors:       ni.SandboxErrors,
+			HealthCheckFailures: status.HealthCheckFailures(ni.ID),
+		})
+		rsp.CompileFailures += ni.CompileFailures
+		rsp.SandboxErrors += ni.SandboxErrors
+	}
+	rsp.HealthCheckFailures = status.TotalHealthCheckFailures()
+
 	return nil
 }
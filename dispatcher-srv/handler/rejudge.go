@@ -0,0 +1,289 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/protobuf/ptypes"
+	perrors "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/xmc-dev/xmc/common/perms"
+	"github.com/xmc-dev/xmc/dispatcher-srv/auth"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/rejudge"
+	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+	"github.com/xmc-dev/xmc/xmc-core/proto/submission"
+	"github.com/xmc-dev/xmc/xmc-core/proto/task"
+	"github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
+)
+
+var taskClient = task.NewTaskServiceClient("xmc.srv.core", client.DefaultClient)
+var datasetClient = dataset.NewDatasetServiceClient("xmc.srv.core", client.DefaultClient)
+
+// rejudgePageSize is the number of submissions that are rejudged in a batch
+const rejudgePageSize = 100
+
+// rejudgeRetryInterval is how long the rejudger waits before resuming
+// the rejudges whose submissions couldn't be read
+const rejudgeRetryInterval = time.Minute
+
+// rejudgeNotify wakes up the rejudger when a rejudge is created
+var rejudgeNotify = make(chan struct{}, 1)
+
+// rejudgeBatch returns the next submissions of the rejudge. Only the submissions made before
+// the rejudge are selected, so that the offsets of the already rejudged ones don't change.
+func rejudgeBatch(r *rejudge.Rejudge) ([]*submission.Submission, error) {
+	if len(r.SubmissionID) > 0 {
+		if r.Processed > 0 {
+			return nil, nil
+		}
+		rsp, err := submissionClient.Read(auth.C(), &submission.ReadRequest{Id: r.SubmissionID})
+		if err != nil {
+			return nil, err
+		}
+		return []*submission.Submission{rsp.Submission}, nil
+	}
+
+	end, _ := ptypes.TimestampProto(r.CreatedAt)
+	rsp, err := submissionClient.Search(auth.C(), &submission.SearchRequest{
+		Limit:      rejudgePageSize,
+		Offset:     r.Processed,
+		TaskId:     r.TaskID,
+		DatasetId:  r.DatasetID,
+		TaskListId: r.TaskListID,
+		CreatedAt:  &tsrange.TimestampRange{End: end},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.Submissions, nil
+}
+
+// rejudgeCache caches the task lists of the tasks and the requirements of the datasets of the rejudged submissions
+type rejudgeCache struct {
+	taskListIDs  map[string]string
+	requirements map[string]*dataset.NodeRequirements
+}
+
+func newRejudgeCache() *rejudgeCache {
+	return &rejudgeCache{
+		taskListIDs:  make(map[string]string),
+		requirements: make(map[string]*dataset.NodeRequirements),
+	}
+}
+
+func (rc *rejudgeCache) taskListID(taskID string) (string, error) {
+	if id, ok := rc.taskListIDs[taskID]; ok {
+		return id, nil
+	}
+	rsp, err := taskClient.Read(auth.C(), &task.ReadRequest{Id: taskID})
+	if err != nil {
+		return "", perrors.Wrapf(err, "couldn't read task %s", taskID)
+	}
+	rc.taskListIDs[taskID] = rsp.Task.TaskListId
+
+	return rsp.Task.TaskListId, nil
+}
+
+func (rc *rejudgeCache) datasetRequirements(datasetID string) (*dataset.NodeRequirements, error) {
+	if r, ok := rc.requirements[datasetID]; ok {
+		return r, nil
+	}
+	rsp, err := datasetClient.Read(auth.C(), &dataset.ReadRequest{Id: datasetID})
+	if err != nil {
+		return nil, perrors.Wrapf(err, "couldn't read dataset %s", datasetID)
+	}
+	rc.requirements[datasetID] = rsp.Dataset.Requirements
+
+	return rsp.Dataset.Requirements, nil
+}
+
+// rejudgeSubmission cancels the unfinished jobs of the submission and creates a new one.
+// The evals read the code from the attachment of the submission. The previous result
+// of the submission is kept until the new job finishes.
+func rejudgeSubmission(s *submission.Submission, priority int32, rc *rejudgeCache) error {
+	taskListID, err := rc.taskListID(s.TaskId)
+	if err != nil {
+		return err
+	}
+	requirements, err := rc.datasetRequirements(s.DatasetId)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := db.UnfinishedJobs(s.Id)
+	if err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		if err := abortJob(j); err != nil && err != db.ErrLeaseLost {
+			return err
+		}
+	}
+
+	_, err = createJob(&job.Job{
+		DatasetId:     s.DatasetId,
+		AttachmentId:  s.AttachmentId,
+		Language:      s.Language,
+		SubmissionId:  s.Id,
+		TaskId:        s.TaskId,
+		UserId:        s.UserId,
+		TaskListId:    taskListID,
+		PriorityClass: job.PriorityClass_REJUDGE,
+		Requirements:  requirements,
+	}, priority)
+
+	return err
+}
+
+// processRejudge rejudges the remaining submissions of the rejudge, saving the progress
+// after each one. The submissions that can't be rejudged are recorded as failures.
+// An error is returned only if the rejudge couldn't advance, in which case it is resumed later.
+func processRejudge(r *rejudge.Rejudge) error {
+	l := log.WithField("rejudge_uuid", r.UUID)
+	rc := newRejudgeCache()
+	for {
+		subs, err := rejudgeBatch(r)
+		if err != nil {
+			me := errors.Parse(err.Error())
+			if len(r.SubmissionID) == 0 || me.Code != 404 {
+				return perrors.Wrap(err, "couldn't read the rejudged submissions")
+			}
+			err = db.AdvanceRejudge(r.UUID, 1, &rejudge.Failure{
+				SubmissionID: r.SubmissionID,
+				Error:        "submission not found",
+			})
+			if err != nil {
+				return err
+			}
+			subs = nil
+		}
+
+		for _, s := range subs {
+			var failure *rejudge.Failure
+			if err := rejudgeSubmission(s, r.Priority, rc); err != nil {
+				l.WithError(err).WithField("submission_id", s.Id).Warn("Couldn't rejudge submission")
+				failure = &rejudge.Failure{
+					SubmissionID: s.Id,
+					Error:        err.Error(),
+				}
+			}
+			if err := db.AdvanceRejudge(r.UUID, r.Processed+1, failure); err != nil {
+				return err
+			}
+			r.Processed++
+		}
+
+		if len(r.SubmissionID) > 0 || len(subs) < rejudgePageSize {
+			break
+		}
+	}
+
+	if err := db.FinishRejudge(r.UUID, time.Now()); err != nil {
+		return err
+	}
+	l.WithField("count", r.Processed).Info("Rejudged submissions")
+
+	return nil
+}
+
+// RunRejudger processes the unfinished rejudges in the order in which they were created,
+// including the ones interrupted by a restart of the dispatcher
+func RunRejudger() {
+	for {
+		rs, err := db.UnfinishedRejudges()
+		if err != nil {
+			log.WithError(err).Error("Couldn't get unfinished rejudges")
+		}
+		for _, r := range rs {
+			if err := processRejudge(r); err != nil {
+				log.WithError(err).WithField("rejudge_uuid", r.UUID).Error("Couldn't process rejudge")
+			}
+		}
+
+		select {
+		case <-rejudgeNotify:
+		case <-time.After(rejudgeRetryInterval):
+		}
+	}
+}
+
+func (*JobsService) Rejudge(ctx context.Context, req *job.RejudgeRequest, rsp *job.RejudgeResponse) error {
+	methodName := jobSName("Rejudge")
+	switch {
+	case len(req.SubmissionId) == 0 && len(req.TaskId) == 0 && len(req.DatasetId) == 0 && len(req.TaskListId) == 0:
+		return errors.BadRequest(methodName, "no submissions selected")
+	case req.Priority < 0:
+		return errors.BadRequest(methodName, "invalid priority")
+	}
+	if req.Priority == 0 {
+		req.Priority = 1
+	}
+
+	if !perms.HasScope(ctx, "rejudge") {
+		return errors.Forbidden(methodName, "you are not allowed to rejudge submissions")
+	}
+
+	if len(req.SubmissionId) > 0 {
+		_, err := submissionClient.Read(auth.C(), &submission.ReadRequest{Id: req.SubmissionId})
+		if err != nil {
+			me := errors.Parse(err.Error())
+			if me.Code == 404 {
+				return errors.NotFound(methodName, "submission not found")
+			}
+			return errors.InternalServerError(methodName, err.Error())
+		}
+	}
+
+	u, err := db.CreateRejudge(&rejudge.Rejudge{
+		SubmissionID: req.SubmissionId,
+		TaskID:       req.TaskId,
+		DatasetID:    req.DatasetId,
+		TaskListID:   req.TaskListId,
+		Priority:     req.Priority,
+	})
+	if err != nil {
+		return errors.InternalServerError(methodName, err.Error())
+	}
+	select {
+	case rejudgeNotify <- struct{}{}:
+	default:
+	}
+	log.WithFields(logrus.Fields{
+		"rejudge_uuid":  u,
+		"submission_id": req.SubmissionId,
+		"task_id":       req.TaskId,
+		"dataset_id":    req.DatasetId,
+		"task_list_id":  req.TaskListId,
+	}).Info("Started rejudge")
+	rsp.RejudgeUuid = u.String()
+
+	return nil
+}
+
+func (*JobsService) ReadRejudge(ctx context.Context, req *job.ReadRejudgeRequest, rsp *job.ReadRejudgeResponse) error {
+	methodName := jobSName("ReadRejudge")
+	if _, err := uuid.Parse(req.Uuid); err != nil {
+		return errors.BadRequest(methodName, "invalid uuid")
+	}
+
+	if !perms.HasScope(ctx, "rejudge") {
+		return errors.Forbidden(methodName, "you are not allowed to read rejudges")
+	}
+
+	r, err := db.ReadRejudge(req.Uuid)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "rejudge not found")
+		}
+		return errors.InternalServerError(methodName, err.Error())
+	}
+	rsp.Rejudge = r.ToProto()
+
+	return nil
+}
@@ -1,13 +1,13 @@
 package main
 
 import (
-	"fmt"
 	"time"
 
 	mlog "github.com/micro/go-log"
 	"github.com/sirupsen/logrus"
 	micrologrus "github.com/tudurom/micro-logrus"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+	"github.com/xmc-dev/xmc/common/metrics"
 	"github.com/xmc-dev/xmc/common/perms"
 	"github.com/xmc-dev/xmc/common/wait"
 	"github.com/xmc-dev/xmc/dispatcher-srv/auth"
@@ -25,8 +25,6 @@ var srv *service.Service
 var log = logrus.WithField("prefix", "main")
 var nis []*status.NodeInfo
 
-var firstCheckDone = make(chan bool)
-
 func sendEv() {
 	t := time.NewTicker(time.Duration(srv.HealthCheckInterval) * time.Second)
 
@@ -34,19 +32,10 @@ func sendEv() {
 		nis = status.HealthCheck()
 		if len(nis) == 0 {
 			log.Warn("No node is alive")
-		} else {
-			firstCheckDone <- true
 		}
 	}
 }
 
-func kickstartDispatch() {
-	fmt.Println("!!waiting for first check")
-	<-firstCheckDone
-	fmt.Println("!!first check done")
-	dispatch.Next()
-}
-
 func main() {
 	service.MainService = service.NewService()
 	srv = service.MainService
@@ -57,7 +46,7 @@ func main() {
 	mlog.SetLogger(ml)
 	srv.Micro.Init()
 
-	if err := wait.For("xmc.srv.auth", "xmc.srv.eval", "xmc.srv.core"); err != nil {
+	if err := wait.For("xmc.srv.auth", "xmc.srv.core"); err != nil {
 		log.WithError(err).Fatal("Couldn't wait")
 	}
 	if err := perms.GetPubkey(service.MainService.Consul.KV()); err != nil {
@@ -92,7 +81,11 @@ func main() {
 	status.InitHealthCheck()
 	// Try to dispatch something to get the flow started
 	go sendEv()
-	go kickstartDispatch()
+	go dispatch.RunReaper()
+	go handler.RunRejudger()
+	if len(srv.MetricsAddress) > 0 {
+		go metrics.Serve(srv.MetricsAddress)
+	}
 
 	if err := srv.Micro.Run(); err != nil {
 		logrus.Fatal("Couldn't run service: ", err)
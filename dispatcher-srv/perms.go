@@ -4,6 +4,10 @@ const (
 	rawPerms = `
 create:
 finish:
+acquire:
+cancel:
+rejudge:
+read:
 `
 	treeRoot = "xmc.dispatcher"
 )
@@ -16,16 +16,33 @@ It has these top-level messages:
 	ReadResponse
 	SearchRequest
 	SearchResponse
+	NodeCapabilities
+	AcquireRequest
+	AcquireResponse
 	FinishRequest
 	FinishResponse
+	HeartbeatRequest
+	HeartbeatResponse
+	CancelRequest
+	CancelResponse
+	RejudgeRequest
+	RejudgeResponse
+	RejudgeFailure
+	Rejudge
+	ReadRejudgeRequest
+	ReadRejudgeResponse
 */
 package job
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import xmc_srv_core_dataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 import xmc_srv_core_result "github.com/xmc-dev/xmc/xmc-core/proto/result"
-import google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
+import xmc_srv_core_searchmeta "github.com/xmc-dev/xmc/xmc-core/proto/searchmeta"
+import xmc_srv_core_tsrange "github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
+import google_protobuf "github.com/golang/protobuf/ptypes/duration"
+import google_protobuf2 "github.com/golang/protobuf/ptypes/timestamp"
 
 import (
 	client "github.com/micro/go-micro/client"
@@ -68,6 +85,64 @@ func (x State) String() string {
 }
 func (State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// PriorityClass groups the jobs by their urgency. The jobs of a more urgent class
+// are always dispatched before the others: CONTEST_LIVE, then PRACTICE, then REJUDGE.
+type PriorityClass int32
+
+const (
+	// submissions made outside of a running contest
+	PriorityClass_PRACTICE PriorityClass = 0
+	// submissions made during a running contest
+	PriorityClass_CONTEST_LIVE PriorityClass = 1
+	// jobs created by Rejudge
+	PriorityClass_REJUDGE PriorityClass = 2
+)
+
+var PriorityClass_name = map[int32]string{
+	0: "PRACTICE",
+	1: "CONTEST_LIVE",
+	2: "REJUDGE",
+}
+var PriorityClass_value = map[string]int32{
+	"PRACTICE":     0,
+	"CONTEST_LIVE": 1,
+	"REJUDGE":      2,
+}
+
+func (x PriorityClass) String() string {
+	return proto.EnumName(PriorityClass_name, int32(x))
+}
+func (PriorityClass) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// Order is the order of the jobs returned by Search
+type Order int32
+
+const (
+	Order_CREATED_AT_DESC Order = 0
+	Order_CREATED_AT_ASC  Order = 1
+	// unfinished jobs come last
+	Order_FINISHED_AT_DESC Order = 2
+	Order_FINISHED_AT_ASC  Order = 3
+)
+
+var Order_name = map[int32]string{
+	0: "CREATED_AT_DESC",
+	1: "CREATED_AT_ASC",
+	2: "FINISHED_AT_DESC",
+	3: "FINISHED_AT_ASC",
+}
+var Order_value = map[string]int32{
+	"CREATED_AT_DESC":  0,
+	"CREATED_AT_ASC":   1,
+	"FINISHED_AT_DESC": 2,
+	"FINISHED_AT_ASC":  3,
+}
+
+func (x Order) String() string {
+	return proto.EnumName(Order_name, int32(x))
+}
+func (Order) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
 type StateValue struct {
 	Value State `protobuf:"varint,1,opt,name=value,enum=xmc.srv.dispatcher.job.State" json:"value,omitempty"`
 }
@@ -92,10 +167,24 @@ type Job struct {
 	EvalId       string                      `protobuf:"bytes,5,opt,name=eval_id,json=evalId" json:"eval_id,omitempty"`
 	Result       *xmc_srv_core_result.Result `protobuf:"bytes,6,opt,name=result" json:"result,omitempty"`
 	State        State                       `protobuf:"varint,7,opt,name=state,enum=xmc.srv.dispatcher.job.State" json:"state,omitempty"`
-	CreatedAt    *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
-	FinishedAt   *google_protobuf1.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt" json:"finished_at,omitempty"`
+	CreatedAt    *google_protobuf2.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	FinishedAt   *google_protobuf2.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt" json:"finished_at,omitempty"`
 	SubmissionId string                      `protobuf:"bytes,10,opt,name=submission_id,json=submissionId" json:"submission_id,omitempty"`
 	TaskId       string                      `protobuf:"bytes,11,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	// the job is requeued if the eval doesn't finish it or send a heartbeat until this time
+	LeaseExpiresAt *google_protobuf2.Timestamp `protobuf:"bytes,12,opt,name=lease_expires_at,json=leaseExpiresAt" json:"lease_expires_at,omitempty"`
+	// the number of times the job was requeued after its lease expired
+	Retries int32 `protobuf:"varint,13,opt,name=retries" json:"retries,omitempty"`
+	// the author of the submission
+	UserId        string        `protobuf:"bytes,14,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	TaskListId    string        `protobuf:"bytes,15,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+	PriorityClass PriorityClass `protobuf:"varint,16,opt,name=priority_class,json=priorityClass,enum=xmc.srv.dispatcher.job.PriorityClass" json:"priority_class,omitempty"`
+	// the job is dispatched only to the eval nodes that satisfy the requirements of its dataset
+	Requirements *xmc_srv_core_dataset.NodeRequirements `protobuf:"bytes,17,opt,name=requirements" json:"requirements,omitempty"`
+	// the time at which the job was last acquired by an eval
+	StartedAt *google_protobuf2.Timestamp `protobuf:"bytes,18,opt,name=started_at,json=startedAt" json:"started_at,omitempty"`
+	// the attachment that holds the code, used when code is empty
+	AttachmentId string `protobuf:"bytes,19,opt,name=attachment_id,json=attachmentId" json:"attachment_id,omitempty"`
 }
 
 func (m *Job) Reset()                    { *m = Job{} }
@@ -152,14 +241,14 @@ func (m *Job) GetState() State {
 	return State_WAITING
 }
 
-func (m *Job) GetCreatedAt() *google_protobuf1.Timestamp {
+func (m *Job) GetCreatedAt() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *Job) GetFinishedAt() *google_protobuf1.Timestamp {
+func (m *Job) GetFinishedAt() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.FinishedAt
 	}
@@ -180,8 +269,65 @@ func (m *Job) GetTaskId() string {
 	return ""
 }
 
+func (m *Job) GetLeaseExpiresAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.LeaseExpiresAt
+	}
+	return nil
+}
+
+func (m *Job) GetRetries() int32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Job) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Job) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+func (m *Job) GetPriorityClass() PriorityClass {
+	if m != nil {
+		return m.PriorityClass
+	}
+	return PriorityClass_PRACTICE
+}
+
+func (m *Job) GetRequirements() *xmc_srv_core_dataset.NodeRequirements {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+func (m *Job) GetStartedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.StartedAt
+	}
+	return nil
+}
+
+func (m *Job) GetAttachmentId() string {
+	if m != nil {
+		return m.AttachmentId
+	}
+	return ""
+}
+
 type CreateRequest struct {
-	Job      *Job  `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// the priority of the job among the jobs of the same class and user or task list
 	Priority int32 `protobuf:"varint,2,opt,name=priority" json:"priority,omitempty"`
 }
 
@@ -252,15 +398,20 @@ func (m *ReadResponse) GetJob() *Job {
 	return nil
 }
 
+// The filters of SearchRequest are combined, a job must match all of them
 type SearchRequest struct {
-	Limit        uint64      `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
-	Offset       uint64      `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
-	TaskId       string      `protobuf:"bytes,3,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
-	DatasetId    string      `protobuf:"bytes,4,opt,name=dataset_id,json=datasetId" json:"dataset_id,omitempty"`
-	Language     string      `protobuf:"bytes,5,opt,name=language" json:"language,omitempty"`
-	EvalId       string      `protobuf:"bytes,6,opt,name=eval_id,json=evalId" json:"eval_id,omitempty"`
-	State        *StateValue `protobuf:"bytes,7,opt,name=state" json:"state,omitempty"`
-	ErrorMessage string      `protobuf:"bytes,8,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+	Limit        uint64                               `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	Offset       uint64                               `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	TaskId       string                               `protobuf:"bytes,3,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	DatasetId    string                               `protobuf:"bytes,4,opt,name=dataset_id,json=datasetId" json:"dataset_id,omitempty"`
+	Language     string                               `protobuf:"bytes,5,opt,name=language" json:"language,omitempty"`
+	EvalId       string                               `protobuf:"bytes,6,opt,name=eval_id,json=evalId" json:"eval_id,omitempty"`
+	State        *StateValue                          `protobuf:"bytes,7,opt,name=state" json:"state,omitempty"`
+	CreatedAt    *xmc_srv_core_tsrange.TimestampRange `protobuf:"bytes,9,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	FinishedAt   *xmc_srv_core_tsrange.TimestampRange `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt" json:"finished_at,omitempty"`
+	Order        Order                                `protobuf:"varint,11,opt,name=order,enum=xmc.srv.dispatcher.job.Order" json:"order,omitempty"`
+	SubmissionId string                               `protobuf:"bytes,12,opt,name=submission_id,json=submissionId" json:"submission_id,omitempty"`
+	UserId       string                               `protobuf:"bytes,13,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
@@ -317,15 +468,44 @@ func (m *SearchRequest) GetState() *StateValue {
 	return nil
 }
 
-func (m *SearchRequest) GetErrorMessage() string {
+func (m *SearchRequest) GetCreatedAt() *xmc_srv_core_tsrange.TimestampRange {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetFinishedAt() *xmc_srv_core_tsrange.TimestampRange {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order_CREATED_AT_DESC
+}
+
+func (m *SearchRequest) GetSubmissionId() string {
+	if m != nil {
+		return m.SubmissionId
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetUserId() string {
 	if m != nil {
-		return m.ErrorMessage
+		return m.UserId
 	}
 	return ""
 }
 
 type SearchResponse struct {
-	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty"`
+	Jobs []*Job                        `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty"`
+	Meta *xmc_srv_core_searchmeta.Meta `protobuf:"bytes,2,opt,name=meta" json:"meta,omitempty"`
 }
 
 func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
@@ -340,15 +520,123 @@ func (m *SearchResponse) GetJobs() []*Job {
 	return nil
 }
 
+func (m *SearchResponse) GetMeta() *xmc_srv_core_searchmeta.Meta {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+// NodeCapabilities describe the jobs an eval can process
+type NodeCapabilities struct {
+	// the codes of the installed languages
+	Languages      []string          `protobuf:"bytes,1,rep,name=languages" json:"languages,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BenchmarkScore float64           `protobuf:"fixed64,3,opt,name=benchmark_score,json=benchmarkScore" json:"benchmark_score,omitempty"`
+	CpuModel       string            `protobuf:"bytes,4,opt,name=cpu_model,json=cpuModel" json:"cpu_model,omitempty"`
+}
+
+func (m *NodeCapabilities) Reset()                    { *m = NodeCapabilities{} }
+func (m *NodeCapabilities) String() string            { return proto.CompactTextString(m) }
+func (*NodeCapabilities) ProtoMessage()               {}
+func (*NodeCapabilities) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *NodeCapabilities) GetLanguages() []string {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+func (m *NodeCapabilities) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *NodeCapabilities) GetBenchmarkScore() float64 {
+	if m != nil {
+		return m.BenchmarkScore
+	}
+	return 0
+}
+
+func (m *NodeCapabilities) GetCpuModel() string {
+	if m != nil {
+		return m.CpuModel
+	}
+	return ""
+}
+
+type AcquireRequest struct {
+	EvalName string `protobuf:"bytes,1,opt,name=eval_name,json=evalName" json:"eval_name,omitempty"`
+	// the maximum number of jobs to lease
+	Capacity     int32             `protobuf:"varint,2,opt,name=capacity" json:"capacity,omitempty"`
+	Capabilities *NodeCapabilities `protobuf:"bytes,3,opt,name=capabilities" json:"capabilities,omitempty"`
+	// how long to wait for jobs if there are none in the queue, capped by the dispatcher
+	Wait *google_protobuf.Duration `protobuf:"bytes,4,opt,name=wait" json:"wait,omitempty"`
+}
+
+func (m *AcquireRequest) Reset()                    { *m = AcquireRequest{} }
+func (m *AcquireRequest) String() string            { return proto.CompactTextString(m) }
+func (*AcquireRequest) ProtoMessage()               {}
+func (*AcquireRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *AcquireRequest) GetEvalName() string {
+	if m != nil {
+		return m.EvalName
+	}
+	return ""
+}
+
+func (m *AcquireRequest) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *AcquireRequest) GetCapabilities() *NodeCapabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *AcquireRequest) GetWait() *google_protobuf.Duration {
+	if m != nil {
+		return m.Wait
+	}
+	return nil
+}
+
+type AcquireResponse struct {
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty"`
+}
+
+func (m *AcquireResponse) Reset()                    { *m = AcquireResponse{} }
+func (m *AcquireResponse) String() string            { return proto.CompactTextString(m) }
+func (*AcquireResponse) ProtoMessage()               {}
+func (*AcquireResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *AcquireResponse) GetJobs() []*Job {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
 type FinishRequest struct {
-	JobUuid string                      `protobuf:"bytes,1,opt,name=job_uuid,json=jobUuid" json:"job_uuid,omitempty"`
-	Result  *xmc_srv_core_result.Result `protobuf:"bytes,2,opt,name=result" json:"result,omitempty"`
+	JobUuid  string                      `protobuf:"bytes,1,opt,name=job_uuid,json=jobUuid" json:"job_uuid,omitempty"`
+	Result   *xmc_srv_core_result.Result `protobuf:"bytes,2,opt,name=result" json:"result,omitempty"`
+	EvalName string                      `protobuf:"bytes,3,opt,name=eval_name,json=evalName" json:"eval_name,omitempty"`
 }
 
 func (m *FinishRequest) Reset()                    { *m = FinishRequest{} }
 func (m *FinishRequest) String() string            { return proto.CompactTextString(m) }
 func (*FinishRequest) ProtoMessage()               {}
-func (*FinishRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*FinishRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *FinishRequest) GetJobUuid() string {
 	if m != nil {
@@ -364,18 +652,295 @@ func (m *FinishRequest) GetResult() *xmc_srv_core_result.Result {
 	return nil
 }
 
+func (m *FinishRequest) GetEvalName() string {
+	if m != nil {
+		return m.EvalName
+	}
+	return ""
+}
+
 type FinishResponse struct {
-	NextJob *Job `protobuf:"bytes,1,opt,name=next_job,json=nextJob" json:"next_job,omitempty"`
 }
 
 func (m *FinishResponse) Reset()                    { *m = FinishResponse{} }
 func (m *FinishResponse) String() string            { return proto.CompactTextString(m) }
 func (*FinishResponse) ProtoMessage()               {}
-func (*FinishResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*FinishResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+type HeartbeatRequest struct {
+	JobUuid  string `protobuf:"bytes,1,opt,name=job_uuid,json=jobUuid" json:"job_uuid,omitempty"`
+	EvalName string `protobuf:"bytes,2,opt,name=eval_name,json=evalName" json:"eval_name,omitempty"`
+}
+
+func (m *HeartbeatRequest) Reset()                    { *m = HeartbeatRequest{} }
+func (m *HeartbeatRequest) String() string            { return proto.CompactTextString(m) }
+func (*HeartbeatRequest) ProtoMessage()               {}
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *HeartbeatRequest) GetJobUuid() string {
+	if m != nil {
+		return m.JobUuid
+	}
+	return ""
+}
+
+func (m *HeartbeatRequest) GetEvalName() string {
+	if m != nil {
+		return m.EvalName
+	}
+	return ""
+}
+
+type HeartbeatResponse struct {
+	LeaseExpiresAt *google_protobuf2.Timestamp `protobuf:"bytes,1,opt,name=lease_expires_at,json=leaseExpiresAt" json:"lease_expires_at,omitempty"`
+}
+
+func (m *HeartbeatResponse) Reset()                    { *m = HeartbeatResponse{} }
+func (m *HeartbeatResponse) String() string            { return proto.CompactTextString(m) }
+func (*HeartbeatResponse) ProtoMessage()               {}
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *HeartbeatResponse) GetLeaseExpiresAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.LeaseExpiresAt
+	}
+	return nil
+}
+
+type CancelRequest struct {
+	JobUuid string `protobuf:"bytes,1,opt,name=job_uuid,json=jobUuid" json:"job_uuid,omitempty"`
+}
+
+func (m *CancelRequest) Reset()                    { *m = CancelRequest{} }
+func (m *CancelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelRequest) ProtoMessage()               {}
+func (*CancelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *CancelRequest) GetJobUuid() string {
+	if m != nil {
+		return m.JobUuid
+	}
+	return ""
+}
+
+type CancelResponse struct {
+}
+
+func (m *CancelResponse) Reset()                    { *m = CancelResponse{} }
+func (m *CancelResponse) String() string            { return proto.CompactTextString(m) }
+func (*CancelResponse) ProtoMessage()               {}
+func (*CancelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+// RejudgeRequest selects the submissions to rejudge. If submission_id is set,
+// only that submission is rejudged, else the submissions that match all the other filters.
+type RejudgeRequest struct {
+	SubmissionId string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId" json:"submission_id,omitempty"`
+	TaskId       string `protobuf:"bytes,2,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	DatasetId    string `protobuf:"bytes,3,opt,name=dataset_id,json=datasetId" json:"dataset_id,omitempty"`
+	TaskListId   string `protobuf:"bytes,4,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+	// the priority of the new jobs, defaults to 1
+	Priority int32 `protobuf:"varint,5,opt,name=priority" json:"priority,omitempty"`
+}
+
+func (m *RejudgeRequest) Reset()                    { *m = RejudgeRequest{} }
+func (m *RejudgeRequest) String() string            { return proto.CompactTextString(m) }
+func (*RejudgeRequest) ProtoMessage()               {}
+func (*RejudgeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *RejudgeRequest) GetSubmissionId() string {
+	if m != nil {
+		return m.SubmissionId
+	}
+	return ""
+}
+
+func (m *RejudgeRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *RejudgeRequest) GetDatasetId() string {
+	if m != nil {
+		return m.DatasetId
+	}
+	return ""
+}
+
+func (m *RejudgeRequest) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+func (m *RejudgeRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type RejudgeResponse struct {
+	RejudgeUuid string `protobuf:"bytes,2,opt,name=rejudge_uuid,json=rejudgeUuid" json:"rejudge_uuid,omitempty"`
+}
+
+func (m *RejudgeResponse) Reset()                    { *m = RejudgeResponse{} }
+func (m *RejudgeResponse) String() string            { return proto.CompactTextString(m) }
+func (*RejudgeResponse) ProtoMessage()               {}
+func (*RejudgeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *RejudgeResponse) GetRejudgeUuid() string {
+	if m != nil {
+		return m.RejudgeUuid
+	}
+	return ""
+}
+
+// RejudgeFailure is a submission that couldn't be rejudged
+type RejudgeFailure struct {
+	SubmissionId string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId" json:"submission_id,omitempty"`
+	Error        string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *RejudgeFailure) Reset()                    { *m = RejudgeFailure{} }
+func (m *RejudgeFailure) String() string            { return proto.CompactTextString(m) }
+func (*RejudgeFailure) ProtoMessage()               {}
+func (*RejudgeFailure) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *RejudgeFailure) GetSubmissionId() string {
+	if m != nil {
+		return m.SubmissionId
+	}
+	return ""
+}
+
+func (m *RejudgeFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Rejudge struct {
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid" json:"uuid,omitempty"`
+	SubmissionId string `protobuf:"bytes,2,opt,name=submission_id,json=submissionId" json:"submission_id,omitempty"`
+	TaskId       string `protobuf:"bytes,3,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	DatasetId    string `protobuf:"bytes,4,opt,name=dataset_id,json=datasetId" json:"dataset_id,omitempty"`
+	TaskListId   string `protobuf:"bytes,5,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+	Priority     int32  `protobuf:"varint,6,opt,name=priority" json:"priority,omitempty"`
+	// the number of submissions handled so far, including the failed ones
+	Processed uint32                      `protobuf:"varint,7,opt,name=processed" json:"processed,omitempty"`
+	Failures  []*RejudgeFailure           `protobuf:"bytes,8,rep,name=failures" json:"failures,omitempty"`
+	CreatedAt *google_protobuf2.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	// unset while the rejudge is running
+	FinishedAt *google_protobuf2.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt" json:"finished_at,omitempty"`
+}
+
+func (m *Rejudge) Reset()                    { *m = Rejudge{} }
+func (m *Rejudge) String() string            { return proto.CompactTextString(m) }
+func (*Rejudge) ProtoMessage()               {}
+func (*Rejudge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *Rejudge) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *Rejudge) GetSubmissionId() string {
+	if m != nil {
+		return m.SubmissionId
+	}
+	return ""
+}
+
+func (m *Rejudge) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *Rejudge) GetDatasetId() string {
+	if m != nil {
+		return m.DatasetId
+	}
+	return ""
+}
+
+func (m *Rejudge) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+func (m *Rejudge) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Rejudge) GetProcessed() uint32 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
+func (m *Rejudge) GetFailures() []*RejudgeFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *Rejudge) GetCreatedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Rejudge) GetFinishedAt() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+type ReadRejudgeRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=uuid" json:"uuid,omitempty"`
+}
 
-func (m *FinishResponse) GetNextJob() *Job {
+func (m *ReadRejudgeRequest) Reset()                    { *m = ReadRejudgeRequest{} }
+func (m *ReadRejudgeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadRejudgeRequest) ProtoMessage()               {}
+func (*ReadRejudgeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *ReadRejudgeRequest) GetUuid() string {
 	if m != nil {
-		return m.NextJob
+		return m.Uuid
+	}
+	return ""
+}
+
+type ReadRejudgeResponse struct {
+	Rejudge *Rejudge `protobuf:"bytes,1,opt,name=rejudge" json:"rejudge,omitempty"`
+}
+
+func (m *ReadRejudgeResponse) Reset()                    { *m = ReadRejudgeResponse{} }
+func (m *ReadRejudgeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadRejudgeResponse) ProtoMessage()               {}
+func (*ReadRejudgeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *ReadRejudgeResponse) GetRejudge() *Rejudge {
+	if m != nil {
+		return m.Rejudge
 	}
 	return nil
 }
@@ -389,9 +954,24 @@ func init() {
 	proto.RegisterType((*ReadResponse)(nil), "xmc.srv.dispatcher.job.ReadResponse")
 	proto.RegisterType((*SearchRequest)(nil), "xmc.srv.dispatcher.job.SearchRequest")
 	proto.RegisterType((*SearchResponse)(nil), "xmc.srv.dispatcher.job.SearchResponse")
+	proto.RegisterType((*NodeCapabilities)(nil), "xmc.srv.dispatcher.job.NodeCapabilities")
+	proto.RegisterType((*AcquireRequest)(nil), "xmc.srv.dispatcher.job.AcquireRequest")
+	proto.RegisterType((*AcquireResponse)(nil), "xmc.srv.dispatcher.job.AcquireResponse")
 	proto.RegisterType((*FinishRequest)(nil), "xmc.srv.dispatcher.job.FinishRequest")
 	proto.RegisterType((*FinishResponse)(nil), "xmc.srv.dispatcher.job.FinishResponse")
+	proto.RegisterType((*HeartbeatRequest)(nil), "xmc.srv.dispatcher.job.HeartbeatRequest")
+	proto.RegisterType((*HeartbeatResponse)(nil), "xmc.srv.dispatcher.job.HeartbeatResponse")
+	proto.RegisterType((*CancelRequest)(nil), "xmc.srv.dispatcher.job.CancelRequest")
+	proto.RegisterType((*CancelResponse)(nil), "xmc.srv.dispatcher.job.CancelResponse")
+	proto.RegisterType((*RejudgeRequest)(nil), "xmc.srv.dispatcher.job.RejudgeRequest")
+	proto.RegisterType((*RejudgeResponse)(nil), "xmc.srv.dispatcher.job.RejudgeResponse")
+	proto.RegisterType((*RejudgeFailure)(nil), "xmc.srv.dispatcher.job.RejudgeFailure")
+	proto.RegisterType((*Rejudge)(nil), "xmc.srv.dispatcher.job.Rejudge")
+	proto.RegisterType((*ReadRejudgeRequest)(nil), "xmc.srv.dispatcher.job.ReadRejudgeRequest")
+	proto.RegisterType((*ReadRejudgeResponse)(nil), "xmc.srv.dispatcher.job.ReadRejudgeResponse")
 	proto.RegisterEnum("xmc.srv.dispatcher.job.State", State_name, State_value)
+	proto.RegisterEnum("xmc.srv.dispatcher.job.PriorityClass", PriorityClass_name, PriorityClass_value)
+	proto.RegisterEnum("xmc.srv.dispatcher.job.Order", Order_name, Order_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -405,7 +985,18 @@ type JobsServiceClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...client.CallOption) (*CreateResponse, error)
 	Read(ctx context.Context, in *ReadRequest, opts ...client.CallOption) (*ReadResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...client.CallOption) (*SearchResponse, error)
+	// Acquire leases jobs to the calling eval. It waits until there are jobs the eval can process
+	// or the requested wait time passes.
+	Acquire(ctx context.Context, in *AcquireRequest, opts ...client.CallOption) (*AcquireResponse, error)
 	Finish(ctx context.Context, in *FinishRequest, opts ...client.CallOption) (*FinishResponse, error)
+	// Heartbeat extends the lease of a job that is being processed by the calling eval
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...client.CallOption) (*HeartbeatResponse, error)
+	// Cancel removes a job from the queue or aborts its evaluation
+	Cancel(ctx context.Context, in *CancelRequest, opts ...client.CallOption) (*CancelResponse, error)
+	// Rejudge starts creating new jobs for existing submissions. The jobs are created
+	// in the background, ReadRejudge reports the progress.
+	Rejudge(ctx context.Context, in *RejudgeRequest, opts ...client.CallOption) (*RejudgeResponse, error)
+	ReadRejudge(ctx context.Context, in *ReadRejudgeRequest, opts ...client.CallOption) (*ReadRejudgeResponse, error)
 }
 
 type jobsServiceClient struct {
@@ -456,6 +1047,16 @@ func (c *jobsServiceClient) Search(ctx context.Context, in *SearchRequest, opts
 	return out, nil
 }
 
+func (c *jobsServiceClient) Acquire(ctx context.Context, in *AcquireRequest, opts ...client.CallOption) (*AcquireResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "JobsService.Acquire", in)
+	out := new(AcquireResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobsServiceClient) Finish(ctx context.Context, in *FinishRequest, opts ...client.CallOption) (*FinishResponse, error) {
 	req := c.c.NewRequest(c.serviceName, "JobsService.Finish", in)
 	out := new(FinishResponse)
@@ -466,13 +1067,64 @@ func (c *jobsServiceClient) Finish(ctx context.Context, in *FinishRequest, opts
 	return out, nil
 }
 
+func (c *jobsServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...client.CallOption) (*HeartbeatResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "JobsService.Heartbeat", in)
+	out := new(HeartbeatResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsServiceClient) Cancel(ctx context.Context, in *CancelRequest, opts ...client.CallOption) (*CancelResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "JobsService.Cancel", in)
+	out := new(CancelResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsServiceClient) Rejudge(ctx context.Context, in *RejudgeRequest, opts ...client.CallOption) (*RejudgeResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "JobsService.Rejudge", in)
+	out := new(RejudgeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsServiceClient) ReadRejudge(ctx context.Context, in *ReadRejudgeRequest, opts ...client.CallOption) (*ReadRejudgeResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "JobsService.ReadRejudge", in)
+	out := new(ReadRejudgeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for JobsService service
 
 type JobsServiceHandler interface {
 	Create(context.Context, *CreateRequest, *CreateResponse) error
 	Read(context.Context, *ReadRequest, *ReadResponse) error
 	Search(context.Context, *SearchRequest, *SearchResponse) error
+	// Acquire leases jobs to the calling eval. It waits until there are jobs the eval can process
+	// or the requested wait time passes.
+	Acquire(context.Context, *AcquireRequest, *AcquireResponse) error
 	Finish(context.Context, *FinishRequest, *FinishResponse) error
+	// Heartbeat extends the lease of a job that is being processed by the calling eval
+	Heartbeat(context.Context, *HeartbeatRequest, *HeartbeatResponse) error
+	// Cancel removes a job from the queue or aborts its evaluation
+	Cancel(context.Context, *CancelRequest, *CancelResponse) error
+	// Rejudge starts creating new jobs for existing submissions. The jobs are created
+	// in the background, ReadRejudge reports the progress.
+	Rejudge(context.Context, *RejudgeRequest, *RejudgeResponse) error
+	ReadRejudge(context.Context, *ReadRejudgeRequest, *ReadRejudgeResponse) error
 }
 
 func RegisterJobsServiceHandler(s server.Server, hdlr JobsServiceHandler, opts ...server.HandlerOption) {
@@ -495,61 +1147,136 @@ func (h *JobsService) Search(ctx context.Context, in *SearchRequest, out *Search
 	return h.JobsServiceHandler.Search(ctx, in, out)
 }
 
+func (h *JobsService) Acquire(ctx context.Context, in *AcquireRequest, out *AcquireResponse) error {
+	return h.JobsServiceHandler.Acquire(ctx, in, out)
+}
+
 func (h *JobsService) Finish(ctx context.Context, in *FinishRequest, out *FinishResponse) error {
 	return h.JobsServiceHandler.Finish(ctx, in, out)
 }
 
+func (h *JobsService) Heartbeat(ctx context.Context, in *HeartbeatRequest, out *HeartbeatResponse) error {
+	return h.JobsServiceHandler.Heartbeat(ctx, in, out)
+}
+
+func (h *JobsService) Cancel(ctx context.Context, in *CancelRequest, out *CancelResponse) error {
+	return h.JobsServiceHandler.Cancel(ctx, in, out)
+}
+
+func (h *JobsService) Rejudge(ctx context.Context, in *RejudgeRequest, out *RejudgeResponse) error {
+	return h.JobsServiceHandler.Rejudge(ctx, in, out)
+}
+
+func (h *JobsService) ReadRejudge(ctx context.Context, in *ReadRejudgeRequest, out *ReadRejudgeResponse) error {
+	return h.JobsServiceHandler.ReadRejudge(ctx, in, out)
+}
+
 func init() {
 	proto.RegisterFile("github.com/xmc-dev/xmc/dispatcher-srv/proto/job/job.proto", fileDescriptor0)
 }
 
 var fileDescriptor0 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xed, 0x6e, 0x12, 0x4d,
-	0x14, 0x2e, 0xb0, 0x2c, 0x70, 0x28, 0xa4, 0x99, 0xbc, 0xe9, 0xbb, 0x62, 0x1a, 0x71, 0x5b, 0x4d,
-	0x63, 0xd2, 0x25, 0xa1, 0x89, 0xb1, 0x31, 0xfe, 0xc0, 0x5a, 0x15, 0x12, 0x5b, 0xb3, 0x54, 0x4d,
-	0xfb, 0x87, 0xec, 0xc7, 0x00, 0x83, 0x2c, 0x83, 0x33, 0xb3, 0xa4, 0x5e, 0x82, 0x97, 0xe1, 0x35,
-	0x7a, 0x03, 0x66, 0x66, 0x76, 0x29, 0x34, 0xdd, 0xb6, 0xfe, 0x20, 0x3b, 0x67, 0xe6, 0x39, 0x5f,
-	0xcf, 0x79, 0x0e, 0x70, 0x34, 0x22, 0x62, 0x1c, 0xfb, 0x4e, 0x40, 0xa3, 0xd6, 0x55, 0x14, 0x1c,
-	0x84, 0x78, 0x21, 0xbf, 0xad, 0x90, 0xf0, 0xb9, 0x27, 0x82, 0x31, 0x66, 0x07, 0x9c, 0x2d, 0x5a,
-	0x73, 0x46, 0x05, 0x6d, 0x4d, 0xa8, 0x2f, 0x7f, 0x8e, 0xb2, 0xd0, 0xf6, 0x55, 0x14, 0x38, 0x9c,
-	0x2d, 0x9c, 0x6b, 0xac, 0x33, 0xa1, 0x7e, 0x23, 0x2b, 0xa4, 0x3c, 0x07, 0x94, 0xe1, 0x24, 0x18,
-	0xc3, 0x3c, 0x9e, 0x8a, 0xe4, 0xa3, 0x43, 0x36, 0x9e, 0x8c, 0x28, 0x1d, 0x4d, 0x13, 0x84, 0x1f,
-	0x0f, 0x5b, 0x82, 0x44, 0x98, 0x0b, 0x2f, 0x9a, 0x6b, 0x80, 0xdd, 0x01, 0xe8, 0x0b, 0x4f, 0xe0,
-	0xaf, 0xde, 0x34, 0xc6, 0xe8, 0x10, 0x8a, 0x0b, 0x79, 0xb0, 0x72, 0xcd, 0xdc, 0x7e, 0xbd, 0xbd,
-	0xe3, 0xdc, 0x5e, 0x91, 0xa3, 0x5c, 0x5c, 0x8d, 0xb5, 0x7f, 0x17, 0xa0, 0xd0, 0xa3, 0x3e, 0x42,
-	0x60, 0xc4, 0x31, 0x09, 0x95, 0x6f, 0xc5, 0x55, 0x67, 0xb4, 0x03, 0x10, 0x7a, 0xc2, 0xe3, 0x58,
-	0x0c, 0x48, 0x68, 0xe5, 0xd5, 0x4b, 0x25, 0xb9, 0xe9, 0x86, 0xd2, 0x25, 0xa0, 0x21, 0xb6, 0x0a,
-	0xcd, 0xdc, 0xfe, 0xa6, 0xab, 0xce, 0xa8, 0x01, 0xe5, 0xa9, 0x37, 0x1b, 0xc5, 0xde, 0x08, 0x5b,
-	0x86, 0x72, 0x58, 0xda, 0xe8, 0x7f, 0x28, 0xe1, 0x85, 0x37, 0x95, 0xb1, 0x8a, 0xea, 0xc9, 0x94,
-	0x66, 0x37, 0x44, 0x87, 0x60, 0xea, 0xbe, 0x2d, 0xb3, 0x99, 0xdb, 0xaf, 0xb6, 0x1f, 0x2f, 0x2b,
-	0x97, 0x04, 0x39, 0x09, 0x27, 0xae, 0xfa, 0xb8, 0x09, 0x54, 0x76, 0xcb, 0x65, 0x23, 0x56, 0xe9,
-	0x41, 0xdd, 0x2a, 0x2c, 0x3a, 0x02, 0x08, 0x18, 0xf6, 0x04, 0x0e, 0x07, 0x9e, 0xb0, 0xca, 0x2a,
-	0x5b, 0xc3, 0xd1, 0x34, 0x3b, 0x29, 0xcd, 0xce, 0x79, 0x4a, 0xb3, 0x5b, 0x49, 0xd0, 0x1d, 0x81,
-	0x5e, 0x43, 0x75, 0x48, 0x66, 0x84, 0x8f, 0xb5, 0x6f, 0xe5, 0x5e, 0x5f, 0x48, 0xe1, 0x1d, 0x81,
-	0x76, 0xa1, 0xc6, 0x63, 0x3f, 0x22, 0x9c, 0x13, 0x3a, 0x93, 0x04, 0x80, 0x22, 0x60, 0xf3, 0xfa,
-	0xb2, 0x1b, 0x4a, 0x7e, 0x84, 0xc7, 0xbf, 0xcb, 0xe7, 0xaa, 0xe6, 0x47, 0x9a, 0xdd, 0xd0, 0xbe,
-	0x84, 0xda, 0xb1, 0xaa, 0xc3, 0xc5, 0x3f, 0x62, 0xcc, 0x05, 0x3a, 0x80, 0xc2, 0x84, 0xfa, 0x6a,
-	0x56, 0xab, 0x6c, 0xdd, 0xe8, 0xbc, 0x47, 0x7d, 0x57, 0xe2, 0xe4, 0x50, 0xe6, 0x8c, 0x50, 0x46,
-	0xc4, 0x4f, 0x35, 0xc5, 0xa2, 0xbb, 0xb4, 0xed, 0x3d, 0xa8, 0xa7, 0xb1, 0xf9, 0x9c, 0xce, 0x38,
-	0xbe, 0x4d, 0x09, 0xf6, 0x53, 0xa8, 0xba, 0xd8, 0x0b, 0xd3, 0xfc, 0xb7, 0x41, 0xde, 0xc0, 0xa6,
-	0x86, 0x24, 0x61, 0xfe, 0xad, 0x46, 0xfb, 0x57, 0x1e, 0x6a, 0x7d, 0xec, 0xb1, 0x60, 0x9c, 0x26,
-	0xf9, 0x0f, 0x8a, 0x53, 0x12, 0x11, 0xa1, 0x42, 0x18, 0xae, 0x36, 0xd0, 0x36, 0x98, 0x74, 0x38,
-	0xe4, 0x58, 0xa8, 0x4e, 0x0c, 0x37, 0xb1, 0x56, 0xc9, 0x2b, 0xac, 0x92, 0x77, 0x43, 0xc4, 0xc6,
-	0x4d, 0x11, 0xaf, 0x0a, 0xb6, 0x98, 0x2d, 0x58, 0x73, 0x4d, 0xb0, 0xaf, 0x56, 0xb5, 0x57, 0x6d,
-	0xdb, 0x77, 0x6a, 0x4f, 0x2d, 0x67, 0x2a, 0xc0, 0x5d, 0xa8, 0x61, 0xc6, 0x28, 0x1b, 0x44, 0x98,
-	0x73, 0x99, 0xb3, 0xac, 0x85, 0xa0, 0x2e, 0x3f, 0xe9, 0x3b, 0xbb, 0x03, 0xf5, 0x94, 0x8a, 0x84,
-	0xcc, 0x16, 0x18, 0x13, 0xea, 0x73, 0x2b, 0xd7, 0x2c, 0xdc, 0xc7, 0xa6, 0x02, 0xda, 0x03, 0xa8,
-	0xbd, 0x57, 0xf2, 0x4b, 0xd9, 0x7c, 0x04, 0xe5, 0x09, 0xf5, 0x07, 0x2b, 0x63, 0x2b, 0x4d, 0xa8,
-	0xff, 0x45, 0xae, 0xf9, 0xf5, 0xfa, 0xe5, 0x1f, 0xbc, 0x7e, 0xf6, 0x47, 0xa8, 0xa7, 0x09, 0x92,
-	0x1a, 0x5f, 0x42, 0x79, 0x86, 0xaf, 0xc4, 0xe0, 0x81, 0x53, 0x2f, 0x49, 0x70, 0x8f, 0xfa, 0x2f,
-	0x1c, 0x28, 0x2a, 0x9e, 0x50, 0x15, 0x4a, 0xdf, 0x3a, 0xdd, 0xf3, 0xee, 0xe9, 0x87, 0xad, 0x0d,
-	0x54, 0x07, 0xf8, 0xec, 0x9e, 0x1d, 0x9f, 0xf4, 0xfb, 0xd2, 0xce, 0xa1, 0x32, 0x18, 0xef, 0xce,
-	0x4e, 0x4f, 0xb6, 0xf2, 0xed, 0x3f, 0x79, 0xa8, 0xf6, 0xa8, 0xcf, 0xfb, 0x98, 0x2d, 0x48, 0x80,
-	0xd1, 0x05, 0x98, 0x5a, 0xc1, 0xe8, 0x59, 0x56, 0xbe, 0xb5, 0xed, 0x69, 0x3c, 0xbf, 0x0f, 0xa6,
-	0x1b, 0xb2, 0x37, 0x50, 0x1f, 0x0c, 0xa9, 0x69, 0xb4, 0x9b, 0xe5, 0xb1, 0xb2, 0x14, 0x8d, 0xbd,
-	0xbb, 0x41, 0xcb, 0xa0, 0x17, 0x60, 0xea, 0xe9, 0x66, 0xd7, 0xbb, 0xb6, 0x08, 0xd9, 0xf5, 0xae,
-	0x8b, 0x44, 0x87, 0xd6, 0x43, 0xc9, 0x0e, 0xbd, 0xa6, 0x8a, 0xec, 0xd0, 0xeb, 0xb3, 0xb5, 0x37,
-	0xde, 0x16, 0x2f, 0xe5, 0x9a, 0xfa, 0xa6, 0xfa, 0xa3, 0x3b, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xa3, 0x2d, 0x62, 0xf9, 0x29, 0x07, 0x00, 0x00,
+	// 1620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0x36, 0x75, 0xb2, 0x34, 0x3a, 0x98, 0xd9, 0x04, 0xf9, 0x19, 0xe5, 0x4f, 0xe3, 0x30, 0xa9,
+	0xe3, 0xba, 0xb0, 0x8c, 0xda, 0xbd, 0x88, 0x93, 0xf6, 0x42, 0x91, 0x95, 0x44, 0xaa, 0x63, 0x1b,
+	0x94, 0x93, 0x22, 0x69, 0x01, 0x61, 0x49, 0xae, 0x6d, 0x3a, 0x92, 0xa8, 0x72, 0x97, 0x6e, 0x02,
+	0xf4, 0x29, 0xfa, 0x1a, 0xbd, 0xef, 0x23, 0xf4, 0x19, 0xfa, 0x0a, 0x7d, 0x8a, 0x16, 0x7b, 0xa0,
+	0x4c, 0xca, 0x3a, 0x39, 0x17, 0x02, 0xb9, 0xcb, 0x6f, 0x66, 0x67, 0x66, 0x67, 0xbe, 0x19, 0xc1,
+	0xee, 0xa9, 0xc7, 0xce, 0x42, 0xbb, 0xe6, 0xf8, 0xfd, 0xad, 0x8f, 0x7d, 0x67, 0xd3, 0x25, 0x17,
+	0xfc, 0xb9, 0xe5, 0x7a, 0x74, 0x88, 0x99, 0x73, 0x46, 0x82, 0x4d, 0x1a, 0x5c, 0x6c, 0x0d, 0x03,
+	0x9f, 0xf9, 0x5b, 0xe7, 0xbe, 0xcd, 0x7f, 0x35, 0xb1, 0x42, 0xb7, 0x3f, 0xf6, 0x9d, 0x1a, 0x0d,
+	0x2e, 0x6a, 0x97, 0xd8, 0xda, 0xb9, 0x6f, 0x57, 0x9f, 0x4d, 0x51, 0xc9, 0xdf, 0x1d, 0x3f, 0x20,
+	0x4a, 0x99, 0x8b, 0x19, 0xa6, 0x84, 0x45, 0x4f, 0xa9, 0xb4, 0xba, 0xbb, 0x98, 0x70, 0x40, 0x68,
+	0xd8, 0x63, 0xea, 0xa1, 0x44, 0xeb, 0x8b, 0x89, 0x52, 0x82, 0x03, 0xe7, 0xac, 0x4f, 0x18, 0x8e,
+	0xbd, 0x2a, 0x15, 0x0b, 0x9a, 0xce, 0x68, 0x80, 0x07, 0xa7, 0x24, 0x7a, 0x2a, 0xe1, 0x2f, 0x4e,
+	0x7d, 0xff, 0xb4, 0xa7, 0x30, 0x76, 0x78, 0xb2, 0xe5, 0x86, 0x01, 0x66, 0x9e, 0x3f, 0x50, 0xdf,
+	0xef, 0x8f, 0x7f, 0x67, 0x5e, 0x9f, 0x50, 0x86, 0xfb, 0x43, 0x09, 0x30, 0xeb, 0x00, 0x1d, 0x86,
+	0x19, 0x79, 0x8b, 0x7b, 0x21, 0x41, 0x3b, 0x90, 0xbd, 0xe0, 0x2f, 0x86, 0xb6, 0xaa, 0xad, 0x57,
+	0xb6, 0xef, 0xd5, 0x26, 0x87, 0xbb, 0x26, 0x44, 0x2c, 0x89, 0x35, 0xff, 0xcc, 0x41, 0xba, 0xed,
+	0xdb, 0x08, 0x41, 0x26, 0x0c, 0x3d, 0x57, 0xc8, 0x16, 0x2c, 0xf1, 0x8e, 0xee, 0x01, 0xa8, 0x58,
+	0x77, 0x3d, 0xd7, 0x48, 0x89, 0x2f, 0x05, 0xb5, 0xd3, 0x72, 0xb9, 0x88, 0xe3, 0xbb, 0xc4, 0x48,
+	0xaf, 0x6a, 0xeb, 0x25, 0x4b, 0xbc, 0xa3, 0x2a, 0xe4, 0x7b, 0x78, 0x70, 0x1a, 0xe2, 0x53, 0x62,
+	0x64, 0x84, 0xc0, 0x68, 0x8d, 0xfe, 0x07, 0xcb, 0xe4, 0x02, 0xf7, 0xb8, 0xae, 0xac, 0xf8, 0x94,
+	0xe3, 0xcb, 0x96, 0x8b, 0x76, 0x20, 0x27, 0xef, 0xc5, 0xc8, 0xad, 0x6a, 0xeb, 0xc5, 0xed, 0xbb,
+	0x23, 0xcb, 0x79, 0x08, 0x6b, 0xf2, 0x5b, 0xcd, 0x12, 0x0f, 0x4b, 0x41, 0xb9, 0xb7, 0x94, 0x3b,
+	0x62, 0x2c, 0x2f, 0xe4, 0xad, 0xc0, 0xa2, 0x5d, 0x00, 0x27, 0x20, 0x98, 0x11, 0xb7, 0x8b, 0x99,
+	0x91, 0x17, 0xa7, 0x55, 0x6b, 0x32, 0xcc, 0xb5, 0x28, 0xcc, 0xb5, 0xe3, 0x28, 0xcc, 0x56, 0x41,
+	0xa1, 0xeb, 0x0c, 0x3d, 0x83, 0xe2, 0x89, 0x37, 0xf0, 0xe8, 0x99, 0x94, 0x2d, 0xcc, 0x95, 0x85,
+	0x08, 0x5e, 0x67, 0xe8, 0x21, 0x94, 0x69, 0x68, 0xf7, 0x3d, 0x4a, 0x3d, 0x7f, 0xc0, 0x03, 0x00,
+	0x22, 0x00, 0xa5, 0xcb, 0xcd, 0x96, 0xcb, 0xe3, 0xc3, 0x30, 0xfd, 0xc0, 0x3f, 0x17, 0x65, 0x7c,
+	0xf8, 0xb2, 0xe5, 0xa2, 0x3d, 0xd0, 0x7b, 0x04, 0x53, 0xd2, 0x25, 0x1f, 0x87, 0x5e, 0x40, 0x28,
+	0x3f, 0xbf, 0x34, 0xf7, 0xfc, 0x8a, 0x90, 0x69, 0x4a, 0x91, 0x3a, 0x43, 0x06, 0x2c, 0x07, 0x84,
+	0x05, 0x1e, 0xa1, 0x46, 0x79, 0x55, 0x5b, 0xcf, 0x5a, 0xd1, 0x92, 0x1f, 0x1c, 0x52, 0x12, 0xf0,
+	0x83, 0x2b, 0xf2, 0x60, 0xbe, 0x6c, 0xb9, 0x68, 0x15, 0x4a, 0xc2, 0xa2, 0x9e, 0x47, 0x45, 0x0a,
+	0xac, 0x88, 0xaf, 0xc0, 0xf7, 0xf6, 0x3d, 0xca, 0x73, 0x60, 0x1f, 0x2a, 0xc3, 0xc0, 0xf3, 0x03,
+	0x8f, 0x7d, 0xea, 0x3a, 0x3d, 0x4c, 0xa9, 0xa1, 0x8b, 0xeb, 0xf8, 0x72, 0xda, 0x75, 0x1c, 0x29,
+	0x74, 0x83, 0x83, 0xad, 0xf2, 0x30, 0xbe, 0x44, 0x6d, 0x28, 0x05, 0xe4, 0x97, 0xd0, 0x0b, 0x48,
+	0x9f, 0x0c, 0x18, 0x35, 0x6e, 0x08, 0x27, 0xd7, 0x92, 0xe9, 0x10, 0x95, 0xff, 0x81, 0xef, 0x12,
+	0x2b, 0x86, 0xb6, 0x12, 0xb2, 0xfc, 0xaa, 0x29, 0xc3, 0x81, 0xba, 0x6a, 0x34, 0xff, 0xaa, 0x15,
+	0x5a, 0xde, 0x16, 0x66, 0x0c, 0xf3, 0x42, 0x1f, 0x08, 0xbf, 0x6f, 0xca, 0xdb, 0xba, 0xdc, 0x6c,
+	0xb9, 0xe6, 0x7b, 0x28, 0x37, 0x44, 0x72, 0x70, 0x1b, 0x08, 0x65, 0x68, 0x13, 0xd2, 0xe7, 0xbe,
+	0x6d, 0x68, 0x63, 0x29, 0x3c, 0xe6, 0x7f, 0xdb, 0xb7, 0x2d, 0x8e, 0xe3, 0x95, 0x12, 0x39, 0x2f,
+	0x4a, 0x2b, 0x6b, 0x8d, 0xd6, 0xe6, 0x23, 0xa8, 0x44, 0xba, 0xe9, 0xd0, 0x1f, 0x50, 0x32, 0xa9,
+	0x3c, 0xcd, 0x07, 0x50, 0xb4, 0x08, 0x76, 0xa3, 0xf3, 0x27, 0x41, 0xbe, 0x87, 0x92, 0x84, 0x28,
+	0x35, 0xd7, 0xb3, 0xd1, 0xfc, 0x27, 0x0d, 0xe5, 0x8e, 0xa0, 0xbc, 0xe8, 0x90, 0x5b, 0x90, 0xed,
+	0x79, 0x7d, 0x8f, 0x09, 0x15, 0x19, 0x4b, 0x2e, 0xd0, 0x6d, 0xc8, 0xf9, 0x27, 0x27, 0x94, 0x30,
+	0xe1, 0x49, 0xc6, 0x52, 0xab, 0x78, 0x46, 0xa7, 0x13, 0x19, 0x9d, 0x64, 0x96, 0xcc, 0x38, 0xb3,
+	0xc4, 0x59, 0x24, 0x3b, 0x9d, 0x45, 0x72, 0x09, 0x16, 0x79, 0x12, 0x27, 0x84, 0xe2, 0xb6, 0x39,
+	0x93, 0x10, 0x04, 0x63, 0x46, 0xac, 0xd0, 0x48, 0xb0, 0x82, 0xac, 0xec, 0x47, 0xc9, 0xa4, 0x8b,
+	0x88, 0xfb, 0x32, 0x5f, 0xf8, 0x32, 0xce, 0x0f, 0xcd, 0x24, 0x3f, 0xc0, 0x35, 0xb4, 0xc4, 0x99,
+	0x62, 0x07, 0xb2, 0x7e, 0xe0, 0x92, 0xc0, 0x28, 0xce, 0xa6, 0xb5, 0x43, 0x0e, 0xb2, 0x24, 0xf6,
+	0x2a, 0xbd, 0x94, 0x26, 0xd3, 0x4b, 0x54, 0xe5, 0xe5, 0x78, 0x95, 0xb7, 0x33, 0xf9, 0xbc, 0x5e,
+	0x30, 0x19, 0x54, 0xa2, 0xab, 0x56, 0xc9, 0xb2, 0x05, 0x99, 0x73, 0xdf, 0xa6, 0x86, 0xb6, 0x9a,
+	0x9e, 0x97, 0x2d, 0x02, 0x88, 0xbe, 0x81, 0x0c, 0x6f, 0x8d, 0x22, 0x09, 0x8a, 0xdb, 0xf7, 0x92,
+	0xbe, 0xc7, 0x5a, 0xe7, 0x6b, 0xc2, 0xb0, 0x25, 0xa0, 0xe6, 0xbf, 0x1a, 0xe8, 0xbc, 0x90, 0x1b,
+	0x78, 0x88, 0x6d, 0xaf, 0xe7, 0x31, 0xce, 0x47, 0xff, 0x87, 0x42, 0x74, 0xdd, 0xf2, 0xf4, 0x82,
+	0x75, 0xb9, 0x81, 0xf6, 0x21, 0xd7, 0xc3, 0x36, 0xe9, 0x51, 0x23, 0x25, 0x0c, 0xfb, 0x76, 0x9a,
+	0x61, 0xe3, 0x7a, 0x6b, 0xfb, 0x42, 0xac, 0x39, 0x60, 0xc1, 0x27, 0x4b, 0xe9, 0x40, 0x8f, 0x61,
+	0xc5, 0x26, 0x03, 0xe7, 0xac, 0x8f, 0x83, 0x0f, 0x5d, 0xca, 0x2d, 0x15, 0xa9, 0xaa, 0x59, 0x95,
+	0xd1, 0x76, 0x87, 0xef, 0xa2, 0xbb, 0x50, 0x70, 0x86, 0x61, 0xb7, 0xef, 0xbb, 0xa4, 0x17, 0xb5,
+	0x36, 0x67, 0x18, 0xbe, 0xe6, 0xeb, 0xea, 0x2e, 0x14, 0x63, 0xca, 0x91, 0x0e, 0xe9, 0x0f, 0xe4,
+	0x93, 0xaa, 0x44, 0xfe, 0xca, 0xeb, 0x46, 0xf6, 0x66, 0xd9, 0x45, 0xe5, 0xe2, 0x69, 0xea, 0x89,
+	0x66, 0xfe, 0xa5, 0x41, 0xa5, 0xee, 0x08, 0xe2, 0x8a, 0x8a, 0xec, 0x2e, 0x14, 0x44, 0x8a, 0x0f,
+	0x70, 0x9f, 0x28, 0x25, 0x79, 0xbe, 0x71, 0x80, 0xfb, 0xa2, 0xc3, 0x3a, 0x78, 0x88, 0x9d, 0x18,
+	0x6f, 0x44, 0x6b, 0xb4, 0x0f, 0x25, 0x27, 0xe6, 0xb0, 0xf0, 0xa4, 0xb8, 0xbd, 0xbe, 0x68, 0x80,
+	0xac, 0x84, 0x34, 0xda, 0x84, 0xcc, 0xaf, 0xd8, 0x63, 0xc2, 0xd9, 0xe2, 0xf6, 0x9d, 0x2b, 0xdc,
+	0xb9, 0xa7, 0xa6, 0x15, 0x4b, 0xc0, 0xcc, 0xe7, 0xb0, 0x32, 0xf2, 0xe3, 0x33, 0x33, 0xc8, 0xfc,
+	0x0d, 0xca, 0x2f, 0x44, 0x2d, 0x44, 0xa1, 0xb8, 0x03, 0xf9, 0x73, 0xdf, 0xee, 0xc6, 0x88, 0x6d,
+	0xf9, 0xdc, 0xb7, 0xdf, 0x84, 0x5e, 0x7c, 0x6a, 0x48, 0x2d, 0x3e, 0x35, 0x24, 0x42, 0x9b, 0x4e,
+	0x86, 0xd6, 0xbc, 0x0d, 0x95, 0xe8, 0x74, 0xe9, 0x40, 0x3b, 0x93, 0xd7, 0xf4, 0x94, 0xd9, 0x06,
+	0xfd, 0x15, 0xc1, 0x01, 0xb3, 0x09, 0x66, 0x0b, 0x18, 0x96, 0x38, 0x23, 0x35, 0x76, 0xc6, 0x3b,
+	0xb8, 0x11, 0xd3, 0xa5, 0xe2, 0x34, 0xa9, 0xc1, 0x6b, 0xd7, 0x6d, 0xf0, 0xe6, 0x06, 0x94, 0x1b,
+	0x78, 0xe0, 0x90, 0xde, 0x7c, 0x1b, 0x4d, 0x1d, 0x2a, 0x11, 0x56, 0xda, 0x60, 0xfe, 0xa1, 0x41,
+	0xc5, 0x22, 0xe7, 0xa1, 0x7b, 0x3a, 0xca, 0xc3, 0x2b, 0xb4, 0xa2, 0xcd, 0x9e, 0x5a, 0x52, 0x33,
+	0x38, 0x3e, 0x3d, 0xce, 0xf1, 0xe3, 0xb3, 0x45, 0xe6, 0xca, 0x6c, 0x11, 0xef, 0x90, 0xd9, 0xb1,
+	0x0e, 0xf9, 0x14, 0x56, 0x46, 0xc6, 0xaa, 0x20, 0x3e, 0xe0, 0xc3, 0x83, 0xd8, 0x92, 0x1e, 0x4b,
+	0x6b, 0x8a, 0x6a, 0x8f, 0x7b, 0xad, 0xae, 0xf3, 0x87, 0x91, 0xa3, 0x2f, 0xb0, 0xd7, 0x0b, 0x03,
+	0xb2, 0x98, 0xa3, 0xb7, 0x20, 0x4b, 0x82, 0xc0, 0x0f, 0xa2, 0x12, 0x16, 0x0b, 0xf3, 0xf7, 0x34,
+	0x2c, 0x2b, 0x6d, 0x13, 0x67, 0xe8, 0x2b, 0xaa, 0x53, 0xb3, 0x63, 0x78, 0xad, 0x3e, 0x39, 0x1e,
+	0xc3, 0xec, 0xcc, 0x18, 0xe6, 0x92, 0x31, 0xe4, 0x34, 0x3b, 0x0c, 0x7c, 0x87, 0x50, 0x4a, 0x5c,
+	0xd1, 0x34, 0xcb, 0xd6, 0xe5, 0x06, 0x7a, 0x0e, 0xf9, 0x13, 0x19, 0x1e, 0x6a, 0xe4, 0x57, 0xd3,
+	0x89, 0x39, 0x6c, 0xac, 0x7e, 0x93, 0xd1, 0xb4, 0x46, 0x72, 0x68, 0x77, 0x42, 0x63, 0xfd, 0xbc,
+	0x71, 0x1b, 0xae, 0x33, 0x6e, 0x9b, 0xeb, 0x80, 0xe4, 0xd8, 0x93, 0x48, 0xe7, 0x49, 0x03, 0xd2,
+	0x11, 0xdc, 0x4c, 0x20, 0x55, 0x2e, 0xed, 0xf2, 0x59, 0x59, 0x6c, 0xa9, 0x3a, 0xbc, 0x3f, 0xc7,
+	0x77, 0x2b, 0xc2, 0x6f, 0xd4, 0x20, 0x2b, 0x26, 0x0c, 0x54, 0x84, 0xe5, 0x1f, 0xeb, 0xad, 0xe3,
+	0xd6, 0xc1, 0x4b, 0x7d, 0x09, 0x55, 0x00, 0x8e, 0xac, 0xc3, 0x46, 0xb3, 0xd3, 0xe1, 0x6b, 0x0d,
+	0xe5, 0x21, 0xb3, 0x77, 0x78, 0xd0, 0xd4, 0x53, 0x1b, 0xdf, 0x41, 0x39, 0x31, 0x13, 0xa3, 0x12,
+	0xe4, 0x8f, 0xac, 0x7a, 0xe3, 0xb8, 0xd5, 0x68, 0xea, 0x4b, 0x48, 0x87, 0x52, 0xe3, 0xf0, 0xe0,
+	0xb8, 0xd9, 0x39, 0xee, 0xee, 0xb7, 0xde, 0x36, 0x75, 0x8d, 0xeb, 0xb5, 0x9a, 0xed, 0x37, 0x7b,
+	0x2f, 0xb9, 0xf4, 0x4f, 0x90, 0x15, 0x93, 0x00, 0xba, 0x09, 0x2b, 0x0d, 0xab, 0x59, 0x3f, 0x6e,
+	0xee, 0x75, 0xeb, 0xc7, 0xdd, 0xbd, 0x66, 0xa7, 0xa1, 0x2f, 0x21, 0x04, 0x95, 0xd8, 0x66, 0xbd,
+	0xd3, 0xd0, 0x35, 0x74, 0x0b, 0xf4, 0x17, 0xad, 0x83, 0x56, 0xe7, 0x55, 0x0c, 0x99, 0xe2, 0xe2,
+	0xf1, 0x5d, 0x0e, 0x4d, 0x6f, 0xff, 0x9d, 0x83, 0x62, 0xdb, 0xb7, 0x69, 0x87, 0x04, 0x17, 0x9e,
+	0x43, 0xd0, 0x3b, 0xc8, 0xc9, 0xb1, 0x14, 0x4d, 0x1d, 0xef, 0x13, 0x23, 0x71, 0x75, 0x6d, 0x1e,
+	0x4c, 0x71, 0xcf, 0x12, 0xea, 0x40, 0x86, 0xdf, 0x03, 0x7a, 0x38, 0x3d, 0xce, 0xa3, 0x49, 0xb7,
+	0xfa, 0x68, 0x36, 0x68, 0xa4, 0xf4, 0x1d, 0xe4, 0xe4, 0x48, 0x33, 0xdd, 0xde, 0xc4, 0x74, 0x5b,
+	0x5d, 0x9b, 0x07, 0x1b, 0xa9, 0xfe, 0x19, 0x96, 0x55, 0xb3, 0x43, 0x53, 0x85, 0x92, 0x5d, 0xbd,
+	0xfa, 0x78, 0x2e, 0x2e, 0x6e, 0xb8, 0x6c, 0x44, 0xd3, 0x0d, 0x4f, 0xb4, 0xc9, 0xea, 0xda, 0x3c,
+	0xd8, 0x48, 0xb5, 0x0d, 0x85, 0x51, 0xff, 0x41, 0x53, 0x27, 0x83, 0xf1, 0x76, 0x57, 0xfd, 0x6a,
+	0x01, 0x64, 0xdc, 0x7c, 0xd9, 0x5c, 0x66, 0xe4, 0x49, 0xbc, 0x51, 0x55, 0xd7, 0xe6, 0xc1, 0xe2,
+	0x71, 0x8f, 0xd8, 0x76, 0x1e, 0x1d, 0xcd, 0x8d, 0xfb, 0x58, 0xd1, 0x9b, 0x4b, 0xe8, 0x2c, 0xfa,
+	0x47, 0x25, 0x4f, 0xd8, 0x98, 0x9d, 0x67, 0x89, 0x53, 0xbe, 0x5e, 0x08, 0x1b, 0x9d, 0xf4, 0x3c,
+	0xfb, 0x9e, 0xff, 0xc1, 0xb2, 0x73, 0x82, 0xc8, 0x76, 0xfe, 0x1b, 0x00, 0x26, 0xa2, 0x9f, 0x10,
+	0x55, 0x13, 0x00, 0x00,
 }
@@ -12,12 +12,19 @@ It has these top-level messages:
 	GetEvalsResponse
 	DispatchNextRequest
 	DispatchNextResponse
+	GetMetricsRequest
+	QueueDepth
+	Bucket
+	DurationStats
+	NodeMetrics
+	GetMetricsResponse
 */
 package meta
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import xmc_srv_dispatcher_job "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
 import xmc_srv_eval_eval "github.com/xmc-dev/xmc/eval-srv/proto/eval"
 
 import (
@@ -85,11 +92,228 @@ func (m *DispatchNextResponse) String() string            { return proto.Compact
 func (*DispatchNextResponse) ProtoMessage()               {}
 func (*DispatchNextResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
+type GetMetricsRequest struct {
+}
+
+func (m *GetMetricsRequest) Reset()                    { *m = GetMetricsRequest{} }
+func (m *GetMetricsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMetricsRequest) ProtoMessage()               {}
+func (*GetMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+type QueueDepth struct {
+	PriorityClass xmc_srv_dispatcher_job.PriorityClass `protobuf:"varint,1,opt,name=priority_class,json=priorityClass,enum=xmc.srv.dispatcher.job.PriorityClass" json:"priority_class,omitempty"`
+	Jobs          int64                                `protobuf:"varint,2,opt,name=jobs" json:"jobs,omitempty"`
+}
+
+func (m *QueueDepth) Reset()                    { *m = QueueDepth{} }
+func (m *QueueDepth) String() string            { return proto.CompactTextString(m) }
+func (*QueueDepth) ProtoMessage()               {}
+func (*QueueDepth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *QueueDepth) GetPriorityClass() xmc_srv_dispatcher_job.PriorityClass {
+	if m != nil {
+		return m.PriorityClass
+	}
+	return xmc_srv_dispatcher_job.PriorityClass_PRACTICE
+}
+
+func (m *QueueDepth) GetJobs() int64 {
+	if m != nil {
+		return m.Jobs
+	}
+	return 0
+}
+
+type Bucket struct {
+	UpperBoundSeconds float64 `protobuf:"fixed64,1,opt,name=upper_bound_seconds,json=upperBoundSeconds" json:"upper_bound_seconds,omitempty"`
+	// the number of observations less than or equal to the upper bound
+	Count uint64 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *Bucket) Reset()                    { *m = Bucket{} }
+func (m *Bucket) String() string            { return proto.CompactTextString(m) }
+func (*Bucket) ProtoMessage()               {}
+func (*Bucket) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Bucket) GetUpperBoundSeconds() float64 {
+	if m != nil {
+		return m.UpperBoundSeconds
+	}
+	return 0
+}
+
+func (m *Bucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type DurationStats struct {
+	Count      uint64    `protobuf:"varint,1,opt,name=count" json:"count,omitempty"`
+	SumSeconds float64   `protobuf:"fixed64,2,opt,name=sum_seconds,json=sumSeconds" json:"sum_seconds,omitempty"`
+	Buckets    []*Bucket `protobuf:"bytes,3,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *DurationStats) Reset()                    { *m = DurationStats{} }
+func (m *DurationStats) String() string            { return proto.CompactTextString(m) }
+func (*DurationStats) ProtoMessage()               {}
+func (*DurationStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *DurationStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DurationStats) GetSumSeconds() float64 {
+	if m != nil {
+		return m.SumSeconds
+	}
+	return 0
+}
+
+func (m *DurationStats) GetBuckets() []*Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type NodeMetrics struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// the part of the slots of the node that are evaluating jobs
+	BusyRatio           float64 `protobuf:"fixed64,3,opt,name=busy_ratio,json=busyRatio" json:"busy_ratio,omitempty"`
+	CompileFailures     uint64  `protobuf:"varint,4,opt,name=compile_failures,json=compileFailures" json:"compile_failures,omitempty"`
+	SandboxErrors       uint64  `protobuf:"varint,5,opt,name=sandbox_errors,json=sandboxErrors" json:"sandbox_errors,omitempty"`
+	HealthCheckFailures uint64  `protobuf:"varint,6,opt,name=health_check_failures,json=healthCheckFailures" json:"health_check_failures,omitempty"`
+}
+
+func (m *NodeMetrics) Reset()                    { *m = NodeMetrics{} }
+func (m *NodeMetrics) String() string            { return proto.CompactTextString(m) }
+func (*NodeMetrics) ProtoMessage()               {}
+func (*NodeMetrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *NodeMetrics) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NodeMetrics) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeMetrics) GetBusyRatio() float64 {
+	if m != nil {
+		return m.BusyRatio
+	}
+	return 0
+}
+
+func (m *NodeMetrics) GetCompileFailures() uint64 {
+	if m != nil {
+		return m.CompileFailures
+	}
+	return 0
+}
+
+func (m *NodeMetrics) GetSandboxErrors() uint64 {
+	if m != nil {
+		return m.SandboxErrors
+	}
+	return 0
+}
+
+func (m *NodeMetrics) GetHealthCheckFailures() uint64 {
+	if m != nil {
+		return m.HealthCheckFailures
+	}
+	return 0
+}
+
+type GetMetricsResponse struct {
+	QueueDepth []*QueueDepth `protobuf:"bytes,1,rep,name=queue_depth,json=queueDepth" json:"queue_depth,omitempty"`
+	// the time the jobs waited in the queue, by priority class
+	JobWait             map[string]*DurationStats `protobuf:"bytes,2,rep,name=job_wait,json=jobWait" json:"job_wait,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EvaluationDuration  *DurationStats            `protobuf:"bytes,3,opt,name=evaluation_duration,json=evaluationDuration" json:"evaluation_duration,omitempty"`
+	Nodes               []*NodeMetrics            `protobuf:"bytes,4,rep,name=nodes" json:"nodes,omitempty"`
+	CompileFailures     uint64                    `protobuf:"varint,5,opt,name=compile_failures,json=compileFailures" json:"compile_failures,omitempty"`
+	SandboxErrors       uint64                    `protobuf:"varint,6,opt,name=sandbox_errors,json=sandboxErrors" json:"sandbox_errors,omitempty"`
+	HealthCheckFailures uint64                    `protobuf:"varint,7,opt,name=health_check_failures,json=healthCheckFailures" json:"health_check_failures,omitempty"`
+}
+
+func (m *GetMetricsResponse) Reset()                    { *m = GetMetricsResponse{} }
+func (m *GetMetricsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMetricsResponse) ProtoMessage()               {}
+func (*GetMetricsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *GetMetricsResponse) GetQueueDepth() []*QueueDepth {
+	if m != nil {
+		return m.QueueDepth
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetJobWait() map[string]*DurationStats {
+	if m != nil {
+		return m.JobWait
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetEvaluationDuration() *DurationStats {
+	if m != nil {
+		return m.EvaluationDuration
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetNodes() []*NodeMetrics {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetCompileFailures() uint64 {
+	if m != nil {
+		return m.CompileFailures
+	}
+	return 0
+}
+
+func (m *GetMetricsResponse) GetSandboxErrors() uint64 {
+	if m != nil {
+		return m.SandboxErrors
+	}
+	return 0
+}
+
+func (m *GetMetricsResponse) GetHealthCheckFailures() uint64 {
+	if m != nil {
+		return m.HealthCheckFailures
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GetEvalsRequest)(nil), "xmc.srv.dispatcher.meta.GetEvalsRequest")
 	proto.RegisterType((*GetEvalsResponse)(nil), "xmc.srv.dispatcher.meta.GetEvalsResponse")
 	proto.RegisterType((*DispatchNextRequest)(nil), "xmc.srv.dispatcher.meta.DispatchNextRequest")
 	proto.RegisterType((*DispatchNextResponse)(nil), "xmc.srv.dispatcher.meta.DispatchNextResponse")
+	proto.RegisterType((*GetMetricsRequest)(nil), "xmc.srv.dispatcher.meta.GetMetricsRequest")
+	proto.RegisterType((*QueueDepth)(nil), "xmc.srv.dispatcher.meta.QueueDepth")
+	proto.RegisterType((*Bucket)(nil), "xmc.srv.dispatcher.meta.Bucket")
+	proto.RegisterType((*DurationStats)(nil), "xmc.srv.dispatcher.meta.DurationStats")
+	proto.RegisterType((*NodeMetrics)(nil), "xmc.srv.dispatcher.meta.NodeMetrics")
+	proto.RegisterType((*GetMetricsResponse)(nil), "xmc.srv.dispatcher.meta.GetMetricsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -102,6 +326,8 @@ var _ server.Option
 type MetaServiceClient interface {
 	GetEvals(ctx context.Context, in *GetEvalsRequest, opts ...client.CallOption) (*GetEvalsResponse, error)
 	DispatchNext(ctx context.Context, in *DispatchNextRequest, opts ...client.CallOption) (*DispatchNextResponse, error)
+	// GetMetrics returns the aggregates of the metrics of the queue and the evals
+	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...client.CallOption) (*GetMetricsResponse, error)
 }
 
 type metaServiceClient struct {
@@ -142,11 +368,23 @@ func (c *metaServiceClient) DispatchNext(ctx context.Context, in *DispatchNextRe
 	return out, nil
 }
 
+func (c *metaServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...client.CallOption) (*GetMetricsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "MetaService.GetMetrics", in)
+	out := new(GetMetricsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MetaService service
 
 type MetaServiceHandler interface {
 	GetEvals(context.Context, *GetEvalsRequest, *GetEvalsResponse) error
 	DispatchNext(context.Context, *DispatchNextRequest, *DispatchNextResponse) error
+	// GetMetrics returns the aggregates of the metrics of the queue and the evals
+	GetMetrics(context.Context, *GetMetricsRequest, *GetMetricsResponse) error
 }
 
 func RegisterMetaServiceHandler(s server.Server, hdlr MetaServiceHandler, opts ...server.HandlerOption) {
@@ -165,28 +403,62 @@ func (h *MetaService) DispatchNext(ctx context.Context, in *DispatchNextRequest,
 	return h.MetaServiceHandler.DispatchNext(ctx, in, out)
 }
 
+func (h *MetaService) GetMetrics(ctx context.Context, in *GetMetricsRequest, out *GetMetricsResponse) error {
+	return h.MetaServiceHandler.GetMetrics(ctx, in, out)
+}
+
 func init() {
 	proto.RegisterFile("github.com/xmc-dev/xmc/dispatcher-srv/proto/meta/meta.proto", fileDescriptor0)
 }
 
 var fileDescriptor0 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcf, 0x4b, 0xc3, 0x30,
-	0x18, 0xb5, 0xa8, 0x73, 0x7c, 0x13, 0x94, 0xf8, 0xab, 0xd4, 0xcb, 0xe8, 0xa9, 0xa2, 0x4d, 0x71,
-	0xe2, 0xc9, 0x9b, 0x38, 0xc4, 0x83, 0x3b, 0xd4, 0x9b, 0xb7, 0xac, 0xfd, 0x66, 0x0b, 0xcb, 0x52,
-	0x93, 0x2c, 0xf4, 0xbf, 0xf5, 0x5f, 0x91, 0xa4, 0x2b, 0x9b, 0xb2, 0xc2, 0x2e, 0xaf, 0xbc, 0x7e,
-	0x2f, 0xef, 0xbd, 0x2f, 0x81, 0xa7, 0xaf, 0x52, 0x17, 0xcb, 0x29, 0xcd, 0x04, 0x4f, 0x6a, 0x9e,
-	0xc5, 0x39, 0x1a, 0xfb, 0x4d, 0xf2, 0x52, 0x55, 0x4c, 0x67, 0x05, 0xca, 0x58, 0x49, 0x93, 0x54,
-	0x52, 0x68, 0x91, 0x70, 0xd4, 0xcc, 0x01, 0x75, 0x9c, 0x5c, 0xd5, 0x3c, 0xa3, 0x4a, 0x1a, 0xba,
-	0x56, 0x53, 0x3b, 0x0e, 0x1e, 0x3b, 0x5c, 0xd1, 0xb0, 0xf9, 0x86, 0x9f, 0xa5, 0x0e, 0x1a, 0xbf,
-	0xf0, 0x16, 0x4e, 0x5e, 0x51, 0x8f, 0x0d, 0x9b, 0xab, 0x14, 0xbf, 0x97, 0xa8, 0x34, 0xf1, 0xe1,
-	0x48, 0xe2, 0x4c, 0xa2, 0x2a, 0x7c, 0x6f, 0xe8, 0x45, 0xfd, 0xb4, 0xa5, 0xe1, 0x18, 0x4e, 0xd7,
-	0x62, 0x55, 0x89, 0x85, 0x42, 0x72, 0x0f, 0x87, 0xd6, 0x4e, 0xf9, 0xde, 0x70, 0x3f, 0x1a, 0x8c,
-	0xae, 0x69, 0x5b, 0xd0, 0x85, 0x38, 0x98, 0x88, 0x1c, 0xdf, 0x16, 0x33, 0x91, 0x36, 0xca, 0xf0,
-	0x02, 0xce, 0x5e, 0x56, 0xed, 0x27, 0x58, 0xeb, 0x55, 0x6e, 0x78, 0x09, 0xe7, 0x7f, 0x7f, 0x37,
-	0x09, 0xa3, 0x1f, 0x0f, 0x06, 0xef, 0xa8, 0xd9, 0x07, 0x4a, 0x53, 0x66, 0x48, 0x18, 0xf4, 0xdb,
-	0x16, 0x24, 0xa2, 0x1d, 0xf7, 0x41, 0xff, 0x6d, 0x15, 0xdc, 0xec, 0xa0, 0x6c, 0x02, 0xc3, 0x3d,
-	0xc2, 0xe1, 0x78, 0xb3, 0x0a, 0xb9, 0xeb, 0x3c, 0xbc, 0x65, 0x91, 0x20, 0xde, 0x51, 0xdd, 0xc6,
-	0x3d, 0xf7, 0x3e, 0x0f, 0xec, 0x78, 0xda, 0x73, 0x6f, 0xf2, 0xf0, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x71, 0x70, 0xda, 0xc4, 0x22, 0x02, 0x00, 0x00,
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x4e, 0xdb, 0x30,
+	0x14, 0x26, 0xfd, 0x85, 0x53, 0x28, 0xe0, 0xc2, 0x16, 0x75, 0x9a, 0x40, 0xd9, 0x98, 0xca, 0x18,
+	0xa9, 0xd6, 0x69, 0x12, 0xb0, 0x5d, 0x41, 0x3b, 0xb4, 0x69, 0xa0, 0x2d, 0xbd, 0x40, 0xda, 0x4d,
+	0x94, 0x1f, 0x43, 0x53, 0x9a, 0x38, 0xd8, 0x4e, 0xd7, 0x5e, 0xee, 0x85, 0xf6, 0x1c, 0x7b, 0x82,
+	0x3d, 0xcf, 0x64, 0x27, 0xa1, 0x41, 0x90, 0xa9, 0x5c, 0xd4, 0xf5, 0x39, 0xe7, 0xf3, 0x77, 0x8e,
+	0xcf, 0xf9, 0xea, 0xc2, 0x87, 0x2b, 0x8f, 0x0f, 0x22, 0x5b, 0x77, 0x88, 0xdf, 0x9e, 0xf8, 0xce,
+	0xbe, 0x8b, 0xc7, 0xe2, 0xbb, 0xed, 0x7a, 0x2c, 0xb4, 0xb8, 0x33, 0xc0, 0x74, 0x9f, 0xd1, 0x71,
+	0x3b, 0xa4, 0x84, 0x93, 0xb6, 0x8f, 0xb9, 0x25, 0x17, 0x5d, 0xda, 0xe8, 0xe9, 0xc4, 0x77, 0x74,
+	0x46, 0xc7, 0xfa, 0x0c, 0xad, 0x8b, 0x70, 0xf3, 0xf0, 0x31, 0xac, 0x43, 0x62, 0x8b, 0x4f, 0xcc,
+	0xd9, 0x7c, 0x9f, 0x73, 0x14, 0x8f, 0xad, 0x51, 0xe6, 0x90, 0x30, 0xe5, 0x12, 0x1f, 0xd3, 0xf6,
+	0x60, 0xf5, 0x14, 0xf3, 0xde, 0xd8, 0x1a, 0x31, 0x03, 0xdf, 0x44, 0x98, 0x71, 0xa4, 0x42, 0x95,
+	0xe2, 0x4b, 0x8a, 0xd9, 0x40, 0x55, 0xb6, 0x95, 0xd6, 0xa2, 0x91, 0x9a, 0x5a, 0x0f, 0xd6, 0x66,
+	0x60, 0x16, 0x92, 0x80, 0x61, 0xf4, 0x16, 0xca, 0x82, 0x8e, 0xa9, 0xca, 0x76, 0xb1, 0x55, 0xeb,
+	0x3c, 0xd3, 0xd3, 0xbb, 0xc9, 0x24, 0x72, 0x39, 0x27, 0x2e, 0xfe, 0x1c, 0x5c, 0x12, 0x23, 0x46,
+	0x6a, 0x9b, 0xd0, 0xe8, 0x26, 0x17, 0x3a, 0xc7, 0x13, 0x9e, 0xe4, 0xd5, 0x9e, 0xc0, 0xc6, 0x5d,
+	0x77, 0x9c, 0x41, 0x6b, 0xc0, 0xfa, 0x29, 0xe6, 0x67, 0x98, 0x53, 0xcf, 0x49, 0x8b, 0xd4, 0x02,
+	0x80, 0xef, 0x11, 0x8e, 0x70, 0x17, 0x87, 0x7c, 0x80, 0xbe, 0x42, 0x3d, 0xa4, 0x1e, 0xa1, 0x1e,
+	0x9f, 0x9a, 0xce, 0xc8, 0x62, 0x4c, 0x56, 0x5e, 0xef, 0xec, 0xe8, 0x0f, 0x74, 0x5a, 0xf4, 0xec,
+	0x5b, 0x82, 0x3e, 0x11, 0x60, 0x63, 0x25, 0xcc, 0x9a, 0x08, 0x41, 0x69, 0x48, 0x6c, 0xa6, 0x16,
+	0xb6, 0x95, 0x56, 0xd1, 0x90, 0x7b, 0xed, 0x1c, 0x2a, 0xc7, 0x91, 0x73, 0x8d, 0x39, 0xd2, 0xa1,
+	0x11, 0x85, 0x21, 0xa6, 0xa6, 0x4d, 0xa2, 0xc0, 0x35, 0x19, 0x76, 0x48, 0xe0, 0xc6, 0x09, 0x15,
+	0x63, 0x5d, 0x86, 0x8e, 0x45, 0xa4, 0x1f, 0x07, 0xd0, 0x06, 0x94, 0x1d, 0x12, 0x05, 0x5c, 0xd2,
+	0x95, 0x8c, 0xd8, 0xd0, 0x7e, 0x29, 0xb0, 0xd2, 0x8d, 0xa8, 0xc5, 0x3d, 0x12, 0xf4, 0xb9, 0xc5,
+	0x33, 0x38, 0x25, 0x83, 0x43, 0x5b, 0x50, 0x63, 0x91, 0x7f, 0x9b, 0xa5, 0x20, 0xb3, 0x00, 0x8b,
+	0xfc, 0x94, 0xfe, 0x10, 0xaa, 0xb6, 0x2c, 0x8c, 0xa9, 0x45, 0x39, 0x81, 0x2d, 0x3d, 0x47, 0x5d,
+	0x7a, 0x7c, 0x01, 0x23, 0xc5, 0x6b, 0x7f, 0x15, 0xa8, 0x89, 0xd9, 0x24, 0xad, 0x45, 0x75, 0x28,
+	0x78, 0xae, 0x4c, 0xbf, 0x64, 0x14, 0x3c, 0x57, 0xf4, 0x21, 0xb0, 0x7c, 0x2c, 0x93, 0x2e, 0x19,
+	0x72, 0x8f, 0x9e, 0x03, 0xd8, 0x11, 0x9b, 0x9a, 0xb2, 0x72, 0xb5, 0x28, 0xcb, 0x59, 0x12, 0x1e,
+	0x43, 0x38, 0xd0, 0x2e, 0xac, 0x39, 0xc4, 0x0f, 0xbd, 0x11, 0x36, 0x2f, 0x2d, 0x6f, 0x14, 0x51,
+	0xcc, 0xd4, 0x92, 0xbc, 0xcf, 0x6a, 0xe2, 0xff, 0x94, 0xb8, 0xd1, 0x0e, 0xd4, 0x99, 0x15, 0xb8,
+	0x36, 0x99, 0x98, 0x98, 0x52, 0x42, 0x99, 0x5a, 0x96, 0xc0, 0x95, 0xc4, 0xdb, 0x93, 0x4e, 0xd4,
+	0x81, 0xcd, 0x01, 0xb6, 0x46, 0x7c, 0x60, 0x3a, 0x03, 0xec, 0x5c, 0xcf, 0x68, 0x2b, 0x12, 0xdd,
+	0x88, 0x83, 0x27, 0x22, 0x96, 0x52, 0x6b, 0xbf, 0x4b, 0x80, 0xb2, 0x92, 0x49, 0xa4, 0xda, 0x85,
+	0xda, 0x8d, 0xd0, 0x8c, 0xe9, 0x0a, 0xd1, 0x24, 0x82, 0x7d, 0x91, 0xdb, 0xae, 0x99, 0xbe, 0x0c,
+	0xb8, 0xb9, 0xdd, 0xa3, 0x3e, 0x2c, 0x0e, 0x89, 0x6d, 0xfe, 0xb4, 0x3c, 0x31, 0x52, 0x41, 0x71,
+	0x90, 0x4b, 0x71, 0xbf, 0x08, 0xfd, 0x0b, 0xb1, 0x2f, 0x2c, 0x8f, 0xf7, 0x02, 0x4e, 0xa7, 0x46,
+	0x75, 0x18, 0x5b, 0xe8, 0x02, 0x1a, 0xe2, 0xb7, 0x11, 0x49, 0x3d, 0x98, 0x6e, 0x22, 0x0c, 0xd9,
+	0xdf, 0x5a, 0xe7, 0x55, 0x2e, 0xff, 0x1d, 0x05, 0x19, 0x68, 0x46, 0x91, 0x06, 0xd0, 0x11, 0x94,
+	0x03, 0xe2, 0xca, 0x29, 0x88, 0x52, 0x5f, 0xe6, 0x52, 0x65, 0x84, 0x60, 0xc4, 0x47, 0x1e, 0x1c,
+	0x66, 0x79, 0xde, 0x61, 0x56, 0x1e, 0x35, 0xcc, 0x6a, 0xee, 0x30, 0x9b, 0x36, 0x2c, 0x67, 0x7b,
+	0x86, 0xd6, 0xa0, 0x78, 0x8d, 0xa7, 0x89, 0x4c, 0xc5, 0x16, 0x7d, 0x84, 0xb2, 0xb8, 0x78, 0x2c,
+	0xd4, 0xf9, 0xdb, 0x15, 0x1f, 0x3a, 0x2a, 0x1c, 0x28, 0x9d, 0x3f, 0x05, 0xa8, 0x9d, 0x61, 0x6e,
+	0xf5, 0x31, 0x1d, 0x7b, 0x0e, 0x46, 0x16, 0x2c, 0xa6, 0x0f, 0x1d, 0x6a, 0xfd, 0x6f, 0xba, 0xd9,
+	0x87, 0xb3, 0xb9, 0x3b, 0x07, 0x32, 0x79, 0xd3, 0x16, 0x90, 0x0f, 0xcb, 0xd9, 0xd7, 0x0e, 0xbd,
+	0xc9, 0xaf, 0xfa, 0xfe, 0x5b, 0xd9, 0xdc, 0x9f, 0x13, 0x7d, 0x9b, 0xee, 0x0a, 0x60, 0x26, 0x46,
+	0xf4, 0x7a, 0x2e, 0xc5, 0xc6, 0xa9, 0xf6, 0x1e, 0xa1, 0x6e, 0x6d, 0xe1, 0xb8, 0xf2, 0xa3, 0x24,
+	0x82, 0x76, 0x45, 0xfe, 0xbf, 0xbc, 0xfb, 0x37, 0x00, 0x06, 0x96, 0xc9, 0x15, 0x29, 0x07, 0x00,
+	0x00,
 }
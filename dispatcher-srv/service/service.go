@@ -2,6 +2,7 @@ package service
 
 import (
 	"os"
+	"time"
 
 	consul "github.com/hashicorp/consul/api"
 	"github.com/micro/cli"
@@ -18,6 +19,19 @@ type Service struct {
 	Debug               bool
 	HealthCheckInterval int
 
+	// LeaseDuration is how long a job stays assigned to an eval without a heartbeat
+	LeaseDuration time.Duration
+	// ReaperInterval is the interval at which expired leases are looked for
+	ReaperInterval time.Duration
+	// MaxRetries is the number of times a job is requeued before it is marked as a system error
+	MaxRetries int
+	// MaxJobsPerUser is the number of jobs of the same user that can be processed at the same time, not counting rejudges
+	MaxJobsPerUser int
+	// MaxAcquireWait is the longest time an eval waits for jobs in a call to Acquire
+	MaxAcquireWait time.Duration
+	// MetricsAddress is the address on which the Prometheus metrics are served. They are not served if it's empty
+	MetricsAddress string
+
 	DBType string
 	DBURL  string
 	DBLog  bool
@@ -54,6 +68,46 @@ func NewService() *Service {
 				Value:       10,
 				Destination: &s.HealthCheckInterval,
 			},
+			cli.DurationFlag{
+				Name:        "lease_duration",
+				EnvVar:      "CFG_LEASE_DURATION",
+				Usage:       "How long a job stays assigned to an eval that doesn't send heartbeats. Defaults to 1 minute",
+				Value:       time.Minute,
+				Destination: &s.LeaseDuration,
+			},
+			cli.DurationFlag{
+				Name:        "reaper_interval",
+				EnvVar:      "CFG_REAPER_INTERVAL",
+				Usage:       "Interval at which jobs with expired leases are requeued. Defaults to 10 seconds",
+				Value:       10 * time.Second,
+				Destination: &s.ReaperInterval,
+			},
+			cli.IntFlag{
+				Name:        "max_job_retries",
+				EnvVar:      "CFG_MAX_JOB_RETRIES",
+				Usage:       "The number of times a job with an expired lease is requeued before it fails. Defaults to 3",
+				Value:       3,
+				Destination: &s.MaxRetries,
+			},
+			cli.IntFlag{
+				Name:        "max_jobs_per_user",
+				EnvVar:      "CFG_MAX_JOBS_PER_USER",
+				Usage:       "The number of jobs of the same user that are evaluated at the same time, not counting rejudges. 0 means no limit",
+				Destination: &s.MaxJobsPerUser,
+			},
+			cli.DurationFlag{
+				Name:        "max_acquire_wait",
+				EnvVar:      "CFG_MAX_ACQUIRE_WAIT",
+				Usage:       "The longest time an eval waits for jobs in a call to Acquire. Defaults to 30 seconds",
+				Value:       30 * time.Second,
+				Destination: &s.MaxAcquireWait,
+			},
+			cli.StringFlag{
+				Name:        "metrics_address",
+				EnvVar:      "CFG_METRICS_ADDRESS",
+				Usage:       "The address on which Prometheus metrics are served at /metrics, like :9180. Metrics are not served if empty",
+				Destination: &s.MetricsAddress,
+			},
 			cli.StringFlag{
 				Name:        "database_url",
 				EnvVar:      "CFG_DB_URL",
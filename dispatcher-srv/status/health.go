@@ -60,6 +60,8 @@ func HealthCheck() []*NodeInfo {
 					aliveNodes[node.Id].Update(rsp.Info, addr)
 				}
 				delete(needCheck, node.Id)
+			} else {
+				healthCheckFailures.WithLabelValues(node.Id).Inc()
 			}
 
 		}
@@ -78,8 +80,10 @@ func HealthCheck() []*NodeInfo {
 	first := true
 	alives := ""
 	aliveNow := []*NodeInfo{}
+	nodeBusyRatio.Reset()
 	for _, v := range aliveNodes {
 		aliveNow = append(aliveNow, v)
+		nodeBusyRatio.WithLabelValues(v.Name).Set(v.BusyRatio())
 		if !first {
 			alives += ", "
 		}
@@ -91,3 +95,16 @@ func HealthCheck() []*NodeInfo {
 
 	return aliveNow
 }
+
+// NodeByName returns the alive node with the given name, or nil if there is none
+func NodeByName(name string) *NodeInfo {
+	mutex.Lock()
+	defer mutex.Unlock()
+	for _, ni := range aliveNodes {
+		if ni.Name == name {
+			return ni
+		}
+	}
+
+	return nil
+}
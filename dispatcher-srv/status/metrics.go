@@ -0,0 +1,39 @@
+package status
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/xmc-dev/xmc/common/metrics"
+)
+
+var nodeBusyRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "xmc_dispatcher_node_busy_ratio",
+	Help: "Part of the slots of the alive eval nodes that are evaluating jobs.",
+}, []string{"node"})
+var healthCheckFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "xmc_dispatcher_health_check_failures_total",
+	Help: "Number of failed health checks of the eval nodes.",
+}, []string{"node_id"})
+
+func init() {
+	prometheus.MustRegister(nodeBusyRatio, healthCheckFailures)
+}
+
+// HealthCheckFailures returns the number of failed health checks of the node with the given ID
+func HealthCheckFailures(id string) uint64 {
+	return uint64(metrics.Sum(healthCheckFailures.WithLabelValues(id)))
+}
+
+// TotalHealthCheckFailures returns the number of failed health checks of all nodes
+func TotalHealthCheckFailures() uint64 {
+	return uint64(metrics.Sum(healthCheckFailures))
+}
+
+// BusyRatio returns the part of the slots of the node that are evaluating jobs
+func (ni *NodeInfo) BusyRatio() float64 {
+	slots := ni.UsedSlots + ni.FreeSlots
+	if slots <= 0 {
+		return 0
+	}
+
+	return float64(ni.UsedSlots) / float64(slots)
+}
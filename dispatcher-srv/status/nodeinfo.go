@@ -11,6 +11,18 @@ type NodeInfo struct {
 	Address     string
 	Idle        bool
 	Disabled    bool
+	Concurrency int32
+	UsedSlots   int32
+	FreeSlots   int32
+
+	// Languages maps the codes of the installed languages to their versions
+	Languages      map[string]string
+	CPUModel       string
+	BenchmarkScore float64
+	Labels         map[string]string
+
+	CompileFailures uint64
+	SandboxErrors   uint64
 }
 
 func NewNodeInfo(pni *eval.NodeInfo, address string, id string) *NodeInfo {
@@ -18,10 +30,8 @@ func NewNodeInfo(pni *eval.NodeInfo, address string, id string) *NodeInfo {
 		ID:          id,
 		Name:        pni.Name,
 		Description: pni.Description,
-		Address:     address,
-		Idle:        pni.Idle,
-		Disabled:    pni.Disabled,
 	}
+	pi.Update(pni, address)
 
 	return pi
 }
@@ -29,6 +39,19 @@ func NewNodeInfo(pni *eval.NodeInfo, address string, id string) *NodeInfo {
 func (ni *NodeInfo) Update(pni *eval.NodeInfo, address string) {
 	ni.Address = address
 	ni.Idle = pni.Idle
+	ni.Disabled = pni.Disabled
+	ni.Concurrency = pni.Concurrency
+	ni.UsedSlots = pni.UsedSlots
+	ni.FreeSlots = pni.FreeSlots
+	ni.Languages = make(map[string]string)
+	for _, l := range pni.Languages {
+		ni.Languages[l.Code] = l.Version
+	}
+	ni.CPUModel = pni.CpuModel
+	ni.BenchmarkScore = pni.BenchmarkScore
+	ni.Labels = pni.Labels
+	ni.CompileFailures = pni.CompileFailures
+	ni.SandboxErrors = pni.SandboxErrors
 }
 
 func (ni *NodeInfo) ToProto() *eval.NodeInfo {
@@ -39,6 +62,22 @@ func (ni *NodeInfo) ToProto() *eval.NodeInfo {
 		Address:     ni.Address,
 		Idle:        ni.Idle,
 		Disabled:    ni.Disabled,
+		Concurrency: ni.Concurrency,
+		UsedSlots:   ni.UsedSlots,
+		FreeSlots:   ni.FreeSlots,
+
+		CpuModel:       ni.CPUModel,
+		BenchmarkScore: ni.BenchmarkScore,
+		Labels:         ni.Labels,
+
+		CompileFailures: ni.CompileFailures,
+		SandboxErrors:   ni.SandboxErrors,
+	}
+	for code, version := range ni.Languages {
+		pni.Languages = append(pni.Languages, &eval.LanguageInfo{
+			Code:    code,
+			Version: version,
+		})
 	}
 
 	return pni
@@ -0,0 +1,294 @@
+// Package cache keeps the test files and the compiled graders of the datasets on the disk of the eval node,
+// so that they aren't downloaded and compiled again for every job.
+//
+// The entries are addressed by their contents: a file is stored under the SHA-256 of its contents
+// and a directory under a hash of everything it was built from, so an entry never becomes stale.
+// The cache is kept under a size limit by removing the least recently used entries.
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/xmc-dev/xmc/eval-srv/util"
+)
+
+// kinds are the subdirectories that hold the entries of the cache
+var kinds = []string{"files", "dirs"}
+
+type entry struct {
+	kind     string
+	key      string
+	size     int64
+	lastUsed time.Time
+}
+
+// Cache is a directory in which files are cached. A nil cache never has the requested entries and doesn't store anything.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	// m guards the entries and the removal of entries from the disk
+	m       sync.Mutex
+	entries map[string]*entry
+	size    int64
+}
+
+// New creates a cache in the given directory, creating the directory if it doesn't exist.
+// The cache keeps at most maxSize bytes, or any number of bytes if maxSize isn't positive.
+// The entries that are already in the directory are kept, their modification time tells when they were last used.
+func New(dir string, maxSize int64) (*Cache, error) {
+	c := &Cache{
+		dir:     dir,
+		maxSize: maxSize,
+		entries: make(map[string]*entry),
+	}
+	if err := os.RemoveAll(filepath.Join(dir, "tmp")); err != nil {
+		return nil, errors.Wrap(err, "couldn't clean cache temp dir")
+	}
+	for _, d := range append(kinds, "tmp") {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			return nil, errors.Wrap(err, "couldn't create cache directory")
+		}
+	}
+	for _, kind := range kinds {
+		if err := c.load(kind); err != nil {
+			return nil, err
+		}
+	}
+	c.m.Lock()
+	c.evict()
+	c.m.Unlock()
+
+	return c, nil
+}
+
+// load adds the entries of the given kind that are on the disk, removing the ones that don't have a valid key
+func (c *Cache) load(kind string) error {
+	infos, err := ioutil.ReadDir(filepath.Join(c.dir, kind))
+	if err != nil {
+		return errors.Wrap(err, "couldn't read cache directory")
+	}
+	for _, info := range infos {
+		p := filepath.Join(c.dir, kind, info.Name())
+		if !ValidKey(info.Name()) || info.IsDir() != (kind == "dirs") {
+			if err := os.RemoveAll(p); err != nil {
+				return errors.Wrap(err, "couldn't remove invalid cache entry")
+			}
+			continue
+		}
+		size, err := diskSize(p)
+		if err != nil {
+			return err
+		}
+		c.add(&entry{kind: kind, key: info.Name(), size: size, lastUsed: info.ModTime()})
+	}
+
+	return nil
+}
+
+// ValidKey returns whether key can address an entry: a hex encoded SHA-256
+func ValidKey(key string) bool {
+	if len(key) != 64 {
+		return false
+	}
+	for _, r := range key {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Cache) path(kind, key string) string {
+	return filepath.Join(c.dir, kind, key)
+}
+
+// use marks the entry as used now, returning false if it isn't cached
+func (c *Cache) use(kind, key string) bool {
+	ent, ok := c.entries[kind+"/"+key]
+	if !ok {
+		return false
+	}
+	ent.lastUsed = time.Now()
+	// the time is kept on the disk for when the eval is restarted
+	os.Chtimes(c.path(kind, key), ent.lastUsed, ent.lastUsed)
+
+	return true
+}
+
+func (c *Cache) add(ent *entry) {
+	c.entries[ent.kind+"/"+ent.key] = ent
+	c.size += ent.size
+}
+
+// evict removes the least recently used entries until the cache fits in its maximum size
+func (c *Cache) evict() {
+	if c.maxSize <= 0 || c.size <= c.maxSize {
+		return
+	}
+	lru := make([]*entry, 0, len(c.entries))
+	for _, ent := range c.entries {
+		lru = append(lru, ent)
+	}
+	sort.Slice(lru, func(i, j int) bool {
+		return lru[i].lastUsed.Before(lru[j].lastUsed)
+	})
+	for _, ent := range lru {
+		if c.size <= c.maxSize {
+			break
+		}
+		if err := os.RemoveAll(c.path(ent.kind, ent.key)); err != nil {
+			continue
+		}
+		delete(c.entries, ent.kind+"/"+ent.key)
+		c.size -= ent.size
+	}
+}
+
+// GetFile copies the cached file with the given key to dest.
+// It returns false if the file is not cached.
+func (c *Cache) GetFile(key, dest string) (bool, error) {
+	if c == nil || !ValidKey(key) {
+		return false, nil
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if !c.use("files", key) {
+		return false, nil
+	}
+
+	return true, util.CopyFile(c.path("files", key), dest)
+}
+
+// PutFile stores a copy of src as the file with the given key, which should be the SHA-256 of its contents
+func (c *Cache) PutFile(key, src string) error {
+	if c == nil || !ValidKey(key) {
+		return nil
+	}
+	tmp, err := ioutil.TempDir(filepath.Join(c.dir, "tmp"), "file")
+	if err != nil {
+		return errors.Wrap(err, "couldn't create cache temp dir")
+	}
+	defer os.RemoveAll(tmp)
+	file := filepath.Join(tmp, "file")
+	if err := util.CopyFile(src, file); err != nil {
+		return err
+	}
+
+	return c.store("files", key, file)
+}
+
+// GetDir copies the files of the cached directory with the given key to dest, which must exist.
+// It returns false if the directory is not cached.
+func (c *Cache) GetDir(key, dest string) (bool, error) {
+	if c == nil || !ValidKey(key) {
+		return false, nil
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if !c.use("dirs", key) {
+		return false, nil
+	}
+
+	return true, copyDir(c.path("dirs", key), dest)
+}
+
+// PutDir stores a copy of the files of src as the directory with the given key
+func (c *Cache) PutDir(key, src string) error {
+	if c == nil || !ValidKey(key) {
+		return nil
+	}
+	tmp, err := ioutil.TempDir(filepath.Join(c.dir, "tmp"), "dir")
+	if err != nil {
+		return errors.Wrap(err, "couldn't create cache temp dir")
+	}
+	defer os.RemoveAll(tmp)
+	dir := filepath.Join(tmp, "dir")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return errors.Wrap(err, "couldn't create cache temp dir")
+	}
+	if err := copyDir(src, dir); err != nil {
+		return err
+	}
+
+	return c.store("dirs", key, dir)
+}
+
+// store makes the files of src read-only, so that they can't be changed by mistake,
+// and moves src in the cache as the entry with the given key
+func (c *Cache) store(kind, key, src string) error {
+	size, err := diskSize(src)
+	if err != nil {
+		return err
+	}
+	err = filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		return os.Chmod(path, 0444)
+	})
+	if err != nil {
+		return errors.Wrap(err, "couldn't make cache entry read-only")
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.use(kind, key) {
+		// another worker stored the same contents in the meantime
+		return nil
+	}
+	if err := os.Rename(src, c.path(kind, key)); err != nil {
+		return errors.Wrap(err, "couldn't move entry in cache")
+	}
+	c.add(&entry{kind: kind, key: key, size: size, lastUsed: time.Now()})
+	c.evict()
+
+	return nil
+}
+
+// diskSize returns the total size of the file at path or of the files inside it
+func diskSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size, errors.Wrap(err, "couldn't get size of cache entry")
+}
+
+// copyDir copies the files from the src directory, and from its subdirectories, to dest
+func copyDir(src, dest string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+		if info.IsDir() {
+			if rel == "." {
+				return nil
+			}
+			return errors.Wrap(os.Mkdir(target, info.Mode()), "couldn't create directory")
+		}
+
+		return util.CopyFile(path, target)
+	})
+}
@@ -0,0 +1,209 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func key(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "cache_test")
+	if err != nil {
+		t.Fatal("Couldn't create temp dir:", err)
+	}
+	return dir
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal("Couldn't write file:", err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal("Couldn't read file:", err)
+	}
+	return string(b)
+}
+
+func TestFile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	c, err := New(filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatal("Couldn't create cache:", err)
+	}
+
+	src := filepath.Join(dir, "src")
+	dest := filepath.Join(dir, "dest")
+	writeFile(t, src, "contents")
+	if ok, err := c.GetFile(key("contents"), dest); ok || err != nil {
+		t.Fatalf("Got file from empty cache: %v, %v", ok, err)
+	}
+	if err := c.PutFile(key("contents"), src); err != nil {
+		t.Fatal("Couldn't put file:", err)
+	}
+	if ok, err := c.GetFile(key("contents"), dest); !ok || err != nil {
+		t.Fatalf("Couldn't get cached file: %v, %v", ok, err)
+	}
+	if got := readFile(t, dest); got != "contents" {
+		t.Errorf("Wrong contents, expected 'contents', got '%s'", got)
+	}
+
+	// the copy must not share its contents with the cached file
+	writeFile(t, dest, "changed")
+	dest2 := filepath.Join(dir, "dest2")
+	if ok, err := c.GetFile(key("contents"), dest2); !ok || err != nil {
+		t.Fatalf("Couldn't get cached file: %v, %v", ok, err)
+	}
+	if got := readFile(t, dest2); got != "contents" {
+		t.Errorf("Changing a copy changed the cached file, got '%s'", got)
+	}
+}
+
+func TestInvalidKey(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	c, err := New(filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatal("Couldn't create cache:", err)
+	}
+
+	src := filepath.Join(dir, "src")
+	writeFile(t, src, "contents")
+	for _, k := range []string{"", "../../etc", key("contents")[:63], "G" + key("contents")[1:]} {
+		if err := c.PutFile(k, src); err != nil {
+			t.Errorf("Putting file with invalid key %q failed: %v", k, err)
+		}
+		if ok, _ := c.GetFile(k, filepath.Join(dir, "dest")); ok {
+			t.Errorf("Got file with invalid key %q", k)
+		}
+	}
+}
+
+func TestDir(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	c, err := New(filepath.Join(dir, "cache"), 0)
+	if err != nil {
+		t.Fatal("Couldn't create cache:", err)
+	}
+
+	src := filepath.Join(dir, "src")
+	if err := os.MkdirAll(filepath.Join(src, "sub"), 0755); err != nil {
+		t.Fatal("Couldn't create dir:", err)
+	}
+	writeFile(t, filepath.Join(src, "a"), "a")
+	writeFile(t, filepath.Join(src, "sub", "b"), "b")
+	if err := c.PutDir(key("dir"), src); err != nil {
+		t.Fatal("Couldn't put dir:", err)
+	}
+
+	dest := filepath.Join(dir, "dest")
+	if err := os.Mkdir(dest, 0755); err != nil {
+		t.Fatal("Couldn't create dir:", err)
+	}
+	if ok, err := c.GetDir(key("dir"), dest); !ok || err != nil {
+		t.Fatalf("Couldn't get cached dir: %v, %v", ok, err)
+	}
+	if got := readFile(t, filepath.Join(dest, "a")); got != "a" {
+		t.Errorf("Wrong contents of a, got '%s'", got)
+	}
+	if got := readFile(t, filepath.Join(dest, "sub", "b")); got != "b" {
+		t.Errorf("Wrong contents of sub/b, got '%s'", got)
+	}
+}
+
+func TestEviction(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	c, err := New(filepath.Join(dir, "cache"), 10)
+	if err != nil {
+		t.Fatal("Couldn't create cache:", err)
+	}
+
+	src := filepath.Join(dir, "src")
+	dest := filepath.Join(dir, "dest")
+	for _, s := range []string{"aaaa", "bbbb"} {
+		writeFile(t, src, s)
+		if err := c.PutFile(key(s), src); err != nil {
+			t.Fatal("Couldn't put file:", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// aaaa becomes the most recently used file, so bbbb is evicted to make room for cccc
+	if ok, _ := c.GetFile(key("aaaa"), dest); !ok {
+		t.Fatal("aaaa was evicted too early")
+	}
+	time.Sleep(10 * time.Millisecond)
+	writeFile(t, src, "cccc")
+	if err := c.PutFile(key("cccc"), src); err != nil {
+		t.Fatal("Couldn't put file:", err)
+	}
+
+	for s, cached := range map[string]bool{"aaaa": true, "bbbb": false, "cccc": true} {
+		if ok, _ := c.GetFile(key(s), dest); ok != cached {
+			t.Errorf("Expected %s cached: %v, got %v", s, cached, ok)
+		}
+	}
+	if c.size > 10 {
+		t.Errorf("Cache is larger than its limit: %d bytes", c.size)
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	cacheDir := filepath.Join(dir, "cache")
+	c, err := New(cacheDir, 0)
+	if err != nil {
+		t.Fatal("Couldn't create cache:", err)
+	}
+
+	src := filepath.Join(dir, "src")
+	writeFile(t, src, "contents")
+	if err := c.PutFile(key("contents"), src); err != nil {
+		t.Fatal("Couldn't put file:", err)
+	}
+	// an entry of the old layout, keyed by attachment ID and version
+	old := filepath.Join(cacheDir, "files", "0b3a4f7e-7d76-11e8-adc0-fa7ae01bbebc")
+	if err := os.MkdirAll(old, 0755); err != nil {
+		t.Fatal("Couldn't create dir:", err)
+	}
+	writeFile(t, filepath.Join(old, "1530000000000000000"), "old")
+
+	c, err = New(cacheDir, 0)
+	if err != nil {
+		t.Fatal("Couldn't reopen cache:", err)
+	}
+	if ok, _ := c.GetFile(key("contents"), filepath.Join(dir, "dest")); !ok {
+		t.Error("File was lost when reopening the cache")
+	}
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("Invalid entry was not removed")
+	}
+	if c.size != int64(len("contents")) {
+		t.Errorf("Wrong cache size, expected %d, got %d", len("contents"), c.size)
+	}
+}
+
+func TestNil(t *testing.T) {
+	var c *Cache
+	if ok, err := c.GetFile(key("a"), "dest"); ok || err != nil {
+		t.Errorf("Nil cache returned %v, %v", ok, err)
+	}
+	if err := c.PutFile(key("a"), "src"); err != nil {
+		t.Error("Nil cache returned", err)
+	}
+}
@@ -7,60 +7,46 @@ import (
 	"github.com/micro/go-micro/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/xmc-dev/xmc/common/perms"
-	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	"github.com/xmc-dev/xmc/eval-srv/consts"
 	"github.com/xmc-dev/xmc/eval-srv/proto/eval"
 	"github.com/xmc-dev/xmc/eval-srv/worker"
 )
 
 type EvalService struct {
-	Worker *worker.Worker
-
-	disabled bool
+	Pool *worker.Pool
 }
 
 func evalSName(method string) string {
 	return fmt.Sprintf("%s.EvalService.%s", consts.ServiceName, method)
 }
 
-func (es *EvalService) Assign(ctx context.Context, req *eval.AssignRequest, rsp *eval.AssignResponse) error {
-	methodName := evalSName("Assign")
-
-	switch {
-	case es.disabled:
-		return errors.BadRequest(methodName, "node is disabled")
-	case req.Job == nil:
-		return errors.BadRequest(methodName, "invalid job")
-	}
-
-	if !perms.HasScope(ctx, "assign") {
-		return errors.Forbidden(methodName, "you are not allowed to assign submissions")
-	}
-
-	j := req.Job
-	jb := job.FromProto(j)
-	err := es.Worker.Work(jb)
-	if err != nil {
-		return errors.BadRequest(methodName, err.Error())
-	}
-
-	return nil
-}
-
 func (es *EvalService) GetStatus(ctx context.Context, req *eval.GetStatusRequest, rsp *eval.GetStatusResponse) error {
+	used := es.Pool.Used()
+	c := worker.NodeCapabilities()
 	rsp.Info = &eval.NodeInfo{
 		Id:          srv.Micro.Server().Options().Id,
 		Name:        srv.Name,
 		Description: srv.Description,
-		Idle:        es.Worker.IsIdle(),
-		Disabled:    es.disabled,
+		Idle:        used == 0,
+		Disabled:    es.Pool.Disabled(),
+		Concurrency: int32(es.Pool.Concurrency()),
+		UsedSlots:   int32(used),
+		FreeSlots:   int32(es.Pool.Capacity() - used),
+
+		Languages:      c.Languages,
+		CpuModel:       c.CPUModel,
+		BenchmarkScore: c.BenchmarkScore,
+		Labels:         c.Labels,
+
+		CompileFailures: worker.CompileFailures(),
+		SandboxErrors:   worker.SandboxErrors(),
 	}
 	return nil
 }
 
 func (es *EvalService) SetDisabled(ctx context.Context, req *eval.SetDisabledRequest, rsp *eval.SetDisabledResponse) error {
-	es.disabled = req.Disabled
-	if es.disabled {
+	es.Pool.SetDisabled(req.Disabled)
+	if req.Disabled {
 		logrus.Warn("Node has been disabled!")
 	} else {
 		logrus.Warn("Node has been enabled!")
@@ -68,3 +54,21 @@ func (es *EvalService) SetDisabled(ctx context.Context, req *eval.SetDisabledReq
 
 	return nil
 }
+
+func (es *EvalService) Abort(ctx context.Context, req *eval.AbortRequest, rsp *eval.AbortResponse) error {
+	methodName := evalSName("Abort")
+	if len(req.JobUuid) == 0 {
+		return errors.BadRequest(methodName, "invalid job_uuid")
+	}
+
+	if !perms.HasScope(ctx, "assign") {
+		return errors.Forbidden(methodName, "you are not allowed to abort jobs")
+	}
+
+	if !es.Pool.Abort(req.JobUuid) {
+		return errors.NotFound(methodName, "job is not being evaluated")
+	}
+	logrus.WithField("job_uuid", req.JobUuid).Warn("Aborting job")
+
+	return nil
+}
@@ -23,19 +23,25 @@ type RunResult struct {
 	CPUTime   time.Duration
 	WallTime  time.Duration
 	MemUsed   uint
+	MaxRSS    uint // peak resident set size in kilobytes
 	Signal    os.Signal
 	ErrorType BoxError
+	// Status is the status code reported by the runner, like "TO" for a timeout
+	Status string
+	// Message describes the status, like "Time limit exceeded (wall clock)"
+	Message string
 }
 
 // BoxConfig contains configuration data for the BoxRunner
 type BoxConfig struct {
-	CPUTime      time.Duration
-	WallTime     time.Duration
-	MemoryLimit  uint
-	StackLimit   uint
-	MaxProc      uint
-	ShareNetwork bool
-	Env          []EnvPair
+	CPUTime       time.Duration
+	WallTime      time.Duration
+	MemoryLimit   uint
+	StackLimit    uint
+	MaxProc       uint
+	FileSizeLimit uint // in kilobytes, 0 means no limit
+	ShareNetwork  bool
+	Env           []EnvPair
 }
 
 // Runner is an interface for various program isolating methods
@@ -43,6 +49,7 @@ type Runner interface {
 	Init() error
 	Run(stdin io.Reader, stdout, stderr io.Writer, command string, args ...string) (RunResult, error)
 	Cleanup() error
+	Kill() error
 }
 
 const (
@@ -110,7 +117,7 @@ func DefaultBoxConfig() BoxConfig {
 func NewBox() *Box {
 	b := Box{}
 	b.Config = DefaultBoxConfig()
-	b.runner = &BoxRunner{&b}
+	b.runner = &BoxRunner{B: &b}
 
 	return &b
 }
@@ -131,6 +138,11 @@ func (b *Box) RunOutput(command string, args ...string) (string, string, RunResu
 	return stdout.String(), stderr.String(), result, err
 }
 
+// Kill calls the runner's Kill function.
+func (b *Box) Kill() error {
+	return b.runner.Kill()
+}
+
 // Cleanup calls the runner's Cleanup function.
 func (b *Box) Cleanup() error {
 	return b.runner.Cleanup()
@@ -130,6 +130,9 @@ func (br *BoxRunner) Run(command string, args ...string) (result RunResult, err
 					return
 				}
 				result.ErrorType = Timeout
+				// the jail only has a wall time limit, so the kind of timeout isn't known
+				result.Status = "TO"
+				result.Message = "Time limit exceeded"
 			}
 		case err = <-done:
 			doneProcess()
@@ -173,6 +176,7 @@ func (br *BoxRunner) Run(command string, args ...string) (result RunResult, err
 		}
 		result.CPUTime = state.SystemTime() + state.UserTime()
 		result.MemUsed = uint(us.Maxrss)
+		result.MaxRSS = uint(us.Maxrss)
 	}
 
 	if result.ExitCode != 0 && result.ErrorType == NoError {
@@ -11,6 +11,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -18,6 +19,9 @@ import (
 // BoxRunner is a Runner based on isolate (See README)
 type BoxRunner struct {
 	B *Box
+
+	m    sync.Mutex
+	proc *os.Process
 }
 
 // See the isolate(1) for the format of the meta file returned by isolate.
@@ -108,6 +112,7 @@ func (br *BoxRunner) Run(stdin io.Reader, stdout, stderr io.Writer, command stri
 	apf("--wall-time", br.B.Config.WallTime.Seconds())
 	ap("--stack", br.B.Config.StackLimit)
 	ap("--cg-mem", br.B.Config.MemoryLimit)
+	ap("--fsize", br.B.Config.FileSizeLimit)
 
 	if br.B.Config.MaxProc == 0 {
 		params = append(params, "-p")
@@ -129,8 +134,26 @@ func (br *BoxRunner) Run(stdin io.Reader, stdout, stderr io.Writer, command stri
 
 	params = append(params, "--cg", "--run", "--", command)
 	params = append(params, args...)
-	_, err = Exec(stdin, stdout, stderr, "isolate", params...)
+	cmd := exec.Command("isolate", params...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	br.m.Lock()
+	err = cmd.Start()
 	if err != nil {
+		br.m.Unlock()
+		return
+	}
+	br.proc = cmd.Process
+	br.m.Unlock()
+	err = cmd.Wait()
+	br.m.Lock()
+	br.proc = nil
+	br.m.Unlock()
+	if _, ok := err.(*exec.ExitError); ok {
+		// isolate exits with a non-zero status when the program fails, the details are in the meta file
+		err = nil
+	} else if err != nil {
 		return
 	}
 	meta, err := parseMetaFile(metaFileName)
@@ -149,6 +172,10 @@ func (br *BoxRunner) Run(stdin io.Reader, stdout, stderr io.Writer, command stri
 
 	memused, _ := strconv.ParseUint(meta["cg-mem"], 10, 64)
 	result.MemUsed = uint(memused)
+	maxRSS, _ := strconv.ParseUint(meta["max-rss"], 10, 64)
+	result.MaxRSS = uint(maxRSS)
+	result.Status = meta["status"]
+	result.Message = meta["message"]
 	if _, ok := meta["status"]; ok {
 		result.ErrorType = BoxError(NoError)
 	}
@@ -184,3 +211,15 @@ func (br *BoxRunner) Cleanup() error {
 
 	return nil
 }
+
+// Kill stops the program that is running inside the box, if any.
+// isolate kills the processes of the box when it is terminated.
+func (br *BoxRunner) Kill() error {
+	br.m.Lock()
+	defer br.m.Unlock()
+	if br.proc == nil {
+		return nil
+	}
+
+	return br.proc.Signal(syscall.SIGTERM)
+}
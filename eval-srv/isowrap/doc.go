@@ -0,0 +1,7 @@
+// Package isowrap runs programs inside isolate boxes.
+//
+// It is a fork of github.com/xmc-dev/isowrap (revision ecf9ada) kept in this
+// repository because the evaluator depends on changes the upstream package
+// doesn't have: killing a running box, output file size limits, maximum RSS
+// and status message reporting and feeding stdin to the sandboxed process.
+package isowrap
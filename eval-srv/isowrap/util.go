@@ -16,6 +16,7 @@ type ExecResult struct {
 // Exec executes a command and returns its stdout, stderr and exit status
 func Exec(stdin io.Reader, stdout, stderr io.Writer, program string, args ...string) (result ExecResult, err error) {
 	cmd := exec.Command(program, args...)
+	cmd.Stdin = stdin
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
 
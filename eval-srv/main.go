@@ -5,12 +5,14 @@ import (
 	"github.com/sirupsen/logrus"
 	micrologrus "github.com/tudurom/micro-logrus"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+	"github.com/xmc-dev/xmc/common/metrics"
 	"github.com/xmc-dev/xmc/common/perms"
 	"github.com/xmc-dev/xmc/common/wait"
 	"github.com/xmc-dev/xmc/eval-srv/handler"
 	"github.com/xmc-dev/xmc/eval-srv/proto/eval"
 	"github.com/xmc-dev/xmc/eval-srv/service"
 	"github.com/xmc-dev/xmc/eval-srv/worker"
+	"github.com/xmc-dev/xmc/xmc-core/common"
 )
 
 var srv *service.Service
@@ -49,7 +51,18 @@ func main() {
 		logrus.SetLevel(logrus.InfoLevel)
 	}
 
-	eval.RegisterEvalServiceHandler(srv.Micro.Server(), &handler.EvalService{Worker: worker.NewWorker(service.MainService)})
+	if len(srv.LanguagesFile) > 0 {
+		if err := common.LoadLanguages(srv.LanguagesFile); err != nil {
+			log.WithError(err).Fatal("Couldn't load languages")
+		}
+	}
+
+	pool := worker.NewPool(service.MainService)
+	eval.RegisterEvalServiceHandler(srv.Micro.Server(), &handler.EvalService{Pool: pool})
+	go pool.Run()
+	if len(srv.MetricsAddress) > 0 {
+		go metrics.Serve(srv.MetricsAddress)
+	}
 
 	if err := srv.Micro.Run(); err != nil {
 		log.Fatal("Couldn't run service: ", err)
@@ -9,19 +9,19 @@ It is generated from these files:
 
 It has these top-level messages:
 	NodeInfo
-	AssignRequest
-	AssignResponse
+	LanguageInfo
 	GetStatusRequest
 	GetStatusResponse
 	SetDisabledRequest
 	SetDisabledResponse
+	AbortRequest
+	AbortResponse
 */
 package eval
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import xmc_srv_dispatcher_job "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
 
 import (
 	client "github.com/micro/go-micro/client"
@@ -47,6 +47,19 @@ type NodeInfo struct {
 	Idle        bool   `protobuf:"varint,3,opt,name=idle" json:"idle,omitempty"`
 	Address     string `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
 	Disabled    bool   `protobuf:"varint,6,opt,name=disabled" json:"disabled,omitempty"`
+	Concurrency int32  `protobuf:"varint,7,opt,name=concurrency" json:"concurrency,omitempty"`
+	UsedSlots   int32  `protobuf:"varint,8,opt,name=used_slots,json=usedSlots" json:"used_slots,omitempty"`
+	FreeSlots   int32  `protobuf:"varint,9,opt,name=free_slots,json=freeSlots" json:"free_slots,omitempty"`
+	// the languages whose toolchains are installed on the node
+	Languages []*LanguageInfo `protobuf:"bytes,10,rep,name=languages" json:"languages,omitempty"`
+	CpuModel  string          `protobuf:"bytes,11,opt,name=cpu_model,json=cpuModel" json:"cpu_model,omitempty"`
+	// the result of a benchmark of the node, higher is faster. 0 means unknown
+	BenchmarkScore float64           `protobuf:"fixed64,12,opt,name=benchmark_score,json=benchmarkScore" json:"benchmark_score,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,13,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// the number of user programs that failed to compile since the node started
+	CompileFailures uint64 `protobuf:"varint,14,opt,name=compile_failures,json=compileFailures" json:"compile_failures,omitempty"`
+	// the number of sandbox errors since the node started
+	SandboxErrors uint64 `protobuf:"varint,15,opt,name=sandbox_errors,json=sandboxErrors" json:"sandbox_errors,omitempty"`
 }
 
 func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
@@ -96,29 +109,92 @@ func (m *NodeInfo) GetDisabled() bool {
 	return false
 }
 
-type AssignRequest struct {
-	Job *xmc_srv_dispatcher_job.Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+func (m *NodeInfo) GetConcurrency() int32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetUsedSlots() int32 {
+	if m != nil {
+		return m.UsedSlots
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetFreeSlots() int32 {
+	if m != nil {
+		return m.FreeSlots
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetLanguages() []*LanguageInfo {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+func (m *NodeInfo) GetCpuModel() string {
+	if m != nil {
+		return m.CpuModel
+	}
+	return ""
 }
 
-func (m *AssignRequest) Reset()                    { *m = AssignRequest{} }
-func (m *AssignRequest) String() string            { return proto.CompactTextString(m) }
-func (*AssignRequest) ProtoMessage()               {}
-func (*AssignRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (m *NodeInfo) GetBenchmarkScore() float64 {
+	if m != nil {
+		return m.BenchmarkScore
+	}
+	return 0
+}
 
-func (m *AssignRequest) GetJob() *xmc_srv_dispatcher_job.Job {
+func (m *NodeInfo) GetLabels() map[string]string {
 	if m != nil {
-		return m.Job
+		return m.Labels
 	}
 	return nil
 }
 
-type AssignResponse struct {
+func (m *NodeInfo) GetCompileFailures() uint64 {
+	if m != nil {
+		return m.CompileFailures
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetSandboxErrors() uint64 {
+	if m != nil {
+		return m.SandboxErrors
+	}
+	return 0
+}
+
+type LanguageInfo struct {
+	Code    string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *LanguageInfo) Reset()                    { *m = LanguageInfo{} }
+func (m *LanguageInfo) String() string            { return proto.CompactTextString(m) }
+func (*LanguageInfo) ProtoMessage()               {}
+func (*LanguageInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *LanguageInfo) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (m *AssignResponse) Reset()                    { *m = AssignResponse{} }
-func (m *AssignResponse) String() string            { return proto.CompactTextString(m) }
-func (*AssignResponse) ProtoMessage()               {}
-func (*AssignResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (m *LanguageInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
 
 type GetStatusRequest struct {
 }
@@ -126,7 +202,7 @@ type GetStatusRequest struct {
 func (m *GetStatusRequest) Reset()                    { *m = GetStatusRequest{} }
 func (m *GetStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetStatusRequest) ProtoMessage()               {}
-func (*GetStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*GetStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 type GetStatusResponse struct {
 	Info *NodeInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
@@ -135,7 +211,7 @@ type GetStatusResponse struct {
 func (m *GetStatusResponse) Reset()                    { *m = GetStatusResponse{} }
 func (m *GetStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetStatusResponse) ProtoMessage()               {}
-func (*GetStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*GetStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *GetStatusResponse) GetInfo() *NodeInfo {
 	if m != nil {
@@ -151,7 +227,7 @@ type SetDisabledRequest struct {
 func (m *SetDisabledRequest) Reset()                    { *m = SetDisabledRequest{} }
 func (m *SetDisabledRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetDisabledRequest) ProtoMessage()               {}
-func (*SetDisabledRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*SetDisabledRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *SetDisabledRequest) GetDisabled() bool {
 	if m != nil {
@@ -166,16 +242,41 @@ type SetDisabledResponse struct {
 func (m *SetDisabledResponse) Reset()                    { *m = SetDisabledResponse{} }
 func (m *SetDisabledResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetDisabledResponse) ProtoMessage()               {}
-func (*SetDisabledResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*SetDisabledResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type AbortRequest struct {
+	JobUuid string `protobuf:"bytes,1,opt,name=job_uuid,json=jobUuid" json:"job_uuid,omitempty"`
+}
+
+func (m *AbortRequest) Reset()                    { *m = AbortRequest{} }
+func (m *AbortRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbortRequest) ProtoMessage()               {}
+func (*AbortRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *AbortRequest) GetJobUuid() string {
+	if m != nil {
+		return m.JobUuid
+	}
+	return ""
+}
+
+type AbortResponse struct {
+}
+
+func (m *AbortResponse) Reset()                    { *m = AbortResponse{} }
+func (m *AbortResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbortResponse) ProtoMessage()               {}
+func (*AbortResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func init() {
 	proto.RegisterType((*NodeInfo)(nil), "xmc.srv.eval.eval.NodeInfo")
-	proto.RegisterType((*AssignRequest)(nil), "xmc.srv.eval.eval.AssignRequest")
-	proto.RegisterType((*AssignResponse)(nil), "xmc.srv.eval.eval.AssignResponse")
+	proto.RegisterType((*LanguageInfo)(nil), "xmc.srv.eval.eval.LanguageInfo")
 	proto.RegisterType((*GetStatusRequest)(nil), "xmc.srv.eval.eval.GetStatusRequest")
 	proto.RegisterType((*GetStatusResponse)(nil), "xmc.srv.eval.eval.GetStatusResponse")
 	proto.RegisterType((*SetDisabledRequest)(nil), "xmc.srv.eval.eval.SetDisabledRequest")
 	proto.RegisterType((*SetDisabledResponse)(nil), "xmc.srv.eval.eval.SetDisabledResponse")
+	proto.RegisterType((*AbortRequest)(nil), "xmc.srv.eval.eval.AbortRequest")
+	proto.RegisterType((*AbortResponse)(nil), "xmc.srv.eval.eval.AbortResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -186,9 +287,10 @@ var _ server.Option
 // Client API for EvalService service
 
 type EvalServiceClient interface {
-	Assign(ctx context.Context, in *AssignRequest, opts ...client.CallOption) (*AssignResponse, error)
 	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...client.CallOption) (*GetStatusResponse, error)
 	SetDisabled(ctx context.Context, in *SetDisabledRequest, opts ...client.CallOption) (*SetDisabledResponse, error)
+	// Abort stops the evaluation of a job
+	Abort(ctx context.Context, in *AbortRequest, opts ...client.CallOption) (*AbortResponse, error)
 }
 
 type evalServiceClient struct {
@@ -209,9 +311,9 @@ func NewEvalServiceClient(serviceName string, c client.Client) EvalServiceClient
 	}
 }
 
-func (c *evalServiceClient) Assign(ctx context.Context, in *AssignRequest, opts ...client.CallOption) (*AssignResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "EvalService.Assign", in)
-	out := new(AssignResponse)
+func (c *evalServiceClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...client.CallOption) (*GetStatusResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "EvalService.GetStatus", in)
+	out := new(GetStatusResponse)
 	err := c.c.Call(ctx, req, out, opts...)
 	if err != nil {
 		return nil, err
@@ -219,9 +321,9 @@ func (c *evalServiceClient) Assign(ctx context.Context, in *AssignRequest, opts
 	return out, nil
 }
 
-func (c *evalServiceClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...client.CallOption) (*GetStatusResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "EvalService.GetStatus", in)
-	out := new(GetStatusResponse)
+func (c *evalServiceClient) SetDisabled(ctx context.Context, in *SetDisabledRequest, opts ...client.CallOption) (*SetDisabledResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "EvalService.SetDisabled", in)
+	out := new(SetDisabledResponse)
 	err := c.c.Call(ctx, req, out, opts...)
 	if err != nil {
 		return nil, err
@@ -229,9 +331,9 @@ func (c *evalServiceClient) GetStatus(ctx context.Context, in *GetStatusRequest,
 	return out, nil
 }
 
-func (c *evalServiceClient) SetDisabled(ctx context.Context, in *SetDisabledRequest, opts ...client.CallOption) (*SetDisabledResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "EvalService.SetDisabled", in)
-	out := new(SetDisabledResponse)
+func (c *evalServiceClient) Abort(ctx context.Context, in *AbortRequest, opts ...client.CallOption) (*AbortResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "EvalService.Abort", in)
+	out := new(AbortResponse)
 	err := c.c.Call(ctx, req, out, opts...)
 	if err != nil {
 		return nil, err
@@ -242,9 +344,10 @@ func (c *evalServiceClient) SetDisabled(ctx context.Context, in *SetDisabledRequ
 // Server API for EvalService service
 
 type EvalServiceHandler interface {
-	Assign(context.Context, *AssignRequest, *AssignResponse) error
 	GetStatus(context.Context, *GetStatusRequest, *GetStatusResponse) error
 	SetDisabled(context.Context, *SetDisabledRequest, *SetDisabledResponse) error
+	// Abort stops the evaluation of a job
+	Abort(context.Context, *AbortRequest, *AbortResponse) error
 }
 
 func RegisterEvalServiceHandler(s server.Server, hdlr EvalServiceHandler, opts ...server.HandlerOption) {
@@ -255,10 +358,6 @@ type EvalService struct {
 	EvalServiceHandler
 }
 
-func (h *EvalService) Assign(ctx context.Context, in *AssignRequest, out *AssignResponse) error {
-	return h.EvalServiceHandler.Assign(ctx, in, out)
-}
-
 func (h *EvalService) GetStatus(ctx context.Context, in *GetStatusRequest, out *GetStatusResponse) error {
 	return h.EvalServiceHandler.GetStatus(ctx, in, out)
 }
@@ -267,36 +366,54 @@ func (h *EvalService) SetDisabled(ctx context.Context, in *SetDisabledRequest, o
 	return h.EvalServiceHandler.SetDisabled(ctx, in, out)
 }
 
+func (h *EvalService) Abort(ctx context.Context, in *AbortRequest, out *AbortResponse) error {
+	return h.EvalServiceHandler.Abort(ctx, in, out)
+}
+
 func init() {
 	proto.RegisterFile("github.com/xmc-dev/xmc/eval-srv/proto/eval/eval.proto", fileDescriptor0)
 }
 
 var fileDescriptor0 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x8f, 0x94, 0x40,
-	0x10, 0x15, 0x16, 0x71, 0xb6, 0x88, 0x9b, 0xdd, 0x32, 0x26, 0x04, 0x2f, 0x88, 0x1f, 0xd9, 0xcb,
-	0x80, 0x59, 0xe3, 0xc1, 0x8b, 0x89, 0x66, 0x8d, 0xd1, 0x83, 0x26, 0xcc, 0xc5, 0x78, 0x30, 0x01,
-	0xba, 0x66, 0xa6, 0x27, 0x40, 0x23, 0xdd, 0x43, 0xe6, 0xa7, 0x18, 0x7f, 0xad, 0xa1, 0x19, 0x90,
-	0xf9, 0x70, 0xf6, 0x40, 0xd3, 0x55, 0xfd, 0xde, 0xeb, 0x7a, 0x2f, 0x0d, 0x6f, 0x16, 0x5c, 0x2d,
-	0xd7, 0x69, 0x98, 0x89, 0x22, 0xda, 0x14, 0xd9, 0x94, 0x51, 0xd3, 0xfe, 0x23, 0x6a, 0x92, 0x7c,
-	0x2a, 0xeb, 0x26, 0xaa, 0x6a, 0xa1, 0x84, 0x2e, 0xf5, 0x12, 0xea, 0x1a, 0xaf, 0x36, 0x45, 0x16,
-	0xca, 0xba, 0x09, 0x75, 0xaf, 0x5d, 0xbc, 0xb7, 0xff, 0x51, 0x62, 0x5c, 0x56, 0x89, 0xca, 0x96,
-	0x54, 0x8f, 0xf4, 0x56, 0x22, 0x6d, 0xbf, 0x4e, 0x2d, 0xf8, 0x63, 0xc0, 0xe4, 0xab, 0x60, 0xf4,
-	0xb9, 0x9c, 0x0b, 0xbc, 0x00, 0x93, 0x33, 0xd7, 0xf2, 0x8d, 0xeb, 0xf3, 0xd8, 0xe4, 0x0c, 0x11,
-	0xac, 0x32, 0x29, 0xc8, 0x35, 0x74, 0x47, 0xef, 0xd1, 0x07, 0x87, 0x91, 0xcc, 0x6a, 0x5e, 0x29,
-	0x2e, 0x4a, 0xd7, 0xd4, 0x47, 0xe3, 0x56, 0xcb, 0xe2, 0x2c, 0x27, 0xf7, 0xcc, 0x37, 0xae, 0x27,
-	0xb1, 0xde, 0xa3, 0x0b, 0x0f, 0x12, 0xc6, 0x6a, 0x92, 0xd2, 0xbd, 0xaf, 0x19, 0x7d, 0x89, 0x1e,
-	0x4c, 0x18, 0x97, 0x49, 0x9a, 0x13, 0x73, 0x6d, 0xcd, 0x18, 0xea, 0xe0, 0x1d, 0x3c, 0x7c, 0x2f,
-	0x25, 0x5f, 0x94, 0x31, 0xfd, 0x5a, 0x93, 0x54, 0x38, 0x85, 0xb3, 0x95, 0x48, 0xf5, 0x3c, 0xce,
-	0xcd, 0x93, 0xb0, 0x4f, 0xe2, 0x9f, 0xcf, 0xb0, 0x75, 0xf6, 0x45, 0xa4, 0x71, 0x8b, 0x0b, 0x2e,
-	0xe1, 0xa2, 0xe7, 0xcb, 0x4a, 0x94, 0x92, 0x02, 0x84, 0xcb, 0x4f, 0xa4, 0x66, 0x2a, 0x51, 0x6b,
-	0xb9, 0x15, 0x0d, 0x6e, 0xe1, 0x6a, 0xd4, 0xeb, 0x80, 0x18, 0x81, 0xc5, 0xcb, 0xb9, 0x38, 0xb8,
-	0x6a, 0x08, 0x3d, 0xec, 0x53, 0x8b, 0x35, 0x30, 0x78, 0x05, 0x38, 0x23, 0x75, 0xbb, 0x1d, 0xbd,
-	0x1f, 0x78, 0xec, 0xce, 0xd8, 0x73, 0xf7, 0x18, 0x1e, 0xed, 0x30, 0xba, 0x9b, 0x6f, 0x7e, 0x9b,
-	0xe0, 0x7c, 0x6c, 0x92, 0x7c, 0x46, 0x75, 0xc3, 0x33, 0xc2, 0x6f, 0x60, 0x77, 0x26, 0xd0, 0x3f,
-	0x32, 0xc5, 0x4e, 0x3e, 0xde, 0xd3, 0x13, 0x88, 0x6d, 0x02, 0xf7, 0xf0, 0x3b, 0x9c, 0x0f, 0x7e,
-	0xf1, 0xd9, 0x11, 0xc6, 0x7e, 0x42, 0xde, 0xf3, 0xd3, 0xa0, 0x41, 0xf9, 0x27, 0x38, 0x23, 0x47,
-	0xf8, 0xe2, 0x08, 0xed, 0x30, 0x23, 0xef, 0xe5, 0x5d, 0xb0, 0x5e, 0xff, 0x83, 0xfd, 0xc3, 0x6a,
-	0x4f, 0x53, 0x5b, 0xbf, 0xdd, 0xd7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xd0, 0x53, 0xa0,
-	0x42, 0x03, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xd1, 0x6e, 0xd3, 0x4a,
+	0x10, 0xbd, 0x4e, 0x93, 0x34, 0x19, 0xb7, 0x49, 0xbb, 0xf7, 0x5e, 0x69, 0x6f, 0xaa, 0x2b, 0x2c,
+	0x43, 0x69, 0xfa, 0x50, 0x07, 0x15, 0x21, 0x01, 0x02, 0x21, 0x50, 0x0b, 0x42, 0x2a, 0x3c, 0x38,
+	0x42, 0x42, 0x3c, 0x10, 0xd9, 0xbb, 0x93, 0xd6, 0xad, 0xed, 0x0d, 0xbb, 0xb6, 0xd5, 0x7e, 0x08,
+	0x9f, 0xc5, 0x3f, 0xa1, 0xdd, 0x6c, 0x52, 0x97, 0x86, 0xf2, 0xe2, 0xec, 0x9c, 0x39, 0x67, 0x76,
+	0x66, 0x7c, 0x62, 0x78, 0x72, 0x9a, 0x14, 0x67, 0x65, 0x1c, 0x30, 0x91, 0x8d, 0x2e, 0x33, 0x76,
+	0xc0, 0xb1, 0xd2, 0xbf, 0x23, 0xac, 0xa2, 0xf4, 0x40, 0xc9, 0x6a, 0x34, 0x93, 0xa2, 0x10, 0x26,
+	0x34, 0x8f, 0xc0, 0xc4, 0x64, 0xfb, 0x32, 0x63, 0x81, 0x92, 0x55, 0x60, 0x30, 0xfd, 0xf0, 0x7f,
+	0x34, 0xa1, 0xf3, 0x51, 0x70, 0x7c, 0x9f, 0x4f, 0x05, 0xe9, 0x41, 0x23, 0xe1, 0xb4, 0xe9, 0x39,
+	0xc3, 0x6e, 0xd8, 0x48, 0x38, 0x21, 0xd0, 0xcc, 0xa3, 0x0c, 0xa9, 0x63, 0x10, 0x73, 0x26, 0x1e,
+	0xb8, 0x1c, 0x15, 0x93, 0xc9, 0xac, 0x48, 0x44, 0x4e, 0x1b, 0x26, 0x55, 0x87, 0xb4, 0x2a, 0xe1,
+	0x29, 0xd2, 0x35, 0xcf, 0x19, 0x76, 0x42, 0x73, 0x26, 0x14, 0xd6, 0x23, 0xce, 0x25, 0x2a, 0x45,
+	0x5b, 0x46, 0xb1, 0x08, 0xc9, 0x00, 0x3a, 0x3c, 0x51, 0x51, 0x9c, 0x22, 0xa7, 0x6d, 0xa3, 0x58,
+	0xc6, 0xfa, 0x2e, 0x26, 0x72, 0x56, 0x4a, 0x89, 0x39, 0xbb, 0xa2, 0xeb, 0x9e, 0x33, 0x6c, 0x85,
+	0x75, 0x88, 0xfc, 0x0f, 0x50, 0x2a, 0xe4, 0x13, 0x95, 0x8a, 0x42, 0xd1, 0x8e, 0x21, 0x74, 0x35,
+	0x32, 0xd6, 0x80, 0x4e, 0x4f, 0x25, 0xa2, 0x4d, 0x77, 0xe7, 0x69, 0x8d, 0xcc, 0xd3, 0x2f, 0xa1,
+	0x9b, 0x46, 0xf9, 0x69, 0x19, 0x9d, 0xa2, 0xa2, 0xe0, 0xad, 0x0d, 0xdd, 0xc3, 0x7b, 0xc1, 0xad,
+	0x1d, 0x05, 0x27, 0x96, 0xa3, 0x77, 0x14, 0x5e, 0x2b, 0xc8, 0x0e, 0x74, 0xd9, 0xac, 0x9c, 0x64,
+	0x82, 0x63, 0x4a, 0x5d, 0x33, 0x56, 0x87, 0xcd, 0xca, 0x0f, 0x3a, 0x26, 0x7b, 0xd0, 0x8f, 0x31,
+	0x67, 0x67, 0x59, 0x24, 0x2f, 0x26, 0x8a, 0x09, 0x89, 0x74, 0xc3, 0x73, 0x86, 0x4e, 0xd8, 0x5b,
+	0xc2, 0x63, 0x8d, 0x92, 0x57, 0xd0, 0x4e, 0xa3, 0x18, 0x53, 0x45, 0x37, 0x4d, 0x07, 0x7b, 0x2b,
+	0x3a, 0x58, 0xbc, 0xa1, 0xe0, 0xc4, 0x30, 0x8f, 0xf3, 0x42, 0x5e, 0x85, 0x56, 0x46, 0xf6, 0x61,
+	0x8b, 0x89, 0x6c, 0x96, 0xa4, 0x38, 0x99, 0x46, 0x49, 0x5a, 0x4a, 0x54, 0xb4, 0xe7, 0x39, 0xc3,
+	0x66, 0xd8, 0xb7, 0xf8, 0x5b, 0x0b, 0x93, 0x5d, 0xe8, 0xa9, 0x28, 0xe7, 0xb1, 0xb8, 0x9c, 0xa0,
+	0x94, 0x42, 0x2a, 0xda, 0x37, 0xc4, 0x4d, 0x8b, 0x1e, 0x1b, 0x70, 0xf0, 0x0c, 0xdc, 0xda, 0x45,
+	0x64, 0x0b, 0xd6, 0x2e, 0xf0, 0xca, 0xba, 0x40, 0x1f, 0xc9, 0x3f, 0xd0, 0xaa, 0xa2, 0xb4, 0x44,
+	0xfb, 0xfa, 0xe7, 0xc1, 0xf3, 0xc6, 0x53, 0xc7, 0x7f, 0x01, 0x1b, 0xf5, 0x75, 0x69, 0x33, 0x30,
+	0xc1, 0x97, 0x16, 0xd2, 0x67, 0x6d, 0x86, 0x0a, 0xa5, 0xba, 0xb6, 0xcf, 0x22, 0xf4, 0x09, 0x6c,
+	0xbd, 0xc3, 0x62, 0x5c, 0x44, 0x45, 0xa9, 0x42, 0xfc, 0x56, 0xa2, 0x2a, 0xfc, 0x23, 0xd8, 0xae,
+	0x61, 0x6a, 0x26, 0x72, 0x85, 0x64, 0x04, 0xcd, 0x24, 0x9f, 0x0a, 0x53, 0xd6, 0x3d, 0xdc, 0xb9,
+	0x63, 0x65, 0xa1, 0x21, 0xfa, 0x8f, 0x80, 0x8c, 0xb1, 0x38, 0xb2, 0xce, 0xb2, 0xb5, 0x6f, 0x98,
+	0xcf, 0xb9, 0x69, 0x3e, 0xff, 0x5f, 0xf8, 0xfb, 0x86, 0x62, 0x7e, 0xb3, 0xbf, 0x0f, 0x1b, 0xaf,
+	0x63, 0x21, 0x8b, 0x45, 0x89, 0xff, 0xa0, 0x73, 0x2e, 0xe2, 0x49, 0x59, 0x26, 0xdc, 0x0e, 0xb9,
+	0x7e, 0x2e, 0xe2, 0x4f, 0x65, 0xc2, 0xfd, 0x3e, 0x6c, 0x5a, 0xea, 0x5c, 0x7b, 0xf8, 0xbd, 0x01,
+	0xee, 0x71, 0x15, 0xa5, 0x63, 0x94, 0x55, 0xc2, 0x90, 0x7c, 0x86, 0xee, 0x72, 0x34, 0x72, 0x7f,
+	0xc5, 0x10, 0xbf, 0x2e, 0x63, 0xf0, 0xe0, 0x6e, 0x92, 0xed, 0xf1, 0x2f, 0xf2, 0x15, 0xdc, 0x5a,
+	0xf3, 0x64, 0x77, 0x85, 0xec, 0xf6, 0x3a, 0x06, 0x0f, 0xff, 0x44, 0x5b, 0xd6, 0x3f, 0x81, 0x96,
+	0x19, 0x8d, 0xac, 0xfa, 0xbf, 0xd4, 0xf7, 0x33, 0xf0, 0x7e, 0x4f, 0x58, 0x54, 0x7b, 0xd3, 0xfe,
+	0xd2, 0xd4, 0x78, 0xdc, 0x36, 0x9f, 0xa9, 0xc7, 0x3f, 0x07, 0x00, 0x77, 0x9c, 0x6a, 0x8c, 0xdf,
+	0x04, 0x00, 0x00,
 }
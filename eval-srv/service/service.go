@@ -2,6 +2,7 @@ package service
 
 import (
 	"os"
+	"runtime"
 	"strings"
 	"time"
 
@@ -26,6 +27,42 @@ type Service struct {
 
 	OAuth2Token string
 
+	// Capacity is the number of jobs that can be evaluated at the same time
+	Capacity int
+	// Concurrency is the number of test cases of a job that can be run at the same time
+	Concurrency int
+	// InteractorMemoryLimit limits the interactors of the interactive datasets, in kilobytes
+	InteractorMemoryLimit int
+
+	// LanguagesFile is the path of the language registry file
+	LanguagesFile string
+
+	// CompileTimeLimit, CompileMemoryLimit and CompileOutputLimit limit the compilation of user programs
+	CompileTimeLimit   time.Duration
+	CompileMemoryLimit int
+	CompileOutputLimit int
+
+	// OutputLimit and IdlenessLimit limit the user programs of the datasets that don't set their own limits
+	OutputLimit   int
+	IdlenessLimit time.Duration
+
+	// BenchmarkScore measures the speed of the node, higher is faster
+	BenchmarkScore float64
+	// Labels are comma separated key=value pairs that describe the node, matched against the requirements of the datasets
+	Labels string
+
+	// CacheDir is the directory in which the test files and the compiled graders are cached. Nothing is cached if it's empty
+	CacheDir string
+	// CacheSize is the maximum size of the cache, in megabytes
+	CacheSize int
+
+	// HeartbeatInterval is the interval at which the lease of the job being evaluated is extended
+	HeartbeatInterval time.Duration
+	// AcquireWait is how long the eval waits for jobs in a call to Acquire
+	AcquireWait time.Duration
+	// MetricsAddress is the address on which the Prometheus metrics are served. They are not served if it's empty
+	MetricsAddress string
+
 	Debug bool
 }
 
@@ -65,6 +102,114 @@ func NewService() *Service {
 				Usage:       "Set the description of the eval node",
 				Destination: &s.Description,
 			},
+			cli.IntFlag{
+				Name:        "capacity",
+				EnvVar:      "CFG_CAPACITY",
+				Usage:       "Set the number of jobs that are evaluated at the same time. Defaults to 1",
+				Value:       1,
+				Destination: &s.Capacity,
+			},
+			cli.IntFlag{
+				Name:        "concurrency",
+				EnvVar:      "CFG_CONCURRENCY",
+				Usage:       "Set the number of test cases of a job that are run at the same time. Defaults to the number of CPUs",
+				Value:       runtime.NumCPU(),
+				Destination: &s.Concurrency,
+			},
+			cli.StringFlag{
+				Name:        "languages_file",
+				EnvVar:      "CFG_LANGUAGES_FILE",
+				Usage:       "Path to the YAML file that declares the available programming languages. If not set, only C, C++ and Go are available",
+				Destination: &s.LanguagesFile,
+			},
+			cli.DurationFlag{
+				Name:        "compile_time_limit",
+				EnvVar:      "CFG_COMPILE_TIME_LIMIT",
+				Usage:       "The CPU time limit of the compilation of a user program",
+				Value:       10 * time.Second,
+				Destination: &s.CompileTimeLimit,
+			},
+			cli.IntFlag{
+				Name:        "compile_memory_limit",
+				EnvVar:      "CFG_COMPILE_MEMORY_LIMIT",
+				Usage:       "The memory limit of the compilation of a user program, in kilobytes",
+				Value:       512 * 1024,
+				Destination: &s.CompileMemoryLimit,
+			},
+			cli.IntFlag{
+				Name:        "compile_output_limit",
+				EnvVar:      "CFG_COMPILE_OUTPUT_LIMIT",
+				Usage:       "The maximum number of bytes of compiler output that are kept",
+				Value:       64 * 1024,
+				Destination: &s.CompileOutputLimit,
+			},
+			cli.IntFlag{
+				Name:        "output_limit",
+				EnvVar:      "CFG_OUTPUT_LIMIT",
+				Usage:       "The maximum number of bytes a user program may output if its dataset doesn't set a limit",
+				Value:       64 * 1024 * 1024,
+				Destination: &s.OutputLimit,
+			},
+			cli.DurationFlag{
+				Name:        "idleness_limit",
+				EnvVar:      "CFG_IDLENESS_LIMIT",
+				Usage:       "How long a user program may run without using the CPU if its dataset doesn't set a limit. Defaults to 1 second",
+				Value:       time.Second,
+				Destination: &s.IdlenessLimit,
+			},
+			cli.IntFlag{
+				Name:        "interactor_memory_limit",
+				EnvVar:      "CFG_INTERACTOR_MEMORY_LIMIT",
+				Usage:       "The memory limit of the interactors of interactive datasets, in kilobytes",
+				Value:       256 * 1024,
+				Destination: &s.InteractorMemoryLimit,
+			},
+			cli.Float64Flag{
+				Name:        "benchmark_score",
+				EnvVar:      "CFG_BENCHMARK_SCORE",
+				Usage:       "The benchmark score of the node, higher is faster. Datasets can require a minimum score",
+				Destination: &s.BenchmarkScore,
+			},
+			cli.StringFlag{
+				Name:        "labels",
+				EnvVar:      "CFG_LABELS",
+				Usage:       "Comma separated key=value labels of the node, like pool=contest,arch=amd64",
+				Destination: &s.Labels,
+			},
+			cli.DurationFlag{
+				Name:        "heartbeat_interval",
+				EnvVar:      "CFG_HEARTBEAT_INTERVAL",
+				Usage:       "The interval at which the eval tells the dispatcher that it is still working on a job. Must be shorter than the dispatcher's lease duration",
+				Value:       15 * time.Second,
+				Destination: &s.HeartbeatInterval,
+			},
+			cli.StringFlag{
+				Name:        "cache_dir",
+				EnvVar:      "CFG_CACHE_DIR",
+				Usage:       "The directory in which the test files and the compiled graders are cached. Nothing is cached if empty",
+				Value:       "/var/cache/xmc-eval",
+				Destination: &s.CacheDir,
+			},
+			cli.IntFlag{
+				Name:        "cache_size",
+				EnvVar:      "CFG_CACHE_SIZE",
+				Usage:       "The maximum size of the cache, in megabytes. The least recently used files are removed when it is full. Defaults to 2048",
+				Value:       2048,
+				Destination: &s.CacheSize,
+			},
+			cli.DurationFlag{
+				Name:        "acquire_wait",
+				EnvVar:      "CFG_ACQUIRE_WAIT",
+				Usage:       "How long the eval waits for jobs in a request to the dispatcher. Defaults to 20 seconds",
+				Value:       20 * time.Second,
+				Destination: &s.AcquireWait,
+			},
+			cli.StringFlag{
+				Name:        "metrics_address",
+				EnvVar:      "CFG_METRICS_ADDRESS",
+				Usage:       "The address on which Prometheus metrics are served at /metrics, like :9181. Metrics are not served if empty",
+				Destination: &s.MetricsAddress,
+			},
 			cli.BoolFlag{
 				Name:        "debug",
 				EnvVar:      "DEBUG",
@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/eval-srv/proto/eval"
+	"github.com/xmc-dev/xmc/eval-srv/service"
+	"github.com/xmc-dev/xmc/xmc-core/common"
+)
+
+// Capabilities describe what the node can evaluate. They are found once, when they are first needed.
+type Capabilities struct {
+	Languages      []*eval.LanguageInfo
+	CPUModel       string
+	BenchmarkScore float64
+	Labels         map[string]string
+}
+
+var caps *Capabilities
+var capsOnce sync.Once
+
+// NodeCapabilities returns the capabilities of the node
+func NodeCapabilities() *Capabilities {
+	capsOnce.Do(func() {
+		caps = &Capabilities{
+			Languages:      installedLanguages(),
+			CPUModel:       cpuModel(),
+			BenchmarkScore: service.MainService.BenchmarkScore,
+			Labels:         parseLabels(service.MainService.Labels),
+		}
+		log.WithFields(logrus.Fields{
+			"languages": len(caps.Languages),
+			"cpu_model": caps.CPUModel,
+			"labels":    caps.Labels,
+		}).Info("Found node capabilities")
+	})
+
+	return caps
+}
+
+// ToProto returns the capabilities sent to the dispatcher when acquiring jobs
+func (c *Capabilities) ToProto() *pjob.NodeCapabilities {
+	pc := &pjob.NodeCapabilities{
+		CpuModel:       c.CPUModel,
+		BenchmarkScore: c.BenchmarkScore,
+		Labels:         c.Labels,
+	}
+	for _, l := range c.Languages {
+		pc.Languages = append(pc.Languages, l.Code)
+	}
+
+	return pc
+}
+
+// installedLanguages returns the languages of the registry whose toolchains are installed
+func installedLanguages() []*eval.LanguageInfo {
+	langs := []*eval.LanguageInfo{}
+	for _, code := range common.Languages() {
+		spec, _ := common.GetLanguage(code)
+		version := spec.VersionString()
+		if len(version) == 0 {
+			log.WithField("language", code).Warn("Language is not installed")
+			continue
+		}
+		langs = append(langs, &eval.LanguageInfo{
+			Code:    string(code),
+			Version: version,
+		})
+	}
+
+	return langs
+}
+
+// cpuModel returns the model name of the first CPU in /proc/cpuinfo
+func cpuModel() string {
+	f, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		kv := strings.SplitN(sc.Text(), ":", 2)
+		if len(kv) == 2 && strings.TrimSpace(kv[0]) == "model name" {
+			return strings.TrimSpace(kv[1])
+		}
+	}
+
+	return ""
+}
+
+func parseLabels(s string) map[string]string {
+	labels := map[string]string{}
+	for _, l := range strings.Split(s, ",") {
+		if len(strings.TrimSpace(l)) == 0 {
+			continue
+		}
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			log.WithField("label", l).Warn("Invalid label, must be key=value")
+			continue
+		}
+		labels[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return labels
+}
@@ -0,0 +1,160 @@
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	pdataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+// defaultEpsilon is the tolerance of the floating-point checkers when the dataset doesn't set one
+const defaultEpsilon = 1e-6
+
+// maxShownToken is the length after which tokens and lines are truncated in checker messages
+const maxShownToken = 32
+
+// check compares the output of the user program with the ok file using a built-in checker
+func (w *Worker) check(outFilename, okFilename string) (*graderReport, error) {
+	out, err := ioutil.ReadFile(outFilename)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read output file")
+	}
+	ok, err := ioutil.ReadFile(okFilename)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read ok file")
+	}
+	epsilon := w.dataset.Epsilon
+	if epsilon == 0 {
+		epsilon = defaultEpsilon
+	}
+
+	var msg string
+	switch w.dataset.Checker {
+	case pdataset.Checker_TOKENS:
+		msg = compareTokens(out, ok, nil)
+	case pdataset.Checker_LINES:
+		msg = compareLines(out, ok)
+	case pdataset.Checker_FLOAT_ABSOLUTE:
+		msg = compareTokens(out, ok, func(found, expected float64) bool {
+			return math.Abs(found-expected) <= epsilon
+		})
+	case pdataset.Checker_FLOAT_RELATIVE:
+		msg = compareTokens(out, ok, func(found, expected float64) bool {
+			return math.Abs(found-expected) <= epsilon*math.Max(math.Abs(expected), 1)
+		})
+	case pdataset.Checker_UNORDERED_LINES:
+		msg = compareUnorderedLines(out, ok)
+	default:
+		return nil, errors.Errorf("unknown checker %v", w.dataset.Checker)
+	}
+
+	// the reason contains the expected output, so only the admins get to see it
+	if len(msg) > 0 {
+		return &graderReport{
+			verdict:        presult.Verdict_WRONG_ANSWER,
+			score:          decimal.Zero,
+			message:        "Wrong answer",
+			privateMessage: msg,
+		}, nil
+	}
+
+	return &graderReport{
+		verdict: presult.Verdict_ACCEPTED,
+		score:   one,
+		message: "OK",
+	}, nil
+}
+
+func shorten(b []byte) string {
+	if len(b) > maxShownToken {
+		return string(b[:maxShownToken]) + "..."
+	}
+	return string(b)
+}
+
+// compareTokens compares the whitespace separated tokens of the outputs.
+// If floatEqual is not nil, tokens which are both numbers are compared with it.
+// It returns an empty string if the outputs match, else the reason why they don't.
+func compareTokens(out, ok []byte, floatEqual func(found, expected float64) bool) string {
+	found := bytes.Fields(out)
+	expected := bytes.Fields(ok)
+	for i := 0; i < len(found) && i < len(expected); i++ {
+		if bytes.Equal(found[i], expected[i]) {
+			continue
+		}
+		if floatEqual != nil {
+			f, errF := strconv.ParseFloat(string(found[i]), 64)
+			e, errE := strconv.ParseFloat(string(expected[i]), 64)
+			if errF == nil && errE == nil && !math.IsNaN(f) && floatEqual(f, e) {
+				continue
+			}
+		}
+		return fmt.Sprintf("Token #%d differs: expected %q, found %q", i+1, shorten(expected[i]), shorten(found[i]))
+	}
+	if len(found) < len(expected) {
+		return fmt.Sprintf("Output is too short: expected %d tokens, found %d", len(expected), len(found))
+	}
+	if len(found) > len(expected) {
+		return fmt.Sprintf("Output is too long: expected %d tokens, found %d", len(expected), len(found))
+	}
+
+	return ""
+}
+
+// splitLines splits the output in lines without trailing whitespace, dropping the trailing empty lines
+func splitLines(b []byte) [][]byte {
+	lines := bytes.Split(b, []byte("\n"))
+	for i := range lines {
+		lines[i] = bytes.TrimRight(lines[i], " \t\r\v\f")
+	}
+	for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// compareLines compares the outputs line by line.
+// It returns an empty string if the outputs match, else the reason why they don't.
+func compareLines(out, ok []byte) string {
+	found := splitLines(out)
+	expected := splitLines(ok)
+	for i := 0; i < len(found) && i < len(expected); i++ {
+		if !bytes.Equal(found[i], expected[i]) {
+			return fmt.Sprintf("Line #%d differs: expected %q, found %q", i+1, shorten(expected[i]), shorten(found[i]))
+		}
+	}
+	if len(found) != len(expected) {
+		return fmt.Sprintf("Expected %d lines, found %d", len(expected), len(found))
+	}
+
+	return ""
+}
+
+// compareUnorderedLines checks that the outputs have the same lines, in any order.
+// It returns an empty string if the outputs match, else the reason why they don't.
+func compareUnorderedLines(out, ok []byte) string {
+	found := splitLines(out)
+	expected := splitLines(ok)
+	if len(found) != len(expected) {
+		return fmt.Sprintf("Expected %d lines, found %d", len(expected), len(found))
+	}
+	remaining := map[string]int{}
+	for _, l := range expected {
+		remaining[string(l)]++
+	}
+	for _, l := range found {
+		if remaining[string(l)] == 0 {
+			return fmt.Sprintf("Unexpected line %q", shorten(l))
+		}
+		remaining[string(l)]--
+	}
+
+	return ""
+}
@@ -0,0 +1,155 @@
+package worker
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pdataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+func absEqual(epsilon float64) func(found, expected float64) bool {
+	return func(found, expected float64) bool {
+		return math.Abs(found-expected) <= epsilon
+	}
+}
+
+func TestCompareTokens(t *testing.T) {
+	tests := []struct {
+		name       string
+		out, ok    string
+		floatEqual func(found, expected float64) bool
+		match      bool
+	}{
+		{"equal", "1 2 3\n", "1 2 3\n", nil, true},
+		{"whitespace is ignored", "  1\t2\n\n3", "1 2 3\n", nil, true},
+		{"different token", "1 2 4", "1 2 3", nil, false},
+		{"too short", "1 2", "1 2 3", nil, false},
+		{"too long", "1 2 3 4", "1 2 3", nil, false},
+		{"empty", "", "", nil, true},
+		{"floats compared exactly without tolerance", "0.1000001", "0.1", nil, false},
+		{"floats within tolerance", "0.1000001 abc", "0.1 abc", absEqual(1e-6), true},
+		{"floats outside tolerance", "0.11", "0.1", absEqual(1e-6), false},
+		{"words are compared exactly", "abd", "abc", absEqual(1), false},
+		{"number and word", "1", "a", absEqual(1), false},
+		{"nan never matches", "nan", "1", absEqual(math.Inf(1)), false},
+		{"scientific notation", "1e-1", "0.1", absEqual(1e-9), true},
+	}
+
+	for _, test := range tests {
+		msg := compareTokens([]byte(test.out), []byte(test.ok), test.floatEqual)
+		if (msg == "") != test.match {
+			t.Errorf("%s: expected match %v, got message %q", test.name, test.match, msg)
+		}
+	}
+}
+
+func TestCompareLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		out, ok string
+		match   bool
+	}{
+		{"equal", "a b\nc\n", "a b\nc\n", true},
+		{"trailing whitespace is ignored", "a b  \r\nc\t\n\n\n", "a b\nc", true},
+		{"leading whitespace matters", " a b\nc\n", "a b\nc\n", false},
+		{"inner whitespace matters", "a  b\nc\n", "a b\nc\n", false},
+		{"missing line", "a b\n", "a b\nc\n", false},
+		{"extra line", "a b\nc\nd\n", "a b\nc\n", false},
+		{"empty lines in the middle matter", "a b\n\nc\n", "a b\nc\n", false},
+	}
+
+	for _, test := range tests {
+		msg := compareLines([]byte(test.out), []byte(test.ok))
+		if (msg == "") != test.match {
+			t.Errorf("%s: expected match %v, got message %q", test.name, test.match, msg)
+		}
+	}
+}
+
+func TestCompareUnorderedLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		out, ok string
+		match   bool
+	}{
+		{"same order", "a\nb\nc\n", "a\nb\nc\n", true},
+		{"other order", "c\na\nb", "a\nb\nc\n", true},
+		{"duplicates are counted", "a\na\nb\n", "a\nb\nb\n", false},
+		{"missing line", "a\nb\n", "a\nb\nc\n", false},
+		{"different line", "a\nb\nd\n", "a\nb\nc\n", false},
+	}
+
+	for _, test := range tests {
+		msg := compareUnorderedLines([]byte(test.out), []byte(test.ok))
+		if (msg == "") != test.match {
+			t.Errorf("%s: expected match %v, got message %q", test.name, test.match, msg)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checker_test")
+	if err != nil {
+		t.Fatal("Couldn't create temp dir:", err)
+	}
+	defer os.RemoveAll(dir)
+	outFilename := filepath.Join(dir, "out")
+	okFilename := filepath.Join(dir, "ok")
+
+	tests := []struct {
+		name     string
+		checker  pdataset.Checker
+		epsilon  float64
+		out, ok  string
+		expected presult.Verdict
+	}{
+		{"tokens", pdataset.Checker_TOKENS, 0, "1  2\n", "1 2", presult.Verdict_ACCEPTED},
+		{"lines", pdataset.Checker_LINES, 0, "1  2\n", "1 2", presult.Verdict_WRONG_ANSWER},
+		{"default epsilon", pdataset.Checker_FLOAT_ABSOLUTE, 0, "1.0000001", "1", presult.Verdict_ACCEPTED},
+		{"dataset epsilon", pdataset.Checker_FLOAT_ABSOLUTE, 1e-9, "1.0000001", "1", presult.Verdict_WRONG_ANSWER},
+		{"relative", pdataset.Checker_FLOAT_RELATIVE, 1e-3, "1000.5", "1000", presult.Verdict_ACCEPTED},
+		{"relative below 1 is absolute", pdataset.Checker_FLOAT_RELATIVE, 1e-3, "0.0015", "0.001", presult.Verdict_ACCEPTED},
+		{"unordered lines", pdataset.Checker_UNORDERED_LINES, 0, "b\na\n", "a\nb\n", presult.Verdict_ACCEPTED},
+		{"wrong token", pdataset.Checker_TOKENS, 0, "1 2", "1 secret", presult.Verdict_WRONG_ANSWER},
+		{"wrong line", pdataset.Checker_LINES, 0, "a\nb\n", "a\nsecret\n", presult.Verdict_WRONG_ANSWER},
+		{"unexpected line", pdataset.Checker_UNORDERED_LINES, 0, "secret\n", "a\n", presult.Verdict_WRONG_ANSWER},
+	}
+
+	for _, test := range tests {
+		if err := ioutil.WriteFile(outFilename, []byte(test.out), 0644); err != nil {
+			t.Fatal("Couldn't write output file:", err)
+		}
+		if err := ioutil.WriteFile(okFilename, []byte(test.ok), 0644); err != nil {
+			t.Fatal("Couldn't write ok file:", err)
+		}
+		w := &Worker{dataset: &pdataset.Dataset{Checker: test.checker, Epsilon: test.epsilon}}
+		report, err := w.check(outFilename, okFilename)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if report.verdict != test.expected {
+			t.Errorf("%s: expected %v, got %v (%s)", test.name, test.expected, report.verdict, report.message)
+		}
+		if score := report.score; (report.verdict == presult.Verdict_ACCEPTED) != score.Equal(one) {
+			t.Errorf("%s: wrong score %v for verdict %v", test.name, score, report.verdict)
+		}
+		// the contestants must never see the expected output
+		if strings.Contains(report.message, "secret") {
+			t.Errorf("%s: message %q shows the expected output", test.name, report.message)
+		}
+		if report.verdict == presult.Verdict_WRONG_ANSWER && report.privateMessage == "" {
+			t.Errorf("%s: expected a private message with the reason", test.name)
+		}
+	}
+
+	w := &Worker{dataset: &pdataset.Dataset{Checker: pdataset.Checker_CUSTOM}}
+	if _, err := w.check(outFilename, okFilename); err == nil {
+		t.Error("Expected an error for a checker that isn't built in")
+	}
+}
@@ -0,0 +1,111 @@
+package worker
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/xmc-dev/xmc/eval-srv/isowrap"
+	"github.com/xmc-dev/xmc/eval-srv/util"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+// limitedBuffer keeps only the first n bytes written to it
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	n         int
+	truncated bool
+	m         sync.Mutex
+}
+
+func (lb *limitedBuffer) Write(p []byte) (int, error) {
+	lb.m.Lock()
+	defer lb.m.Unlock()
+	if rem := lb.n - lb.buf.Len(); len(p) > rem {
+		lb.truncated = true
+		if rem > 0 {
+			lb.buf.Write(p[:rem])
+		}
+		return len(p), nil
+	}
+
+	return lb.buf.Write(p)
+}
+
+func (lb *limitedBuffer) String() string {
+	if lb.truncated {
+		return lb.buf.String() + "\n[output truncated]"
+	}
+
+	return lb.buf.String()
+}
+
+// compileUserProgram compiles the user program inside a sandbox and copies the build files
+// next to the source file.
+func (w *Worker) compileUserProgram() error {
+	id := <-w.boxes
+	defer func() { w.boxes <- id }()
+	box, err := w.initCompileSandbox(id)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.deinitSandbox(box); err != nil {
+			w.log.Error(err)
+		}
+	}()
+
+	err = util.CopyFile(w.userProgram.Source, filepath.Join(box.Path, filepath.Base(w.userProgram.Source)))
+	if err != nil {
+		return err
+	}
+	cmd, err := w.userProgram.SandboxCompileCommand()
+	if err != nil {
+		return errors.Wrap(err, "couldn't find compiler")
+	}
+	w.result.BuildCommand = strings.Join(cmd, " ")
+	w.log.Debug("Compiling user program ", w.result.BuildCommand)
+
+	out := &limitedBuffer{n: w.srv.CompileOutputLimit}
+	result, err := runInBox(box, bytes.NewReader(nil), out, out, cmd)
+	if err != nil {
+		return errors.Wrap(err, "couldn't execute compiler")
+	}
+	verOut, _ := w.userProgram.Version().CombinedOutput()
+	w.result.CompilationMessage = string(verOut) + "\n" + out.String()
+
+	switch result.ErrorType {
+	case isowrap.NoError:
+	case isowrap.Timeout:
+		compileFailures.WithLabelValues(w.job.Language).Inc()
+		w.result.ErrorMessage = "err_userprogram_compilation_timeout:" + box.Config.CPUTime.String()
+		w.result.Verdict = presult.Verdict_COMPILATION_TIMEOUT
+		return errors.New("user program compilation timed out")
+	default:
+		compileFailures.WithLabelValues(w.job.Language).Inc()
+		_, msg := runError(result, box)
+		w.result.ErrorMessage = "err_userprogram_compilation:" + msg
+		w.result.Verdict = presult.Verdict_COMPILATION_ERROR
+		return errors.New("couldn't compile user program")
+	}
+
+	files, err := ioutil.ReadDir(box.Path)
+	if err != nil {
+		return errors.Wrap(err, "couldn't read compilation sandbox")
+	}
+	dir := filepath.Dir(w.userProgram.Executable)
+	for _, f := range files {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		err := util.CopyFile(filepath.Join(box.Path, f.Name()), filepath.Join(dir, f.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	pgrader "github.com/xmc-dev/xmc/xmc-core/proto/grader"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+// xmcV1Header is the first line of the output of graders that use the XMC_V1 protocol
+const xmcV1Header = "xmc-grader 1"
+
+// testlib exit codes
+const (
+	testlibOK               = 0
+	testlibWrongAnswer      = 1
+	testlibPresentationErr  = 2
+	testlibFail             = 3
+	testlibPartiallyCorrect = 16
+)
+
+// graderReport is the result of a test case as reported by the grader
+type graderReport struct {
+	verdict        presult.Verdict
+	score          decimal.Decimal
+	message        string
+	privateMessage string
+}
+
+// verdictMessage is the message shown to the contestants when the grader has no message for them
+func verdictMessage(v presult.Verdict) string {
+	switch v {
+	case presult.Verdict_ACCEPTED:
+		return "Accepted"
+	case presult.Verdict_PARTIAL:
+		return "Partially correct"
+	}
+
+	return "Wrong answer"
+}
+
+func (gr *graderReport) fill(tr *presult.TestResult) {
+	tr.Verdict = gr.verdict
+	tr.Score = gr.score.String()
+	tr.GraderMessage = gr.message
+	tr.GraderPrivateMessage = gr.privateMessage
+}
+
+// graderExitCode returns the exit code of a grader that was run on the host from the error returned after running it.
+// A grader that couldn't be executed or that didn't exit normally results in an error.
+func graderExitCode(runErr error) (int, error) {
+	if runErr == nil {
+		return 0, nil
+	}
+	exitErr, ok := runErr.(*exec.ExitError)
+	if !ok {
+		return 0, errors.Wrap(runErr, "couldn't execute grader")
+	}
+	ws, ok := exitErr.Sys().(syscall.WaitStatus)
+	if !ok || !ws.Exited() {
+		return 0, errors.Wrap(runErr, "grader failed")
+	}
+
+	return ws.ExitStatus(), nil
+}
+
+// parseGraderOutput interprets the output of the grader according to its protocol.
+// exitCode is the exit code of the grader, which exited normally.
+// A grader that fails or writes a malformed output results in an error.
+func parseGraderOutput(protocol pgrader.Protocol, exitCode int, stdout, stderr []byte) (*graderReport, error) {
+	switch protocol {
+	case pgrader.Protocol_LEGACY:
+		return parseLegacy(exitCode, stdout, stderr)
+	case pgrader.Protocol_XMC_V1:
+		return parseXMCV1(exitCode, stdout, stderr)
+	case pgrader.Protocol_TESTLIB:
+		return parseTestlib(exitCode, stderr)
+	}
+
+	return nil, errors.Errorf("unknown grader protocol %v", protocol)
+}
+
+func parseScore(s string) (decimal.Decimal, error) {
+	score, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return score, errors.Wrap(err, "malformed grader score")
+	}
+	if score.Sign() < 0 || score.GreaterThan(one) {
+		return score, errors.Errorf("grader score %s is not between 0 and 1", score)
+	}
+
+	return score, nil
+}
+
+func parseLegacy(exitCode int, stdout, stderr []byte) (*graderReport, error) {
+	if exitCode != 0 {
+		return nil, errors.Errorf("grader failed with exit code %d", exitCode)
+	}
+	score, err := parseScore(string(stdout))
+	if err != nil {
+		return nil, err
+	}
+
+	// the grader may write the expected output to stderr, which must not be shown to the contestants
+	verdict := scoreVerdict(score)
+	return &graderReport{
+		verdict:        verdict,
+		score:          score,
+		message:        verdictMessage(verdict),
+		privateMessage: strings.TrimSpace(string(stderr)),
+	}, nil
+}
+
+func parseXMCV1(exitCode int, stdout, stderr []byte) (*graderReport, error) {
+	if exitCode != 0 {
+		return nil, errors.Errorf("grader failed with exit code %d", exitCode)
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(stdout))
+	if !sc.Scan() || strings.TrimSpace(sc.Text()) != xmcV1Header {
+		return nil, errors.New("malformed grader output: missing " + xmcV1Header + " header")
+	}
+	gr := &graderReport{}
+	var score *decimal.Decimal
+	messages := []string{}
+	privateMessages := []string{}
+	for sc.Scan() {
+		line := sc.Text()
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("malformed grader output: invalid line %q", line)
+		}
+		value := strings.TrimSpace(kv[1])
+		switch strings.TrimSpace(kv[0]) {
+		case "verdict":
+			switch value {
+			case "accepted":
+				gr.verdict = presult.Verdict_ACCEPTED
+			case "wrong_answer":
+				gr.verdict = presult.Verdict_WRONG_ANSWER
+			case "partial":
+				gr.verdict = presult.Verdict_PARTIAL
+			default:
+				return nil, errors.Errorf("malformed grader output: invalid verdict %q", value)
+			}
+		case "score":
+			s, err := parseScore(value)
+			if err != nil {
+				return nil, err
+			}
+			score = &s
+		case "message":
+			messages = append(messages, value)
+		case "private_message":
+			privateMessages = append(privateMessages, value)
+		default:
+			return nil, errors.Errorf("malformed grader output: unknown key %q", kv[0])
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, errors.Wrap(err, "couldn't read grader output")
+	}
+
+	switch gr.verdict {
+	case presult.Verdict_ACCEPTED:
+		gr.score = one
+	case presult.Verdict_WRONG_ANSWER:
+		gr.score = decimal.Zero
+	case presult.Verdict_PARTIAL:
+		if score == nil {
+			return nil, errors.New("malformed grader output: partial verdict without score")
+		}
+		gr.score = *score
+	default:
+		return nil, errors.New("malformed grader output: missing verdict")
+	}
+	if score != nil && !score.Equal(gr.score) {
+		return nil, errors.Errorf("malformed grader output: score %s doesn't match the verdict", score)
+	}
+
+	if errOut := strings.TrimSpace(string(stderr)); len(errOut) > 0 {
+		privateMessages = append(privateMessages, errOut)
+	}
+	gr.message = strings.Join(messages, "\n")
+	gr.privateMessage = strings.Join(privateMessages, "\n")
+
+	return gr, nil
+}
+
+// parseTestlib interprets the exit code of a testlib checker.
+// Partial scores are given with the exit code 16+p, where p is the percentage of the score.
+func parseTestlib(code int, stderr []byte) (*graderReport, error) {
+	// testlib checkers write things like the expected output to stderr, which only the admins may see
+	gr := &graderReport{
+		score:          decimal.Zero,
+		privateMessage: strings.TrimSpace(string(stderr)),
+	}
+	switch {
+	case code == testlibOK:
+		gr.verdict = presult.Verdict_ACCEPTED
+		gr.score = one
+	case code == testlibWrongAnswer || code == testlibPresentationErr:
+		gr.verdict = presult.Verdict_WRONG_ANSWER
+	case code == testlibFail:
+		return nil, errors.Errorf("grader failed: %s", gr.privateMessage)
+	case code >= testlibPartiallyCorrect && code <= testlibPartiallyCorrect+100:
+		gr.score = decimal.New(int64(code-testlibPartiallyCorrect), -2)
+		gr.verdict = scoreVerdict(gr.score)
+	default:
+		return nil, errors.Errorf("grader exited with unknown exit code %d", code)
+	}
+	gr.message = verdictMessage(gr.verdict)
+
+	return gr, nil
+}
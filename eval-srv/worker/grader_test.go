@@ -0,0 +1,187 @@
+package worker
+
+import (
+	"testing"
+
+	pgrader "github.com/xmc-dev/xmc/xmc-core/proto/grader"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+func TestParseGraderOutput(t *testing.T) {
+	tests := []struct {
+		name           string
+		protocol       pgrader.Protocol
+		exitCode       int
+		stdout, stderr string
+		err            bool
+		verdict        presult.Verdict
+		score          string
+		message        string
+		privateMessage string
+	}{
+		{
+			name:           "legacy",
+			protocol:       pgrader.Protocol_LEGACY,
+			stdout:         "0.5\n",
+			stderr:         "half right\n",
+			verdict:        presult.Verdict_PARTIAL,
+			score:          "0.5",
+			message:        "Partially correct",
+			privateMessage: "half right",
+		},
+		{
+			name:     "legacy accepted",
+			protocol: pgrader.Protocol_LEGACY,
+			stdout:   "1",
+			verdict:  presult.Verdict_ACCEPTED,
+			score:    "1",
+			message:  "Accepted",
+		},
+		{
+			name:     "legacy malformed score",
+			protocol: pgrader.Protocol_LEGACY,
+			stdout:   "ok",
+			err:      true,
+		},
+		{
+			name:     "legacy score out of range",
+			protocol: pgrader.Protocol_LEGACY,
+			stdout:   "1.5",
+			err:      true,
+		},
+		{
+			name:     "legacy failed",
+			protocol: pgrader.Protocol_LEGACY,
+			exitCode: 1,
+			stdout:   "1",
+			err:      true,
+		},
+		{
+			name:           "xmc v1",
+			protocol:       pgrader.Protocol_XMC_V1,
+			stdout:         "xmc-grader 1\nverdict: partial\nscore: 0.25\nmessage: a\n\nmessage: b\nprivate_message: c\n",
+			stderr:         "debug\n",
+			verdict:        presult.Verdict_PARTIAL,
+			score:          "0.25",
+			message:        "a\nb",
+			privateMessage: "c\ndebug",
+		},
+		{
+			name:     "xmc v1 accepted",
+			protocol: pgrader.Protocol_XMC_V1,
+			stdout:   "xmc-grader 1\nverdict: accepted\n",
+			verdict:  presult.Verdict_ACCEPTED,
+			score:    "1",
+		},
+		{
+			name:     "xmc v1 missing header",
+			protocol: pgrader.Protocol_XMC_V1,
+			stdout:   "verdict: accepted\n",
+			err:      true,
+		},
+		{
+			name:     "xmc v1 missing verdict",
+			protocol: pgrader.Protocol_XMC_V1,
+			stdout:   "xmc-grader 1\nscore: 1\n",
+			err:      true,
+		},
+		{
+			name:     "xmc v1 partial without score",
+			protocol: pgrader.Protocol_XMC_V1,
+			stdout:   "xmc-grader 1\nverdict: partial\n",
+			err:      true,
+		},
+		{
+			name:     "xmc v1 score doesn't match verdict",
+			protocol: pgrader.Protocol_XMC_V1,
+			stdout:   "xmc-grader 1\nverdict: wrong_answer\nscore: 0.5\n",
+			err:      true,
+		},
+		{
+			name:     "xmc v1 unknown key",
+			protocol: pgrader.Protocol_XMC_V1,
+			stdout:   "xmc-grader 1\nverdict: accepted\npoints: 3\n",
+			err:      true,
+		},
+		{
+			name:     "xmc v1 failed",
+			protocol: pgrader.Protocol_XMC_V1,
+			exitCode: 2,
+			stdout:   "xmc-grader 1\nverdict: accepted\n",
+			err:      true,
+		},
+		{
+			name:           "testlib ok",
+			protocol:       pgrader.Protocol_TESTLIB,
+			stderr:         "ok 3 numbers\n",
+			verdict:        presult.Verdict_ACCEPTED,
+			score:          "1",
+			message:        "Accepted",
+			privateMessage: "ok 3 numbers",
+		},
+		{
+			name:           "testlib wrong answer",
+			protocol:       pgrader.Protocol_TESTLIB,
+			exitCode:       testlibWrongAnswer,
+			stderr:         "wrong answer expected 5, found 3",
+			verdict:        presult.Verdict_WRONG_ANSWER,
+			score:          "0",
+			message:        "Wrong answer",
+			privateMessage: "wrong answer expected 5, found 3",
+		},
+		{
+			name:     "testlib presentation error",
+			protocol: pgrader.Protocol_TESTLIB,
+			exitCode: testlibPresentationErr,
+			verdict:  presult.Verdict_WRONG_ANSWER,
+			score:    "0",
+			message:  "Wrong answer",
+		},
+		{
+			name:     "testlib partially correct",
+			protocol: pgrader.Protocol_TESTLIB,
+			exitCode: testlibPartiallyCorrect + 40,
+			verdict:  presult.Verdict_PARTIAL,
+			score:    "0.4",
+			message:  "Partially correct",
+		},
+		{
+			name:     "testlib fail",
+			protocol: pgrader.Protocol_TESTLIB,
+			exitCode: testlibFail,
+			err:      true,
+		},
+		{
+			name:     "testlib unknown exit code",
+			protocol: pgrader.Protocol_TESTLIB,
+			exitCode: 7,
+			err:      true,
+		},
+	}
+
+	for _, test := range tests {
+		report, err := parseGraderOutput(test.protocol, test.exitCode, []byte(test.stdout), []byte(test.stderr))
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if report.verdict != test.verdict {
+			t.Errorf("%s: expected verdict %v, got %v", test.name, test.verdict, report.verdict)
+		}
+		if !report.score.Equal(d(test.score)) {
+			t.Errorf("%s: expected score %s, got %s", test.name, test.score, report.score)
+		}
+		if report.message != test.message {
+			t.Errorf("%s: expected message %q, got %q", test.name, test.message, report.message)
+		}
+		if report.privateMessage != test.privateMessage {
+			t.Errorf("%s: expected private message %q, got %q", test.name, test.privateMessage, report.privateMessage)
+		}
+	}
+}
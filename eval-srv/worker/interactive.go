@@ -0,0 +1,192 @@
+package worker
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/micro/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/xmc-dev/xmc/eval-srv/isowrap"
+	"github.com/xmc-dev/xmc/eval-srv/util"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+// interactorGraceTime is how long the interactor may run after the wall time limit of the user program
+const interactorGraceTime = time.Second
+
+// the names of the files of the interactor inside its sandbox
+const (
+	interactorInput = "input"
+	interactorOk    = "ok"
+	interactorScore = "score"
+)
+
+// runInteractive runs the user program and the interactor at the same time, in their own sandboxes,
+// with the stdout of each one connected to the stdin of the other one.
+//
+// The interactor is the grader of the dataset. It is executed with the paths of the input file,
+// of the ok file and of the file in which it must write what graders write to stdout according to
+// their protocol.
+func (w *Worker) runInteractive(testFile string, box *isowrap.Box, tr *presult.TestResult) (*presult.TestResult, decimal.Decimal, error) {
+	score := decimal.Zero
+
+	iBox, err := w.initInteractorSandbox(box)
+	if err != nil {
+		return nil, score, err
+	}
+	defer func() {
+		if err := w.deinitSandbox(iBox); err != nil {
+			w.log.Error(err)
+		}
+	}()
+	if err := copyProgram(w.graderProgram, iBox); err != nil {
+		return nil, score, err
+	}
+	if err := util.CopyFile(testFile+".in", filepath.Join(iBox.Path, interactorInput)); err != nil {
+		return nil, score, err
+	}
+	if err := util.CopyFile(testFile+".ok", filepath.Join(iBox.Path, interactorOk)); err != nil {
+		return nil, score, err
+	}
+	iCommand, err := w.graderProgram.SandboxCommand(interactorInput, interactorOk, interactorScore)
+	if err != nil {
+		return nil, score, errors.Wrap(err, "couldn't find interactor run command")
+	}
+
+	// user program -> interactor
+	uOutR, uOutW, err := os.Pipe()
+	if err != nil {
+		return nil, score, errors.Wrap(err, "couldn't create pipe")
+	}
+	defer uOutR.Close()
+	defer uOutW.Close()
+	// interactor -> user program
+	uInR, uInW, err := os.Pipe()
+	if err != nil {
+		return nil, score, errors.Wrap(err, "couldn't create pipe")
+	}
+	defer uInR.Close()
+	defer uInW.Close()
+
+	var iResult isowrap.RunResult
+	var iRunErr error
+	var iErr bytes.Buffer
+	iDone := make(chan struct{})
+	go func() {
+		defer close(iDone)
+		iResult, iRunErr = runInBox(iBox, uOutR, uInW, &iErr, iCommand)
+		// the ends must be closed here too so that the user program gets EOF when the interactor exits
+		uOutR.Close()
+		uInW.Close()
+	}()
+
+	// the output goes to a pipe, so the file size limit of the sandbox doesn't apply to it
+	uOut := &countingWriter{
+		w:     uOutW,
+		limit: w.outputLimit(),
+		onExceeded: func() {
+			if err := box.Kill(); err != nil {
+				w.log.WithError(err).Warn("Couldn't kill user program that exceeded the output limit")
+			}
+		},
+	}
+	result, err := runInBox(box, uInR, uOut, ioutil.Discard, w.userCommand)
+	uInR.Close()
+	uOutW.Close()
+	if err != nil || uOut.exceeded {
+		if err := iBox.Kill(); err != nil {
+			w.log.WithError(err).Warn("Couldn't kill interactor")
+		}
+		<-iDone
+		if uOut.exceeded {
+			fillUsage(tr, result)
+			tr.StdoutSize = uOut.n
+			tr.Verdict, tr.GraderMessage = presult.Verdict_OUTPUT_LIMIT_EXCEEDED, "Output limit exceeded"
+			return tr, score, nil
+		}
+		return nil, score, errors.Wrap(err, "couldn't execute user program")
+	}
+	w.log.Debug(result, err)
+	<-iDone
+
+	tr.InteractorMemory = int32(iResult.MaxRSS)
+	tr.InteractorTime = ptypes.DurationProto(iResult.CPUTime)
+	fillUsage(tr, result)
+	tr.StdoutSize = uOut.n
+
+	if result.ErrorType != isowrap.NoError {
+		// the interactor most likely failed because the user program did, so its verdict doesn't matter
+		tr.Verdict, tr.GraderMessage = runError(result, box)
+		return tr, score, nil
+	}
+	if iRunErr != nil {
+		return nil, score, errors.Wrap(iRunErr, "couldn't execute interactor")
+	}
+	code, err := interactorExitCode(iResult)
+	if err != nil {
+		return nil, score, err
+	}
+
+	// testlib interactors don't write the score file
+	out, err := ioutil.ReadFile(filepath.Join(iBox.Path, interactorScore))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, score, errors.Wrap(err, "couldn't read interactor score")
+	}
+	report, err := parseGraderOutput(w.graderProtocol, code, out, iErr.Bytes())
+	if err != nil {
+		return nil, score, err
+	}
+	report.fill(tr)
+
+	return tr, report.score, nil
+}
+
+// interactorExitCode returns the exit code of the interactor that ran in a sandbox.
+// An interactor that didn't exit normally results in an error.
+func interactorExitCode(result isowrap.RunResult) (int, error) {
+	switch result.ErrorType {
+	case isowrap.NoError, isowrap.RunTimeError:
+		return result.ExitCode, nil
+	case isowrap.Timeout:
+		return 0, errors.New("interactor exceeded its time limit")
+	case isowrap.MemoryExceeded:
+		return 0, errors.New("interactor exceeded its memory limit")
+	}
+
+	return 0, errors.Errorf("interactor failed: %s", result.Message)
+}
+
+// errOutputLimitExceeded is returned by countingWriter when more than limit bytes are written
+var errOutputLimitExceeded = errors.New("output limit exceeded")
+
+// countingWriter counts the bytes written through it. If limit is greater than 0,
+// it calls onExceeded and stops writing once more than limit bytes are written.
+type countingWriter struct {
+	w          io.Writer
+	n          int64
+	limit      int64
+	onExceeded func()
+	exceeded   bool
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.exceeded {
+		return 0, errOutputLimitExceeded
+	}
+	if cw.limit > 0 && cw.n+int64(len(p)) > cw.limit {
+		n, _ := cw.w.Write(p[:cw.limit-cw.n])
+		cw.n += int64(n)
+		cw.exceeded = true
+		cw.onExceeded()
+		return n, errOutputLimitExceeded
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+
+	return n, err
+}
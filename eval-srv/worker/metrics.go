@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/xmc-dev/xmc/common/metrics"
+)
+
+var compileFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "xmc_eval_compile_failures_total",
+	Help: "Number of user programs that failed to compile.",
+}, []string{"language"})
+var sandboxErrors = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "xmc_eval_sandbox_errors_total",
+	Help: "Number of sandboxes that couldn't be initialized or failed to run a program.",
+})
+var evaluationDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    "xmc_eval_evaluation_duration_seconds",
+	Help:    "Time spent evaluating jobs.",
+	Buckets: metrics.DurationBuckets,
+})
+var cacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "xmc_eval_cache_hits_total",
+	Help: "Number of test files and compiled graders found in the cache of the node.",
+}, []string{"kind"})
+var cacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "xmc_eval_cache_misses_total",
+	Help: "Number of test files and compiled graders that were not in the cache of the node.",
+}, []string{"kind"})
+
+func init() {
+	prometheus.MustRegister(compileFailures, sandboxErrors, evaluationDuration, cacheHits, cacheMisses)
+}
+
+// registerBusyRatio exposes the part of the workers of the pool that are evaluating jobs
+func registerBusyRatio(p *Pool) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "xmc_eval_busy_ratio",
+		Help: "Part of the workers of the node that are evaluating jobs.",
+	}, func() float64 {
+		return float64(p.Used()) / float64(p.Capacity())
+	}))
+}
+
+// CompileFailures returns the number of user programs that failed to compile since the node started
+func CompileFailures() uint64 {
+	return uint64(metrics.Sum(compileFailures))
+}
+
+// SandboxErrors returns the number of sandbox errors since the node started
+func SandboxErrors() uint64 {
+	return uint64(metrics.Sum(sandboxErrors))
+}
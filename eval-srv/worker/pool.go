@@ -0,0 +1,169 @@
+package worker
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
+	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/eval-srv/cache"
+	"github.com/xmc-dev/xmc/eval-srv/service"
+)
+
+// acquireRetryInterval is how long the pool waits before acquiring jobs again after the dispatcher failed
+const acquireRetryInterval = 5 * time.Second
+
+// acquireTimeoutGrace is added to the wait time of Acquire to get the timeout of the request
+const acquireTimeoutGrace = 10 * time.Second
+
+// Pool is a group of workers that evaluate jobs at the same time.
+// Each worker takes up a slot of the eval node.
+type Pool struct {
+	workers []*Worker
+	m       *sync.Mutex
+	srv     *service.Service
+
+	// disabled is 1 if the node doesn't take new jobs
+	disabled int32
+	// freed is signaled when a worker becomes idle or the node is enabled
+	freed chan struct{}
+}
+
+// NewPool creates a Pool with as many workers as the capacity of the eval node
+func NewPool(srv *service.Service) *Pool {
+	p := &Pool{
+		m:     &sync.Mutex{},
+		srv:   srv,
+		freed: make(chan struct{}, 1),
+	}
+	capacity := srv.Capacity
+	if capacity < 1 {
+		capacity = 1
+	}
+	var c *cache.Cache
+	if len(srv.CacheDir) > 0 {
+		var err error
+		c, err = cache.New(srv.CacheDir, int64(srv.CacheSize)<<20)
+		if err != nil {
+			log.WithError(err).Warn("Couldn't create cache, dataset files won't be cached")
+		}
+	}
+	for i := 0; i < capacity; i++ {
+		w := NewWorker(srv, i)
+		w.freed = p.freed
+		w.cache = c
+		p.workers = append(p.workers, w)
+	}
+	registerBusyRatio(p)
+
+	return p
+}
+
+// Run acquires jobs from the dispatcher for the idle workers. It never returns.
+func (p *Pool) Run() {
+	for {
+		free := p.Capacity() - p.Used()
+		if free <= 0 || p.Disabled() {
+			<-p.freed
+			continue
+		}
+
+		jobs, err := p.acquire(free)
+		if err != nil {
+			log.WithError(err).Error("Couldn't acquire jobs")
+			time.Sleep(acquireRetryInterval)
+			continue
+		}
+		for _, pj := range jobs {
+			if err := p.Work(job.FromProto(pj)); err != nil {
+				// the job is requeued by the dispatcher when its lease expires
+				log.WithError(err).WithField("job_uuid", pj.Uuid).Error("Couldn't start job")
+			}
+		}
+	}
+}
+
+// acquire asks the dispatcher for at most n jobs, waiting for them if there are none
+func (p *Pool) acquire(n int) ([]*pjob.Job, error) {
+	rsp, err := jobClient.Acquire(C(), &pjob.AcquireRequest{
+		EvalName:     p.srv.Name,
+		Capacity:     int32(n),
+		Capabilities: NodeCapabilities().ToProto(),
+		Wait:         ptypes.DurationProto(p.srv.AcquireWait),
+	}, client.WithRequestTimeout(p.srv.AcquireWait+acquireTimeoutGrace))
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.Jobs, nil
+}
+
+// SetDisabled sets whether the node takes new jobs
+func (p *Pool) SetDisabled(disabled bool) {
+	if disabled {
+		atomic.StoreInt32(&p.disabled, 1)
+		return
+	}
+	atomic.StoreInt32(&p.disabled, 0)
+	select {
+	case p.freed <- struct{}{}:
+	default:
+	}
+}
+
+// Disabled returns true if the node doesn't take new jobs
+func (p *Pool) Disabled() bool {
+	return atomic.LoadInt32(&p.disabled) != 0
+}
+
+// Work gives the job to an idle worker
+func (p *Pool) Work(j *job.Job) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	for _, w := range p.workers {
+		if err := w.Work(j); err == nil {
+			return nil
+		}
+	}
+
+	return errors.New("No free slots")
+}
+
+// Abort stops the evaluation of a job. It returns false if no worker is evaluating the job.
+func (p *Pool) Abort(jobUUID string) bool {
+	for _, w := range p.workers {
+		if w.Abort(jobUUID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Capacity returns the number of jobs that can be evaluated at the same time
+func (p *Pool) Capacity() int {
+	return len(p.workers)
+}
+
+// Used returns the number of workers that are evaluating a job
+func (p *Pool) Used() int {
+	p.m.Lock()
+	defer p.m.Unlock()
+	used := 0
+	for _, w := range p.workers {
+		if !w.IsIdle() {
+			used++
+		}
+	}
+
+	return used
+}
+
+// Concurrency returns the number of test cases each worker runs at the same time
+func (p *Pool) Concurrency() int {
+	return p.workers[0].Concurrency()
+}
@@ -1,44 +1,148 @@
 package worker
 
 import (
+	"io"
+	"strings"
 	"time"
 
 	"github.com/micro/protobuf/ptypes"
 	"github.com/pkg/errors"
-	"github.com/xmc-dev/isowrap"
+	"github.com/xmc-dev/xmc/eval-srv/isowrap"
 )
 
-const wallGraceTime = time.Second / 4
+// firstBoxID is the isowrap box ID of the first sandbox used by the worker.
+// Each test case that is run in parallel gets its own box ID, starting from this one.
+const firstBoxID = 420
 
-func (w *Worker) initSandbox() error {
-	w.box = isowrap.NewBox()
-	w.box.Config.CPUTime, _ = ptypes.Duration(w.dataset.TimeLimit)
-	w.box.Config.WallTime = w.box.Config.CPUTime + wallGraceTime
-	w.box.Config.MemoryLimit = uint(w.dataset.MemoryLimit)
-	w.box.Config.ShareNetwork = false
-	w.box.ID = 420
+func (w *Worker) initSandbox(id uint) (*isowrap.Box, error) {
+	spec := w.userProgram.Spec
+	box := isowrap.NewBox()
+	timeLimit, _ := ptypes.Duration(w.dataset.TimeLimit)
+	box.Config.CPUTime = time.Duration(float64(timeLimit) * spec.TimeMultiplier)
+	box.Config.WallTime = box.Config.CPUTime + w.idlenessLimit()
+	box.Config.MemoryLimit = uint(float64(w.dataset.MemoryLimit) * spec.MemoryMultiplier)
+	// the stdout of batch programs is a file too, so this limits both the output file and stdout
+	box.Config.FileSizeLimit = uint((w.outputLimit() + 1023) / 1024)
+	box.Config.ShareNetwork = false
+	box.Config.Env = append(box.Config.Env, envPairs(spec.Env)...)
+	box.ID = id
 
-	if err := w.box.Init(); err != nil {
-		w.box.Cleanup()
-		err = w.box.Init()
+	return w.initBox(box)
+}
+
+// outputLimit returns the maximum number of bytes the user program may output
+func (w *Worker) outputLimit() int64 {
+	if w.dataset.OutputLimit > 0 {
+		return w.dataset.OutputLimit
+	}
+
+	return int64(w.srv.OutputLimit)
+}
+
+// idlenessLimit returns how long the user program may run without using the CPU
+func (w *Worker) idlenessLimit() time.Duration {
+	if d, err := ptypes.Duration(w.dataset.IdlenessLimit); err == nil && d > 0 {
+		return d
+	}
+
+	return w.srv.IdlenessLimit
+}
+
+// interactorFileSizeLimit limits the files written by the interactor, in kilobytes. It only writes its score.
+const interactorFileSizeLimit = 1024
+
+// initInteractorSandbox initializes the sandbox in which the interactor talks to the user program
+// that runs in userBox. The interactor box has the ID of the user box plus the concurrency of the worker.
+func (w *Worker) initInteractorSandbox(userBox *isowrap.Box) (*isowrap.Box, error) {
+	box := isowrap.NewBox()
+	// the interactor spends most of the time waiting for the user program, but it may
+	// use the CPU for as long as the user program runs
+	box.Config.CPUTime = userBox.Config.WallTime + interactorGraceTime
+	box.Config.WallTime = userBox.Config.WallTime + interactorGraceTime
+	box.Config.MemoryLimit = uint(w.srv.InteractorMemoryLimit)
+	box.Config.FileSizeLimit = interactorFileSizeLimit
+	box.Config.ShareNetwork = false
+	box.Config.Env = append(box.Config.Env, envPairs(w.graderProgram.Spec.Env)...)
+	box.ID = userBox.ID + uint(w.concurrency)
+
+	return w.initBox(box)
+}
+
+// initCompileSandbox initializes a sandbox in which the user program is compiled
+func (w *Worker) initCompileSandbox(id uint) (*isowrap.Box, error) {
+	box := isowrap.NewBox()
+	box.Config.CPUTime = w.srv.CompileTimeLimit
+	// compilers spend a lot of time waiting for the disk
+	box.Config.WallTime = 2 * box.Config.CPUTime
+	box.Config.MemoryLimit = uint(w.srv.CompileMemoryLimit)
+	box.Config.ShareNetwork = false
+	box.Config.Env = append(box.Config.Env, compileEnv...)
+	box.Config.Env = append(box.Config.Env, envPairs(w.userProgram.Spec.Env)...)
+	box.ID = id
+
+	return w.initBox(box)
+}
+
+// compileEnv is the environment needed by the compilers, which is otherwise cleared by the sandbox
+var compileEnv = []isowrap.EnvPair{
+	{Var: "PATH", Value: "/usr/local/bin:/usr/bin:/bin"},
+	{Var: "HOME", Value: "/tmp"},
+}
+
+func envPairs(env []string) []isowrap.EnvPair {
+	pairs := []isowrap.EnvPair{}
+	for _, e := range env {
+		kv := strings.SplitN(e, "=", 2)
+		if len(kv) == 2 {
+			pairs = append(pairs, isowrap.EnvPair{Var: kv[0], Value: kv[1]})
+		}
+	}
+
+	return pairs
+}
+
+func (w *Worker) initBox(box *isowrap.Box) (*isowrap.Box, error) {
+	id := box.ID
+	if err := box.Init(); err != nil {
+		box.Cleanup()
+		err = box.Init()
 		if err != nil {
-			return errors.Wrap(err, "couldn't init sandbox")
+			sandboxErrors.Inc()
+			return nil, errors.Wrapf(err, "couldn't init sandbox %d", id)
 		}
 	}
 
-	log.WithField("sandbox_path", w.box.Path).Debug("Initialized sandbox")
-	return nil
+	w.boxesM.Lock()
+	w.activeBoxes[id] = box
+	w.boxesM.Unlock()
+
+	w.log.WithField("sandbox_path", box.Path).Debug("Initialized sandbox")
+	return box, nil
 }
 
-func (w *Worker) deinitSandbox() error {
-	if w.box == nil {
+// runInBox runs the command inside the sandbox, counting the sandbox errors
+func runInBox(box *isowrap.Box, stdin io.Reader, stdout, stderr io.Writer, cmd []string) (isowrap.RunResult, error) {
+	result, err := box.Run(stdin, stdout, stderr, cmd[0], cmd[1:]...)
+	if err != nil || result.ErrorType == isowrap.InternalError {
+		sandboxErrors.Inc()
+	}
+
+	return result, err
+}
+
+func (w *Worker) deinitSandbox(box *isowrap.Box) error {
+	if box == nil {
 		return nil
 	}
-	err := w.box.Cleanup()
+	w.boxesM.Lock()
+	delete(w.activeBoxes, box.ID)
+	w.boxesM.Unlock()
+
+	err := box.Cleanup()
 	if err != nil {
-		return errors.Wrap(err, "couldn't deinit sandbox")
+		return errors.Wrapf(err, "couldn't deinit sandbox %d", box.ID)
 	}
 
-	log.Debug("Deinitialized sandbox")
+	w.log.WithField("sandbox_id", box.ID).Debug("Deinitialized sandbox")
 	return nil
 }
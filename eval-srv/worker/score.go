@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	pdataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+var hundred = decimal.New(100, 0)
+var one = decimal.New(1, 0)
+
+// scoreVerdict returns the verdict of a test case on which the user program ran normally
+func scoreVerdict(score decimal.Decimal) presult.Verdict {
+	switch {
+	case score.GreaterThanOrEqual(one):
+		return presult.Verdict_ACCEPTED
+	case score.Sign() > 0:
+		return presult.Verdict_PARTIAL
+	}
+
+	return presult.Verdict_WRONG_ANSWER
+}
+
+// resultVerdict returns the verdict of the submission, which is the verdict of the first test case
+// that wasn't accepted. If all of the failed test cases got partial scores, then the verdict is PARTIAL.
+func resultVerdict(trs []*presult.TestResult) presult.Verdict {
+	v := presult.Verdict_ACCEPTED
+	for _, tr := range trs {
+		if tr.Verdict == presult.Verdict_ACCEPTED {
+			continue
+		}
+		if tr.Verdict != presult.Verdict_PARTIAL {
+			return tr.Verdict
+		}
+		v = presult.Verdict_PARTIAL
+	}
+
+	return v
+}
+
+// score computes the score of the submission from the scores of its test cases, keyed by test number.
+func (w *Worker) score(scores map[int32]decimal.Decimal) error {
+	score, groupResults, err := computeScore(w.dataset, w.testCases, scores)
+	if err != nil {
+		return err
+	}
+	w.result.Score = score.String()
+	w.result.GroupResults = groupResults
+
+	return nil
+}
+
+// computeScore computes the score of a submission according to the scoring policy of the dataset
+// from the scores of the test cases, keyed by test number. The test cases without a score get 0.
+// The group results are only returned by the policies that use test groups.
+func computeScore(ds *pdataset.Dataset, testCases []*pdataset.TestCase,
+	scores map[int32]decimal.Decimal) (decimal.Decimal, []*presult.GroupResult, error) {
+	if ds.ScoringPolicy == pdataset.ScoringPolicy_AVERAGE {
+		if len(testCases) == 0 {
+			return decimal.Zero, nil, nil
+		}
+		sum := decimal.Zero
+		for _, tc := range testCases {
+			sum = sum.Add(scores[tc.Number])
+		}
+		return sum.Div(decimal.New(int64(len(testCases)), 0)).Mul(hundred), nil, nil
+	}
+
+	weights := map[int32]decimal.Decimal{}
+	for _, tg := range ds.TestGroups {
+		weights[tg.Number], _ = decimal.NewFromString(tg.Weight)
+	}
+
+	// the lowest score of each group
+	mins := map[int32]decimal.Decimal{}
+	for _, tc := range testCases {
+		if _, ok := weights[tc.GroupNo]; !ok {
+			return decimal.Zero, nil, errors.Errorf("test case #%d is not in a defined test group", tc.Number)
+		}
+		if min, ok := mins[tc.GroupNo]; !ok || scores[tc.Number].LessThan(min) {
+			mins[tc.GroupNo] = scores[tc.Number]
+		}
+	}
+
+	total := decimal.Zero
+	groupResults := []*presult.GroupResult{}
+	for _, tg := range ds.TestGroups {
+		gs := decimal.Zero
+		if min, ok := mins[tg.Number]; ok {
+			switch ds.ScoringPolicy {
+			case pdataset.ScoringPolicy_GROUP_MIN:
+				gs = weights[tg.Number].Mul(min)
+			case pdataset.ScoringPolicy_GROUP_ALL_OR_NOTHING:
+				if min.GreaterThanOrEqual(one) {
+					gs = weights[tg.Number]
+				}
+			}
+		}
+		groupResults = append(groupResults, &presult.GroupResult{
+			GroupNo: tg.Number,
+			Score:   gs.String(),
+		})
+		total = total.Add(gs)
+	}
+
+	return total, groupResults, nil
+}
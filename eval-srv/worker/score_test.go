@@ -0,0 +1,173 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	pdataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+	presult "github.com/xmc-dev/xmc/xmc-core/proto/result"
+)
+
+func d(s string) decimal.Decimal {
+	v, err := decimal.NewFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestComputeScore(t *testing.T) {
+	groups := []*pdataset.TestGroup{
+		{Number: 1, Weight: "40"},
+		{Number: 2, Weight: "60"},
+	}
+	// the numbers have gaps, like after removing test cases
+	grouped := []*pdataset.TestCase{
+		{Number: 1, GroupNo: 1},
+		{Number: 3, GroupNo: 1},
+		{Number: 4, GroupNo: 2},
+		{Number: 7, GroupNo: 2},
+	}
+	tests := []struct {
+		name      string
+		policy    pdataset.ScoringPolicy
+		groups    []*pdataset.TestGroup
+		testCases []*pdataset.TestCase
+		scores    map[int32]decimal.Decimal
+		expected  string
+		// the scores of the groups, in the order of the groups
+		groupScores []string
+		err         bool
+	}{
+		{
+			name:   "average",
+			policy: pdataset.ScoringPolicy_AVERAGE,
+			testCases: []*pdataset.TestCase{
+				{Number: 2},
+				{Number: 5},
+			},
+			scores:   map[int32]decimal.Decimal{2: d("1"), 5: d("0.5")},
+			expected: "75",
+		},
+		{
+			name:      "average without test cases",
+			policy:    pdataset.ScoringPolicy_AVERAGE,
+			testCases: []*pdataset.TestCase{},
+			expected:  "0",
+		},
+		{
+			name:        "group min",
+			policy:      pdataset.ScoringPolicy_GROUP_MIN,
+			groups:      groups,
+			testCases:   grouped,
+			scores:      map[int32]decimal.Decimal{1: d("1"), 3: d("0.5"), 4: d("1"), 7: d("1")},
+			expected:    "80",
+			groupScores: []string{"20", "60"},
+		},
+		{
+			name:        "group all or nothing",
+			policy:      pdataset.ScoringPolicy_GROUP_ALL_OR_NOTHING,
+			groups:      groups,
+			testCases:   grouped,
+			scores:      map[int32]decimal.Decimal{1: d("1"), 3: d("0.99"), 4: d("1"), 7: d("1")},
+			expected:    "60",
+			groupScores: []string{"0", "60"},
+		},
+		{
+			name:        "missing scores are 0",
+			policy:      pdataset.ScoringPolicy_GROUP_MIN,
+			groups:      groups,
+			testCases:   grouped,
+			scores:      map[int32]decimal.Decimal{1: d("1"), 3: d("1")},
+			expected:    "40",
+			groupScores: []string{"40", "0"},
+		},
+		{
+			name:   "empty group",
+			policy: pdataset.ScoringPolicy_GROUP_MIN,
+			groups: groups,
+			testCases: []*pdataset.TestCase{
+				{Number: 1, GroupNo: 2},
+			},
+			scores:      map[int32]decimal.Decimal{1: d("1")},
+			expected:    "60",
+			groupScores: []string{"0", "60"},
+		},
+		{
+			name:   "test case without a group",
+			policy: pdataset.ScoringPolicy_GROUP_MIN,
+			groups: groups,
+			testCases: []*pdataset.TestCase{
+				{Number: 1, GroupNo: 1},
+				{Number: 2},
+			},
+			scores: map[int32]decimal.Decimal{1: d("1"), 2: d("1")},
+			err:    true,
+		},
+	}
+
+	for _, test := range tests {
+		ds := &pdataset.Dataset{ScoringPolicy: test.policy, TestGroups: test.groups}
+		score, grs, err := computeScore(ds, test.testCases, test.scores)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !score.Equal(d(test.expected)) {
+			t.Errorf("%s: expected score %s, got %s", test.name, test.expected, score)
+		}
+		if len(grs) != len(test.groupScores) {
+			t.Errorf("%s: expected %d group results, got %d", test.name, len(test.groupScores), len(grs))
+			continue
+		}
+		for i, gs := range test.groupScores {
+			if grs[i].GroupNo != test.groups[i].Number || !d(grs[i].Score).Equal(d(gs)) {
+				t.Errorf("%s: expected group %d to score %s, got group %d with %s",
+					test.name, test.groups[i].Number, gs, grs[i].GroupNo, grs[i].Score)
+			}
+		}
+	}
+}
+
+func TestResultVerdict(t *testing.T) {
+	tests := []struct {
+		verdicts []presult.Verdict
+		expected presult.Verdict
+	}{
+		{nil, presult.Verdict_ACCEPTED},
+		{[]presult.Verdict{presult.Verdict_ACCEPTED, presult.Verdict_ACCEPTED}, presult.Verdict_ACCEPTED},
+		{[]presult.Verdict{presult.Verdict_ACCEPTED, presult.Verdict_PARTIAL}, presult.Verdict_PARTIAL},
+		{[]presult.Verdict{presult.Verdict_PARTIAL, presult.Verdict_WRONG_ANSWER, presult.Verdict_RUNTIME_ERROR}, presult.Verdict_WRONG_ANSWER},
+	}
+
+	for _, test := range tests {
+		trs := []*presult.TestResult{}
+		for _, v := range test.verdicts {
+			trs = append(trs, &presult.TestResult{Verdict: v})
+		}
+		if v := resultVerdict(trs); v != test.expected {
+			t.Errorf("%v: expected %v, got %v", test.verdicts, test.expected, v)
+		}
+	}
+}
+
+func TestScoreVerdict(t *testing.T) {
+	tests := map[string]presult.Verdict{
+		"1":    presult.Verdict_ACCEPTED,
+		"0.5":  presult.Verdict_PARTIAL,
+		"0":    presult.Verdict_WRONG_ANSWER,
+		"-0.5": presult.Verdict_WRONG_ANSWER,
+	}
+
+	for score, expected := range tests {
+		if v := scoreVerdict(d(score)); v != expected {
+			t.Errorf("%s: expected %v, got %v", score, expected, v)
+		}
+	}
+}
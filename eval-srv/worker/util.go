@@ -5,7 +5,6 @@ import (
 	"os/exec"
 	"strings"
 
-	"github.com/micro/go-micro/metadata"
 	"github.com/sirupsen/logrus"
 	"github.com/xmc-dev/xmc/common/cred"
 	"github.com/xmc-dev/xmc/common/req"
@@ -23,17 +22,7 @@ func cmdString(cmd *exec.Cmd) string {
 
 func InitAuth() error {
 	return cred.InitAuth("xmc.srv.eval", service.MainService.Consul.KV(), service.MainService.OAuth2Token,
-		"xmc.core/manage/attachment xmc.dispatcher/finish")
-}
-
-func CWithName(evalName string) context.Context {
-	ctx, err := req.C(cred.Src, metadata.Metadata{"X-Eval-Name": evalName})
-	if err != nil {
-		logrus.WithError(err).Error("Couldn't get token from source")
-		return context.Background()
-	}
-
-	return ctx
+		"xmc.core/manage/attachment xmc.dispatcher/finish xmc.dispatcher/acquire")
 }
 
 func C() context.Context {
@@ -3,6 +3,8 @@ package worker
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,7 +12,9 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
@@ -18,9 +22,10 @@ import (
 	"github.com/micro/go-micro/client"
 	"github.com/micro/protobuf/ptypes"
 	"github.com/sirupsen/logrus"
-	"github.com/xmc-dev/isowrap"
 	"github.com/xmc-dev/xmc/dispatcher-srv/db/models/job"
 	pjob "github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
+	"github.com/xmc-dev/xmc/eval-srv/cache"
+	"github.com/xmc-dev/xmc/eval-srv/isowrap"
 	"github.com/xmc-dev/xmc/eval-srv/service"
 	"github.com/xmc-dev/xmc/eval-srv/util"
 	"github.com/xmc-dev/xmc/xmc-core/common"
@@ -40,6 +45,8 @@ var graderClient = pgrader.NewGraderServiceClient("xmc.srv.core", client.Default
 
 // Worker executes Jobs
 type Worker struct {
+	id            int
+	log           *logrus.Entry
 	job           *job.Job
 	m             *sync.Mutex
 	srv           *service.Service
@@ -47,28 +54,103 @@ type Worker struct {
 	tempDir       string
 	dataset       *pdataset.Dataset
 	task          *ptask.Task
-	box           *isowrap.Box
 	graderProgram *common.Program
-	userProgram   *common.Program
-	nrTestCases   int
+	// graderProtocol is the way the grader reports the results
+	graderProtocol pgrader.Protocol
+	userProgram    *common.Program
+	nrTestCases    int
+	testCases      []*pdataset.TestCase
+
+	// userCommand is the command that runs the user program inside the sandbox
+	userCommand []string
+
+	// boxes holds the IDs of the sandboxes that are not in use
+	boxes       chan uint
+	concurrency int
+
+	// activeBoxes holds the sandboxes that are initialized, so that they can be killed when the job is aborted
+	activeBoxes map[uint]*isowrap.Box
+	boxesM      *sync.Mutex
+	// aborted is set to 1 when the job is cancelled
+	aborted int32
+
+	// freed is signaled when the worker becomes idle
+	freed chan<- struct{}
+
+	// cache holds the test files and the compiled graders, it is nil if caching is disabled
+	cache *cache.Cache
+	// graderKey identifies the compiled grader in the cache
+	graderKey string
+	// graderCached is true if the compiled grader was taken from the cache
+	graderCached bool
 }
 
-// NewWorker creates a new Worker
-func NewWorker(srv *service.Service) *Worker {
+// errNoOutputFile is returned when the user program didn't create its output file
+var errNoOutputFile = errors.New("no output file")
+
+// errAborted is returned when the job was aborted
+var errAborted = errors.New("job aborted")
+
+// NewWorker creates a new Worker. Workers on the same node must have distinct IDs
+// so that their sandboxes don't overlap. Each worker uses twice its concurrency of box IDs,
+// the second half is for the interactors of the interactive datasets.
+func NewWorker(srv *service.Service, id int) *Worker {
 	w := new(Worker)
 
+	w.id = id
+	w.log = log.WithField("worker", id)
 	w.job = nil
 	w.m = &sync.Mutex{}
 	w.srv = srv
+	w.concurrency = srv.Concurrency
+	if w.concurrency < 1 {
+		w.concurrency = 1
+	}
+	w.activeBoxes = make(map[uint]*isowrap.Box)
+	w.boxesM = &sync.Mutex{}
+	w.boxes = make(chan uint, w.concurrency)
+	for i := 0; i < w.concurrency; i++ {
+		w.boxes <- uint(firstBoxID + 2*id*w.concurrency + i)
+	}
 
 	return w
 }
 
+// Concurrency returns the number of test cases the worker runs at the same time
+func (w *Worker) Concurrency() int {
+	return w.concurrency
+}
+
 // IsIdle returns true if the worker is idle
 func (w *Worker) IsIdle() bool {
 	return w.job == nil
 }
 
+// Abort stops the evaluation of the job, killing the programs that run in its sandboxes.
+// It returns false if the worker isn't evaluating the job.
+func (w *Worker) Abort(jobUUID string) bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.job == nil || w.job.UUID.String() != jobUUID {
+		return false
+	}
+	atomic.StoreInt32(&w.aborted, 1)
+
+	w.boxesM.Lock()
+	defer w.boxesM.Unlock()
+	for id, box := range w.activeBoxes {
+		if err := box.Kill(); err != nil {
+			w.log.WithField("sandbox_id", id).WithError(err).Error("Couldn't kill sandbox")
+		}
+	}
+
+	return true
+}
+
+func (w *Worker) isAborted() bool {
+	return atomic.LoadInt32(&w.aborted) != 0
+}
+
 // Work works the job
 func (w *Worker) Work(j *job.Job) error {
 	w.m.Lock()
@@ -87,24 +169,52 @@ func (w *Worker) execute() {
 		return
 	}
 	id := w.job.UUID
-	log.WithField("job_uuid", id).Info("Starting work")
+	start := time.Now()
+	w.log.WithField("job_uuid", id).Info("Starting work")
+	stopHeartbeat := make(chan struct{})
+	go w.heartbeat(id.String(), stopHeartbeat)
 	err := w.prepare()
 	if err != nil {
 		if len(w.result.ErrorMessage) == 0 {
 			w.result.ErrorMessage = "err_prepare:" + err.Error()
 		}
+		if w.result.Verdict == presult.Verdict_NO_VERDICT {
+			w.result.Verdict = presult.Verdict_SYSTEM_ERROR
+		}
 	} else {
 		err = w.work()
 		if err != nil {
 			if len(w.result.ErrorMessage) == 0 {
 				w.result.ErrorMessage = "err_system:" + err.Error()
 			}
+			w.result.Verdict = presult.Verdict_SYSTEM_ERROR
 		}
 	}
-	w.finish()
+	close(stopHeartbeat)
+	evaluationDuration.Observe(time.Since(start).Seconds())
+	if w.isAborted() {
+		// the dispatcher doesn't accept the results of cancelled jobs
+		w.log.WithField("job_uuid", id).Info("Job aborted")
+	} else {
+		w.finish()
+	}
 	w.cleanup()
-	log.WithField("job_uuid", id).Info("Work finished")
-	w.next()
+	w.log.WithField("job_uuid", id).Info("Work finished")
+	w.m.Lock()
+	w.job = nil
+	w.m.Unlock()
+	w.signalFreed()
+}
+
+// signalFreed tells the pool that the worker can take another job
+func (w *Worker) signalFreed() {
+	if w.freed == nil {
+		return
+	}
+	select {
+	case w.freed <- struct{}{}:
+	default:
+	}
 }
 
 func (w *Worker) makeTemp() error {
@@ -113,18 +223,48 @@ func (w *Worker) makeTemp() error {
 		return errors.Wrap(err, "couldn't create temp dir")
 	}
 	w.tempDir = tempDir
-	log.WithField("tempDir", tempDir).Debug("Created tempdir")
+	w.log.WithField("tempDir", tempDir).Debug("Created tempdir")
 
 	return nil
 }
 
+// newProgram creates a program which is built in its own directory inside the temp dir,
+// so that the files created by the build of one program don't mix with the ones of the others.
+func (w *Worker) newProgram(name string, lang common.Language) (*common.Program, error) {
+	spec, err := common.GetLanguage(lang)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(w.tempDir, name)
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "couldn't create %s dir", name)
+	}
+	source := name + "." + string(lang)
+	if len(spec.SourceFile) > 0 {
+		source = spec.SourceFile
+	}
+
+	return common.NewProgram(filepath.Join(dir, source), filepath.Join(dir, name), lang)
+}
+
 func (w *Worker) writeUserProgram() error {
-	p := filepath.Join(w.tempDir, "userprogram."+w.job.Language)
-	err := ioutil.WriteFile(p, w.job.Code, 0644)
+	var err error
+	w.userProgram, err = w.newProgram("userprogram", common.Language(w.job.Language))
+	if err != nil {
+		return errors.Wrap(err, "couldn't write user program")
+	}
+	// rejudged jobs don't carry the code, it is read from the attachment of the submission
+	if len(w.job.Code) == 0 && len(w.job.AttachmentID) > 0 {
+		rsp, err := attachmentClient.GetContents(C(), &pattachment.GetContentsRequest{Id: w.job.AttachmentID})
+		if err != nil {
+			return errors.Wrapf(err, "couldn't get user program attachment %s", w.job.AttachmentID)
+		}
+		return errors.Wrap(util.Download(rsp.Url, w.userProgram.Source), "couldn't write user program")
+	}
+	err = ioutil.WriteFile(w.userProgram.Source, w.job.Code, 0644)
 	if err != nil {
 		return errors.Wrap(err, "couldn't write user program")
 	}
-	w.userProgram = common.NewProgram(p, filepath.Join(w.tempDir, "userprogram"), common.Language(w.job.Language))
 
 	return nil
 }
@@ -135,7 +275,7 @@ func (w *Worker) getDataset() error {
 		return errors.Wrapf(err, "couldn't get dataset %s", w.job.DatasetID)
 	}
 	w.dataset = drsp.Dataset
-	log.WithField("dataset", w.dataset.Id).Debug("Got dataset")
+	w.log.WithField("dataset", w.dataset.Id).Debug("Got dataset")
 
 	return nil
 }
@@ -146,96 +286,183 @@ func (w *Worker) getTask() error {
 		return errors.Wrapf(err, "couldn't get task %s", w.job.TaskID)
 	}
 	w.task = rsp.Task
-	log.WithField("task", w.task.Id).Debug("Got task")
+	w.log.WithField("task", w.task.Id).Debug("Got task")
 
 	return nil
 }
 
 func (w *Worker) getGrader() error {
+	if w.dataset.Checker != pdataset.Checker_CUSTOM {
+		w.log.WithField("checker", w.dataset.Checker).Debug("Using built-in checker")
+		return nil
+	}
 	grsp, err := graderClient.Read(context.TODO(), &pgrader.ReadRequest{Id: w.dataset.GraderId})
 	if err != nil {
 		return errors.Wrapf(err, "couldn't get grader %s", w.dataset.GraderId)
 	}
+	w.graderProtocol = grsp.Grader.Protocol
+	w.graderProgram, err = w.newProgram("grader", common.Language(grsp.Grader.Language))
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get grader %s", w.dataset.GraderId)
+	}
+
+	arsp, err := attachmentClient.Read(C(), &pattachment.ReadRequest{Id: grsp.Grader.AttachmentId})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get grader %s attachment", w.dataset.GraderId)
+	}
+	// the compiled grader must be rebuilt when the code or the compiler changes.
+	// The graders uploaded before the attachments had a SHA-256 aren't cached.
+	w.graderKey = ""
+	if len(arsp.Attachment.Sha256) > 0 {
+		key := sha256.Sum256([]byte(arsp.Attachment.Sha256 + "\x00" + grsp.Grader.Language + "\x00" + w.graderProgram.Spec.VersionString()))
+		w.graderKey = hex.EncodeToString(key[:])
+	}
+	w.graderCached, err = w.cache.GetDir(w.graderKey, filepath.Dir(w.graderProgram.Executable))
+	if err != nil {
+		w.log.WithError(err).Warn("Couldn't read compiled grader from cache")
+		w.graderCached = false
+	}
+	if w.graderCached {
+		cacheHits.WithLabelValues("grader").Inc()
+		w.log.WithField("grader", w.dataset.GraderId).Debug("Got compiled grader from cache")
+		return nil
+	}
+	cacheMisses.WithLabelValues("grader").Inc()
+
 	garsp, err := attachmentClient.GetContents(C(), &pattachment.GetContentsRequest{Id: grsp.Grader.AttachmentId})
 	if err != nil {
-		return errors.Wrapf(err, "couldn't get grader %s contents", err)
+		return errors.Wrapf(err, "couldn't get grader %s contents", w.dataset.GraderId)
 	}
-	p := filepath.Join(w.tempDir, "grader."+string(grsp.Grader.Language))
-	err = util.Download(garsp.Url, p)
+	err = util.Download(garsp.Url, w.graderProgram.Source)
 	if err != nil {
 		return err
 	}
-	w.graderProgram = common.NewProgram(p, filepath.Join(w.tempDir, "grader"), common.Language(grsp.Grader.Language))
-	log.WithField("grader", w.dataset.GraderId).Debug("Got grader")
+	w.log.WithField("grader", w.dataset.GraderId).Debug("Got grader")
 
 	return nil
 }
 
+// fetchAttachment writes the contents of the attachment to dest, taking them from the cache if possible
+func (w *Worker) fetchAttachment(id, dest string) error {
+	rsp, err := attachmentClient.Read(C(), &pattachment.ReadRequest{Id: id})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get attachment %s", id)
+	}
+	key := rsp.Attachment.Sha256
+	ok, err := w.cache.GetFile(key, dest)
+	if err != nil {
+		w.log.WithError(err).Warn("Couldn't read attachment from cache")
+	} else if ok {
+		cacheHits.WithLabelValues("file").Inc()
+		return nil
+	}
+	cacheMisses.WithLabelValues("file").Inc()
+
+	crsp, err := attachmentClient.GetContents(C(), &pattachment.GetContentsRequest{Id: id})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get attachment %s contents", id)
+	}
+	if err := util.Download(crsp.Url, dest); err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		return nil
+	}
+	// the attachment could have been updated since it was read
+	if sum, err := fileSHA256(dest); err != nil || sum != key {
+		w.log.WithField("attachment", id).Warn("Contents of attachment don't match its SHA-256, not caching it")
+		return nil
+	}
+	if err := w.cache.PutFile(key, dest); err != nil {
+		w.log.WithError(err).Warn("Couldn't cache attachment")
+	}
+
+	return nil
+}
+
+// fileSHA256 returns the hex encoded SHA-256 of the contents of the file
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func (w *Worker) getTestCases() error {
 	rsp, err := datasetClient.GetTestCases(context.TODO(), &pdataset.GetTestCasesRequest{Id: w.job.DatasetID})
 	if err != nil {
 		return errors.Wrapf(err, "couldn't get dataset's %s test cases", w.job.DatasetID)
 	}
 	w.nrTestCases = len(rsp.TestCases)
+	w.testCases = rsp.TestCases
 	for _, tc := range rsp.TestCases {
-		rsp, err := attachmentClient.GetContents(C(), &pattachment.GetContentsRequest{Id: tc.InputAttachmentId})
-		if err != nil {
-			return errors.Wrapf(err, "couldn't get input #%d contents", tc.Number)
-		}
-		inURL := rsp.Url
-		rsp, err = attachmentClient.GetContents(C(), &pattachment.GetContentsRequest{Id: tc.OutputAttachmentId})
-		if err != nil {
-			return errors.Wrapf(err, "couldn't get output #%d contents", tc.Number)
-		}
-		outURL := rsp.Url
-		err = util.Download(inURL, filepath.Join(w.tempDir, fmt.Sprintf("test%d.in", tc.Number)))
+		err := w.fetchAttachment(tc.InputAttachmentId, filepath.Join(w.tempDir, fmt.Sprintf("test%d.in", tc.Number)))
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "couldn't get input #%d", tc.Number)
 		}
-		err = util.Download(outURL, filepath.Join(w.tempDir, fmt.Sprintf("test%d.ok", tc.Number)))
+		err = w.fetchAttachment(tc.OutputAttachmentId, filepath.Join(w.tempDir, fmt.Sprintf("test%d.ok", tc.Number)))
 		if err != nil {
-			return err
+			return errors.Wrapf(err, "couldn't get output #%d", tc.Number)
 		}
 	}
-	log.Debug("Successfully downloaded tests")
+	w.log.Debug("Successfully downloaded tests")
 
 	return nil
 }
 
 func (w *Worker) compilePrograms() error {
-	upc := w.userProgram.Compile()
-	w.result.BuildCommand = cmdString(upc)
-	log.Debug("Compiling user program ", w.result.BuildCommand)
-	out, err := upc.CombinedOutput()
-	verOut, _ := w.userProgram.Version().CombinedOutput()
-	w.result.CompilationMessage = string(verOut) + "\n" + string(out)
+	if err := w.compileUserProgram(); err != nil {
+		return err
+	}
+	w.log.Debug("Successfully compiled user program")
+	var err error
+	w.userCommand, err = w.userProgram.SandboxCommand()
 	if err != nil {
-		w.result.ErrorMessage = "err_userprogram_compilation:" + err.Error()
-		return errors.Wrap(err, "couldn't compile user program")
+		return errors.Wrap(err, "couldn't find user program run command")
 	}
-	log.Debug("Successfully compiled user program")
 
+	if w.graderProgram == nil || w.graderCached {
+		return nil
+	}
 	graderBuildCmd := cmdString(w.graderProgram.Compile())
-	log.Debug("Compiling grader ", graderBuildCmd)
-	out, err = w.graderProgram.Compile().CombinedOutput()
+	w.log.Debug("Compiling grader ", graderBuildCmd)
+	out, err := w.graderProgram.Compile().CombinedOutput()
 	if err != nil {
 		w.result.ErrorMessage = fmt.Sprintf("err_grader_compilation:%s\n%s\n%s", graderBuildCmd, out, err.Error())
 		return errors.Wrap(err, "couldn't compile grader program")
 	}
-	log.Debug("Successfully compiled grader program")
+	w.log.Debug("Successfully compiled grader program")
+	err = w.cache.PutDir(w.graderKey, filepath.Dir(w.graderProgram.Executable))
+	if err != nil {
+		w.log.WithError(err).Warn("Couldn't cache compiled grader")
+	}
 	return nil
 }
 
-func (w *Worker) copyUserProgram() error {
-	err := util.CopyFile(w.userProgram.Executable, filepath.Join(w.box.Path, "userprogram"))
+// copyProgram copies the files needed to run the compiled program in the sandbox
+func copyProgram(p *common.Program, box *isowrap.Box) error {
+	files, err := p.SandboxFiles()
 	if err != nil {
-		return err
+		return errors.Wrap(err, "couldn't find program files")
+	}
+	for _, f := range files {
+		err := util.CopyFile(f, filepath.Join(box.Path, filepath.Base(f)))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (w *Worker) prepare() error {
-	log = log.WithField("job_uuid", w.job.UUID)
+	w.log = w.log.WithField("job_uuid", w.job.UUID)
 	w.result = &presult.Result{TestResults: []*presult.TestResult{}}
 	if err := w.makeTemp(); err != nil {
 		return err
@@ -263,135 +490,256 @@ func (w *Worker) prepare() error {
 }
 
 func (w *Worker) work() error {
-	scoreSum := decimal.Zero
-	stdoutFilename := filepath.Join(w.tempDir, "userprogram.out")
-	defer w.deinitSandbox()
-	for i := 1; i <= w.nrTestCases; i++ {
-		tr := &presult.TestResult{
-			TestNo: int32(i),
-			Score:  "0.00",
+	var wg sync.WaitGroup
+	var failed int32
+	trs := make([]*presult.TestResult, w.nrTestCases)
+	testScores := make([]decimal.Decimal, w.nrTestCases)
+	errs := make([]error, w.nrTestCases)
+	for i, tc := range w.testCases {
+		id := <-w.boxes
+		// no reason to run the rest of the tests if one of them failed
+		if atomic.LoadInt32(&failed) != 0 || w.isAborted() {
+			w.boxes <- id
+			break
 		}
-		if err := w.initSandbox(); err != nil {
-			return err
+		wg.Add(1)
+		go func(i int, no int32, id uint) {
+			defer wg.Done()
+			defer func() { w.boxes <- id }()
+			trs[i], testScores[i], errs[i] = w.runTest(int(no), id)
+			if errs[i] != nil {
+				atomic.StoreInt32(&failed, 1)
+			}
+		}(i, tc.Number, id)
+	}
+	wg.Wait()
+	if w.isAborted() {
+		return errAborted
+	}
+
+	for i, tr := range trs {
+		if errs[i] == errNoOutputFile {
+			w.result.ErrorMessage = "err_no_output_file:" + w.task.OutputFile
+			w.result.Verdict = presult.Verdict_WRONG_ANSWER
+			return nil
+		} else if errs[i] != nil {
+			return errs[i]
+		} else if tr == nil {
+			// the test wasn't run because an earlier one failed
+			break
 		}
-		if err := w.copyUserProgram(); err != nil {
-			return err
+		w.result.TestResults = append(w.result.TestResults, tr)
+	}
+	w.result.Verdict = resultVerdict(w.result.TestResults)
+	scores := make(map[int32]decimal.Decimal)
+	for i, tc := range w.testCases {
+		scores[tc.Number] = testScores[i]
+	}
+
+	return w.score(scores)
+}
+
+// runTest runs the user program on the test case with the number no inside the sandbox with the given ID
+// and grades its output.
+func (w *Worker) runTest(no int, boxID uint) (*presult.TestResult, decimal.Decimal, error) {
+	score := decimal.Zero
+	tr := &presult.TestResult{
+		TestNo: int32(no),
+		Score:  "0.00",
+	}
+	box, err := w.initSandbox(boxID)
+	if err != nil {
+		return nil, score, err
+	}
+	defer func() {
+		if err := w.deinitSandbox(box); err != nil {
+			w.log.Error(err)
 		}
-		stdout, err := os.Create(stdoutFilename)
+	}()
+	if err := copyProgram(w.userProgram, box); err != nil {
+		return nil, score, err
+	}
+
+	testFile := filepath.Join(w.tempDir, fmt.Sprintf("test%d", no))
+	if w.dataset.Type == pdataset.Type_INTERACTIVE {
+		return w.runInteractive(testFile, box, tr)
+	}
+
+	return w.runBatch(testFile, box, tr)
+}
+
+// runBatch runs the user program with the input of the test case and then runs the grader on its output.
+func (w *Worker) runBatch(testFile string, box *isowrap.Box, tr *presult.TestResult) (*presult.TestResult, decimal.Decimal, error) {
+	score := decimal.Zero
+	stdoutFilename := testFile + ".out"
+	stdout, err := os.Create(stdoutFilename)
+	if err != nil {
+		return nil, score, errors.Wrap(err, "couldn't open stdout file")
+	}
+	defer stdout.Close()
+
+	if w.task.InputFile != "stdin" {
+		err := util.CopyFile(testFile+".in", filepath.Join(box.Path, w.task.InputFile))
 		if err != nil {
-			return errors.Wrap(err, "couldn't open stdout file")
+			return nil, score, err
 		}
-
-		testFile := filepath.Join(w.tempDir, fmt.Sprintf("test%d", i))
-		if w.task.InputFile != "stdin" {
-			err := util.CopyFile(testFile+".in", filepath.Join(w.box.Path, w.task.InputFile))
-			if err != nil {
-				return err
-			}
+	}
+	var stdin io.Reader = os.Stdin
+	if w.task.InputFile == "stdin" {
+		in, err := os.Open(testFile + ".in")
+		if err != nil {
+			return nil, score, errors.Wrap(err, "couldn't read input file")
 		}
-		var stdin io.Reader = os.Stdin
-		if w.task.InputFile == "stdin" {
-			in, err := os.Open(testFile + ".in")
+		defer in.Close()
+		stdin = in
+	}
+	// the stderr of the eval may be a file, to which the output limit would apply too
+	result, err := runInBox(box, stdin, stdout, ioutil.Discard, w.userCommand)
+	if err != nil {
+		return nil, score, errors.Wrap(err, "couldn't execute user program")
+	}
+	stdout.Close()
+	w.log.Debug(result, err)
+	outputFilename := stdoutFilename
+	if w.task.OutputFile != "stdout" {
+		outputFilename = filepath.Join(box.Path, w.task.OutputFile)
+	}
+	if fi, err := os.Stat(outputFilename); err == nil {
+		tr.StdoutSize = fi.Size()
+	}
+	if result.ErrorType != isowrap.NoError {
+		tr.Verdict, tr.GraderMessage = runError(result, box)
+	} else {
+		if w.task.OutputFile != "stdout" {
+			err = util.CopyFile(filepath.Join(box.Path, w.task.OutputFile), stdoutFilename)
 			if err != nil {
-				return errors.Wrap(err, "couldn't read input file")
+				return nil, score, errNoOutputFile
 			}
-			stdin = in
 		}
-		result, err := w.box.Run(stdin, stdout, os.Stderr, "userprogram")
+
+		var report *graderReport
+		if w.dataset.Checker == pdataset.Checker_CUSTOM {
+			report, err = w.grade(testFile, stdoutFilename)
+		} else {
+			report, err = w.check(stdoutFilename, testFile+".ok")
+		}
 		if err != nil {
-			return errors.Wrap(err, "couldn't execute user program")
+			return nil, score, err
 		}
-		stdout.Close()
-		log.Debug(result, err)
-		if result.ErrorType != isowrap.NoError {
-			switch result.ErrorType {
-			case isowrap.RunTimeError:
-				tr.GraderMessage = fmt.Sprintf("Program exited with exit status %d", result.ExitCode)
-			case isowrap.KilledBySignal:
-				tr.GraderMessage = fmt.Sprintf("Killed by signal %d: %v", int(result.Signal.(syscall.Signal)), result.Signal)
-			case isowrap.Timeout:
-				tr.GraderMessage = "Time limit exceeded"
-			case isowrap.MemoryExceeded:
-				tr.GraderMessage = "Memory limit exceeded"
-			}
-		} else {
-			if w.task.OutputFile != "stdout" {
-				err = util.CopyFile(filepath.Join(w.box.Path, w.task.OutputFile), stdoutFilename)
-				if err != nil {
-					w.result.ErrorMessage = "err_no_output_file:" + w.task.OutputFile
-					return nil
-				}
-			}
+		report.fill(tr)
+		score = report.score
+	}
+	fillUsage(tr, result)
 
-			gProc := w.graderProgram.Execute(testFile+".in", stdoutFilename, testFile+".ok")
-			gProc.Dir = w.tempDir
-			var gOut, gErr bytes.Buffer
-			gProc.Stdout = &gOut
-			gProc.Stderr = &gErr
-			err = gProc.Run()
-			if err != nil {
-				return errors.Wrap(err, "couldn't execute grader program")
-			}
+	return tr, score, nil
+}
 
-			// ignores error, because if there's an error then most likely the grader failed
-			score, _ := decimal.NewFromString(strings.TrimSpace(string(gOut.Bytes())))
-			scoreSum = scoreSum.Add(score)
-			tr.Score = score.String()
-			tr.GraderMessage = strings.TrimSpace(string(gErr.Bytes()))
-		}
-		tr.Memory = int32(result.MemUsed)
-		tr.Time = ptypes.DurationProto(result.CPUTime)
-		w.result.TestResults = append(w.result.TestResults, tr)
-		if err := w.deinitSandbox(); err != nil {
-			return err
+// grade runs the grader of the dataset on the output of the user program
+func (w *Worker) grade(testFile, stdoutFilename string) (*graderReport, error) {
+	gProc := w.graderProgram.Execute(testFile+".in", stdoutFilename, testFile+".ok")
+	gProc.Dir = w.tempDir
+	var gOut, gErr bytes.Buffer
+	gProc.Stdout = &gOut
+	gProc.Stderr = &gErr
+	code, err := graderExitCode(gProc.Run())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseGraderOutput(w.graderProtocol, code, gOut.Bytes(), gErr.Bytes())
+}
+
+// fillUsage sets the resources used by the user program and how it exited in the test result
+func fillUsage(tr *presult.TestResult, result isowrap.RunResult) {
+	tr.Memory = int32(result.MemUsed)
+	tr.Time = ptypes.DurationProto(result.CPUTime)
+	tr.WallTime = ptypes.DurationProto(result.WallTime)
+	tr.MaxRss = int32(result.MaxRSS)
+	tr.ExitCode = int32(result.ExitCode)
+	if sig, ok := result.Signal.(syscall.Signal); ok {
+		tr.Signal = int32(sig)
+	}
+}
+
+// runError returns the verdict and the message shown to the user when their program didn't exit normally
+// in the given sandbox
+func runError(result isowrap.RunResult, box *isowrap.Box) (presult.Verdict, string) {
+	switch result.ErrorType {
+	case isowrap.RunTimeError:
+		return presult.Verdict_RUNTIME_ERROR, fmt.Sprintf("Program exited with exit status %d", result.ExitCode)
+	case isowrap.KilledBySignal:
+		if result.Signal == syscall.SIGXFSZ && box.Config.FileSizeLimit > 0 {
+			return presult.Verdict_OUTPUT_LIMIT_EXCEEDED, "Output limit exceeded"
 		}
-		if f, ok := stdin.(*os.File); ok {
-			f.Close()
+		return presult.Verdict_RUNTIME_ERROR, fmt.Sprintf("Killed by signal %d: %v", int(result.Signal.(syscall.Signal)), result.Signal)
+	case isowrap.Timeout:
+		// the wall time limit is the sum of the time limit and of the idleness limit, so a program
+		// killed by it rather than by the CPU time limit spent too long without using the CPU
+		if wallTimeout(result) {
+			return presult.Verdict_IDLENESS_LIMIT_EXCEEDED, "Idleness limit exceeded"
 		}
+		return presult.Verdict_TIME_LIMIT_EXCEEDED, "Time limit exceeded"
+	case isowrap.MemoryExceeded:
+		return presult.Verdict_MEMORY_LIMIT_EXCEEDED, "Memory limit exceeded"
 	}
-	w.result.Score = scoreSum.Div(decimal.NewFromFloat(float64(w.nrTestCases))).Mul(decimal.NewFromFloat(100.)).String()
 
-	return nil
+	return presult.Verdict_SYSTEM_ERROR, ""
+}
+
+// wallTimeout returns true if isolate killed the program because of the wall time limit
+func wallTimeout(result isowrap.RunResult) bool {
+	return result.Status == "TO" && strings.HasSuffix(result.Message, "(wall clock)")
+}
+
+// heartbeat extends the lease of the job periodically until stop is closed,
+// so that the dispatcher doesn't give the job to another eval
+func (w *Worker) heartbeat(jobUUID string, stop <-chan struct{}) {
+	t := time.NewTicker(w.srv.HeartbeatInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			_, err := jobClient.Heartbeat(C(), &pjob.HeartbeatRequest{
+				JobUuid:  jobUUID,
+				EvalName: w.srv.Name,
+			})
+			if err != nil {
+				w.log.WithError(err).Warn("Couldn't send heartbeat")
+			}
+		}
+	}
 }
 
 func (w *Worker) finish() {
-	log.Debug(w.result)
-	rsp, err := jobClient.Finish(CWithName(w.srv.Name), &pjob.FinishRequest{
-		JobUuid: w.job.UUID.String(),
-		Result:  w.result,
+	w.log.Debug(w.result)
+	_, err := jobClient.Finish(C(), &pjob.FinishRequest{
+		JobUuid:  w.job.UUID.String(),
+		Result:   w.result,
+		EvalName: w.srv.Name,
 	})
-	log.WithField("job_uuid", w.job.UUID).Info("Work done")
+	w.log.WithField("job_uuid", w.job.UUID).Info("Work done")
 	if err != nil {
-		log.Error(err)
+		w.log.Error(err)
 	}
-	w.m.Lock()
-	if rsp == nil || rsp.NextJob == nil {
-		w.job = nil
-		log.Info("No work left. Idling...")
-	} else {
-		log.WithField("job_uuid", w.job.UUID).WithField("next_job", rsp.NextJob.Uuid).Info("Next job")
-		w.job = job.FromProto(rsp.NextJob)
-	}
-	w.m.Unlock()
 }
 
 func (w *Worker) cleanup() {
 	w.result = nil
-	err := w.deinitSandbox()
+	err := os.RemoveAll(w.tempDir)
 	if err != nil {
-		log.Error("Error while cleaning up: couldn't deinit sandbox: ", err)
-	}
-	err = os.RemoveAll(w.tempDir)
-	if err != nil {
-		log.WithField("tempDir", w.tempDir).Error("Error while cleaning up: couldn't remove worker's temp dir: ", err)
+		w.log.WithField("tempDir", w.tempDir).Error("Error while cleaning up: couldn't remove worker's temp dir: ", err)
 	}
 	w.tempDir = ""
 	w.dataset = nil
+	w.testCases = nil
 	w.graderProgram = nil
+	w.graderKey = ""
+	w.graderCached = false
 	w.userProgram = nil
-	log = log.WithField("job_uuid", "")
-}
-
-func (w *Worker) next() {
-	go w.execute()
+	w.userCommand = nil
+	atomic.StoreInt32(&w.aborted, 0)
+	w.log = log.WithField("worker", w.id)
 }
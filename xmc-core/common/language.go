@@ -2,6 +2,14 @@ package common
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
 )
 
 // ErrInvalidLanguage is returned when there is no programming language in the system that matches the extension
@@ -12,52 +20,190 @@ type Language string
 const (
 	LangC   Language = "c"
 	LangCPP          = "cpp"
-	//	LangJava                = "java"
-	//	LangPython2             = "py"
-	//	LangPython3             = "py3"
-	//	LangHaskell             = "hs"
-	//	LangRuby                = "rb"
-	//	LangCommonLISP          = "lisp"
-	//	LangPascal              = "pas"
-	LangGo = "go"
-	end
+	LangGo           = "go"
 )
 
 func (eil ErrInvalidLanguage) Error() string {
 	return fmt.Sprintf("invalid file extension %s for programming language", string(eil))
 }
 
-// FileExtToLanguage returns a programming language code from a file extension.
+// LanguageSpec describes how programs written in a programming language are compiled and run.
+//
+// The commands may contain the placeholders {source}, {executable} and {dir}, which are replaced
+// with the paths of the program's source file, executable and of the directory in which it is built.
+// When the program is run inside a sandbox, they are replaced with the paths inside the sandbox.
+type LanguageSpec struct {
+	// Name is the human readable name of the language, like "C++11"
+	Name string `yaml:"name"`
+	// Extensions are the file extensions of the source files written in this language
+	Extensions []string `yaml:"extensions"`
+	// Version is the command that prints the version of the compiler or runtime
+	Version []string `yaml:"version"`
+	// Compile is the command that compiles the source file into the executable
+	Compile []string `yaml:"compile"`
+	// Run is the command that runs the executable. If the first element is not a path,
+	// it is looked up in PATH.
+	Run []string `yaml:"run"`
+	// Files are glob patterns, relative to the build directory, of the files
+	// which are needed to run the program, like bytecode or scripts.
+	// They are copied in the sandbox. By default, only the executable is copied.
+	Files []string `yaml:"files"`
+	// SourceFile is the name the source file must have, if the compiler cares about it
+	SourceFile string `yaml:"source_file"`
+	// TimeMultiplier and MemoryMultiplier multiply the time and memory limits
+	// of the programs written in this language
+	TimeMultiplier   float64 `yaml:"time_multiplier"`
+	MemoryMultiplier float64 `yaml:"memory_multiplier"`
+	// Env holds extra environment variables in the form KEY=value
+	Env []string `yaml:"env"`
+
+	version     string
+	versionOnce sync.Once
+}
+
+// VersionString runs the version command once and returns the first line of its output.
+// If the command fails, the result is empty.
+func (ls *LanguageSpec) VersionString() string {
+	ls.versionOnce.Do(func() {
+		out, err := exec.Command(ls.Version[0], ls.Version[1:]...).CombinedOutput()
+		if err != nil {
+			return
+		}
+		ls.version = strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+	})
+
+	return ls.version
+}
+
+func defaultLanguages() (map[Language]*LanguageSpec, []Language) {
+	ls := map[Language]*LanguageSpec{
+		LangC: {
+			Name:       "C11",
+			Extensions: []string{"c"},
+			Version:    []string{"clang", "--version"},
+			Compile:    []string{"clang", "-o", "{executable}", "-DONLINE_JUDGE", "-Wall", "-O2", "-static", "-std=c11", "-lm", "{source}"},
+			Run:        []string{"{executable}"},
+		},
+		LangCPP: {
+			Name:       "C++11",
+			Extensions: []string{"cpp", "cxx", "C"},
+			Version:    []string{"clang++", "--version"},
+			Compile:    []string{"clang++", "-o", "{executable}", "-DONLINE_JUDGE", "-Wall", "-O2", "-static", "-std=c++11", "-lm", "{source}"},
+			Run:        []string{"{executable}"},
+		},
+		LangGo: {
+			Name:       "Go",
+			Extensions: []string{"go"},
+			Version:    []string{"go", "version"},
+			Compile:    []string{"go", "build", "-ldflags", "-s", "-o", "{executable}", "{source}"},
+			Run:        []string{"{executable}"},
+			Env:        []string{"CGO_ENABLED=0"},
+		},
+	}
+	for code, l := range ls {
+		l.setDefaults(code)
+	}
+
+	return ls, []Language{LangC, LangCPP, LangGo}
+}
+
+func (ls *LanguageSpec) setDefaults(code Language) {
+	if len(ls.Extensions) == 0 {
+		ls.Extensions = []string{string(code)}
+	}
+	if ls.TimeMultiplier == 0 {
+		ls.TimeMultiplier = 1
+	}
+	if ls.MemoryMultiplier == 0 {
+		ls.MemoryMultiplier = 1
+	}
+}
+
+var languages, languageOrder = defaultLanguages()
+
+// LoadLanguages replaces the language registry with the languages declared in a YAML file.
+//
+// The file maps language codes to their specs:
 //
-// The actual string value of the constant must also be a valid extension.
+//	py3:
+//	  name: Python 3
+//	  extensions: [py3]
+//	  version: [python3, --version]
+//	  compile: [python3, -m, py_compile, "{source}"]
+//	  run: [python3, "{source}"]
+//	  files: ["*.py"]
+//	  source_file: main.py
+//	  time_multiplier: 2.5
+//	  memory_multiplier: 1
+//	  env: [PYTHONIOENCODING=utf-8]
+//
+// The multipliers default to 1. If more languages have the same extension,
+// the first one in the file is used for the source files with that extension.
+func LoadLanguages(fp string) error {
+	data, err := ioutil.ReadFile(fp)
+	if err != nil {
+		return errors.Wrap(err, "couldn't read languages file")
+	}
+
+	ls := map[Language]*LanguageSpec{}
+	if err := yaml.Unmarshal(data, &ls); err != nil {
+		return errors.Wrap(err, "couldn't parse languages file")
+	}
+	// the map loses the order of the languages in the file
+	ms := yaml.MapSlice{}
+	if err := yaml.Unmarshal(data, &ms); err != nil {
+		return errors.Wrap(err, "couldn't parse languages file")
+	}
+	order := []Language{}
+	for _, item := range ms {
+		order = append(order, Language(fmt.Sprint(item.Key)))
+	}
+	for code, l := range ls {
+		if len(l.Version) == 0 || len(l.Compile) == 0 || len(l.Run) == 0 {
+			return errors.Errorf("language %s must have a version, a compile and a run command", code)
+		}
+		l.setDefaults(code)
+	}
+	languages, languageOrder = ls, order
+
+	return nil
+}
+
+// GetLanguage returns the spec of a language from the registry
+func GetLanguage(lang Language) (*LanguageSpec, error) {
+	l, ok := languages[lang]
+	if !ok {
+		return nil, ErrInvalidLanguage(lang)
+	}
+
+	return l, nil
+}
+
+// Languages returns the codes of the languages in the registry, sorted
+func Languages() []Language {
+	codes := []Language{}
+	for code := range languages {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	return codes
+}
+
+// FileExtToLanguage returns a programming language code from a file extension.
 func FileExtToLanguage(ext string) (Language, error) {
-	switch {
-	case ext == "c":
-		return LangC, nil
-	case ext == "cpp" || ext == "cxx" || ext == "C":
-		return LangCPP, nil
-		//	case ext == "java":
-		//		return LangJava, nil
-		//	case ext == "py":
-		//		return LangPython2, nil
-		//	case ext == "py3":
-		//		return LangPython3, nil
-		//	case ext == "hs":
-		//		return LangHaskell, nil
-		//	case ext == "rb":
-		//		return LangRuby, nil
-		//	case ext == "lisp":
-		//		return LangCommonLISP, nil
-		//	case ext == "pas":
-		//		return LangPascal, nil
-	case ext == "go":
-		return LangGo, nil
-	default:
-		return "", ErrInvalidLanguage(ext)
+	for _, code := range languageOrder {
+		for _, e := range languages[code].Extensions {
+			if e == ext {
+				return code, nil
+			}
+		}
 	}
+
+	return "", ErrInvalidLanguage(ext)
 }
 
 func IsValidLanguage(lang string) bool {
-	_, err := FileExtToLanguage(lang)
-	return err == nil
+	_, ok := languages[Language(lang)]
+	return ok
 }
@@ -3,96 +3,108 @@ package common
 import (
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 )
 
 type Program struct {
 	Source     string
 	Executable string
 	Language   Language
-	Manager    ProgramManager
+	Spec       *LanguageSpec
 }
 
-type ProgramManager interface {
-	Version() *exec.Cmd
-	Compile(p *Program, args ...string) *exec.Cmd
-	Execute(p *Program, args ...string) *exec.Cmd
+func expand(cmd []string, source, executable, dir string, args ...string) []string {
+	r := strings.NewReplacer("{source}", source, "{executable}", executable, "{dir}", dir)
+	ret := []string{}
+	for _, c := range cmd {
+		ret = append(ret, r.Replace(c))
+	}
+
+	return append(ret, args...)
+}
+
+func (p *Program) command(cmd []string, args ...string) *exec.Cmd {
+	c := expand(cmd, p.Source, p.Executable, filepath.Dir(p.Executable), args...)
+	ret := exec.Command(c[0], c[1:]...)
+	if len(p.Spec.Env) > 0 {
+		ret.Env = append(os.Environ(), p.Spec.Env...)
+	}
+
+	return ret
 }
 
 func (p *Program) Version() *exec.Cmd {
-	return p.Manager.Version()
+	return p.command(p.Spec.Version)
 }
 
 func (p *Program) Compile(args ...string) *exec.Cmd {
-	return p.Manager.Compile(p, args...)
+	return p.command(p.Spec.Compile, args...)
 }
 
 func (p *Program) Execute(args ...string) *exec.Cmd {
-	return p.Manager.Execute(p, args...)
+	return p.command(p.Spec.Run, args...)
 }
 
-func NewProgram(source, executable string, language Language) *Program {
-	var manager ProgramManager
-	switch language {
-	case LangC:
-		manager = &CManager{}
-	case LangCPP:
-		manager = &CPPManager{}
-	case LangGo:
-		manager = &GoManager{}
+// SandboxFiles returns the paths of the files that must be copied
+// in the sandbox in order to run the program.
+func (p *Program) SandboxFiles() ([]string, error) {
+	dir := filepath.Dir(p.Executable)
+	if len(p.Spec.Files) == 0 {
+		return []string{p.Executable}, nil
 	}
 
-	return &Program{
-		Source:     source,
-		Executable: executable,
-		Language:   language,
-		Manager:    manager,
+	files := []string{}
+	for _, f := range p.Spec.Files {
+		pattern := expand([]string{f}, filepath.Base(p.Source), filepath.Base(p.Executable), ".")[0]
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
 	}
-}
-
-type CManager struct{}
-
-func (*CManager) Version() *exec.Cmd {
-	return exec.Command("clang", "--version")
-}
-
-func (*CManager) Compile(p *Program, args ...string) *exec.Cmd {
-	args = append([]string{"-o", p.Executable, "-DONLINE_JUDGE", "-Wall", "-O2", "-static", "-std=c11", "-lm", p.Source}, args...)
-	return exec.Command("clang", args...)
-}
 
-func (*CManager) Execute(p *Program, args ...string) *exec.Cmd {
-	return exec.Command(p.Executable, args...)
+	return files, nil
 }
 
-type CPPManager struct{}
-
-func (*CPPManager) Version() *exec.Cmd {
-	return exec.Command("clang++", "--version")
+// SandboxCommand returns the command that runs the program inside a sandbox
+// in which the files returned by SandboxFiles were copied in the working directory.
+func (p *Program) SandboxCommand(args ...string) ([]string, error) {
+	return p.sandboxCommand(p.Spec.Run, args...)
 }
 
-func (*CPPManager) Compile(p *Program, args ...string) *exec.Cmd {
-	args = append([]string{"-o", p.Executable, "-DONLINE_JUDGE", "-Wall", "-O2", "-static", "-std=c++11", "-lm", p.Source}, args...)
-	return exec.Command("clang++", args...)
+// SandboxCompileCommand returns the command that compiles the program inside a sandbox
+// in which the source file was copied in the working directory.
+// The build files are written in the working directory.
+func (p *Program) SandboxCompileCommand(args ...string) ([]string, error) {
+	return p.sandboxCommand(p.Spec.Compile, args...)
 }
 
-func (*CPPManager) Execute(p *Program, args ...string) *exec.Cmd {
-	return exec.Command(p.Executable, args...)
-}
-
-type GoManager struct{}
+func (p *Program) sandboxCommand(cmd []string, args ...string) ([]string, error) {
+	c := expand(cmd, filepath.Base(p.Source), "./"+filepath.Base(p.Executable), ".", args...)
+	if !strings.Contains(c[0], "/") {
+		// the sandbox doesn't look up the command in PATH
+		path, err := exec.LookPath(c[0])
+		if err != nil {
+			return nil, err
+		}
+		c[0] = path
+	}
 
-func (*GoManager) Version() *exec.Cmd {
-	return exec.Command("go", "version")
+	return c, nil
 }
 
-func (*GoManager) Compile(p *Program, args ...string) *exec.Cmd {
-	args = append([]string{"build", "-a", "-installsuffix", "cgo", "-ldflags", "-s", "-o", p.Executable, p.Source}, args...)
-	cmd := exec.Command("go", args...)
-	cmd.Env = os.Environ()
-	cmd.Env = append(cmd.Env, "CGO_ENABLED=0")
-	return cmd
-}
+// NewProgram creates a program written in a language from the registry
+func NewProgram(source, executable string, language Language) (*Program, error) {
+	spec, err := GetLanguage(language)
+	if err != nil {
+		return nil, err
+	}
 
-func (*GoManager) Execute(p *Program, args ...string) *exec.Cmd {
-	return exec.Command(p.Executable, args...)
+	return &Program{
+		Source:     source,
+		Executable: executable,
+		Language:   language,
+		Spec:       spec,
+	}, nil
 }
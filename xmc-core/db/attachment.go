@@ -1,6 +1,8 @@
 package db
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
@@ -56,8 +58,12 @@ func (d *Datastore) SetS3Object(uuid uuid.UUID, s3Object string) error {
 	return e(d.db.Exec("UPDATE attachments SET s3_object=? WHERE id=?", s3Object, uuid).Error, "couldn't set attachment's S3 object")
 }
 
-func (d *Datastore) SetAttachmentSize(uuid uuid.UUID, size int32) error {
-	return e(d.db.Exec("UPDATE attachments SET size = ? WHERE id = ?", size, uuid).Error, "couldn't set attachment's size")
+// SetAttachmentContents sets the size and the SHA-256 of the attachment after its contents were uploaded.
+// The evals cache the contents by their SHA-256.
+func (d *Datastore) SetAttachmentContents(uuid uuid.UUID, contents []byte) error {
+	sum := sha256.Sum256(contents)
+	return e(d.db.Exec("UPDATE attachments SET size = ?, sha256 = ?, updated_at = ? WHERE id = ?",
+		len(contents), hex.EncodeToString(sum[:]), time.Now(), uuid).Error, "couldn't set attachment's contents")
 }
 
 func (d *Datastore) SetAttachmentPublic(uuid uuid.UUID, isPublic bool) error {
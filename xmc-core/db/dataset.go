@@ -43,7 +43,7 @@ func (d *Datastore) UpdateDataset(ds *pdataset.UpdateRequest) error {
 
 	if len(ds.GraderId) > 0 {
 		graderID, _ := uuid.Parse(ds.GraderId)
-		dt.GraderID = graderID
+		dt.GraderID = &graderID
 	}
 
 	if len(ds.Name) > 0 {
@@ -58,11 +58,49 @@ func (d *Datastore) UpdateDataset(ds *pdataset.UpdateRequest) error {
 		dt.TimeLimit, _ = ptypes.Duration(ds.TimeLimit)
 	}
 
+	if ds.ScoringPolicy != nil {
+		dt.ScoringPolicy = problem.ScoringPolicy(ds.ScoringPolicy.Value)
+	}
+
+	if ds.Type != nil {
+		dt.Type = problem.Type(ds.Type.Value)
+	}
+
+	if ds.Checker != nil {
+		dt.Checker = problem.Checker(ds.Checker.Value)
+		if dt.Checker != problem.Custom {
+			dt.GraderID = nil
+		}
+	}
+
+	if ds.Epsilon != nil {
+		dt.Epsilon = ds.Epsilon.Value
+	}
+
+	if ds.Requirements != nil {
+		dt.SetRequirements(ds.Requirements)
+	}
+
+	if ds.OutputLimit != nil {
+		dt.OutputLimit = ds.OutputLimit.Value
+	}
+
+	if ds.IdlenessLimit != nil {
+		dt.IdlenessLimit, _ = ptypes.Duration(ds.IdlenessLimit)
+	}
+
 	if err := dd.db.Save(dt).Error; err != nil {
 		dd.Rollback()
 		return e(err, "couldn't update dataset")
 	}
 
+	if len(ds.TestGroups) > 0 || ds.ClearTestGroups {
+		if err := dd.SetTestGroups(id, ds.TestGroups); err != nil {
+			dd.Rollback()
+			return err
+		}
+	}
+
 	return e(dd.Commit(), "couldn't update dataset")
 }
 
@@ -126,6 +164,7 @@ func (d *Datastore) CreateTestCase(req *pdataset.AddTestCaseRequest) (uuid.UUID,
 	t := &problem.TestCase{
 		Number:    req.Number,
 		DatasetID: datasetID,
+		GroupNo:   req.GroupNo,
 	}
 
 	err := d.db.Create(t).Error
@@ -169,6 +208,10 @@ func (d *Datastore) TestCaseSetAttachmentIDs(testCaseID, inputID, outputID uuid.
 	return e(d.db.Exec("UPDATE test_cases SET	input_attachment_id = ?, output_attachment_id = ? WHERE id = ?", inputID, outputID, testCaseID).Error, "couldn't set test case's attachment ids")
 }
 
+func (d *Datastore) TestCaseSetGroupNo(testCaseID uuid.UUID, groupNo int32) error {
+	return e(d.db.Exec("UPDATE test_cases SET group_no = ? WHERE id = ?", groupNo, testCaseID).Error, "couldn't set test case's group number")
+}
+
 func (d *Datastore) RemoveTestCase(datasetID uuid.UUID, number int32) error {
 	result := d.db.Where("dataset_id = ? AND number = ?", datasetID, number).Delete(&problem.TestCase{})
 
@@ -182,3 +225,42 @@ func (d *Datastore) RemoveTestCase(datasetID uuid.UUID, number int32) error {
 
 	return nil
 }
+
+func (d *Datastore) ReadTestGroups(datasetID uuid.UUID) ([]*problem.TestGroup, error) {
+	tg := []*problem.TestGroup{}
+
+	err := d.db.Where("dataset_id = ?", datasetID).Order("number ASC").Find(&tg).Error
+
+	return tg, e(err, "couldn't read test groups")
+}
+
+// SetTestGroups replaces the test groups of a dataset.
+// The test cases in groups that don't exist anymore are left without a group.
+func (d *Datastore) SetTestGroups(datasetID uuid.UUID, tgs []*pdataset.TestGroup) error {
+	dd := d.begin()
+	err := dd.db.Where("dataset_id = ?", datasetID).Delete(&problem.TestGroup{}).Error
+	if err != nil {
+		dd.Rollback()
+		return e(err, "couldn't set test groups")
+	}
+
+	numbers := []int32{0}
+	for _, tg := range tgs {
+		numbers = append(numbers, tg.Number)
+	}
+	err = dd.db.Exec("UPDATE test_cases SET group_no = 0 WHERE dataset_id = ? AND group_no NOT IN (?)", datasetID, numbers).Error
+	if err != nil {
+		dd.Rollback()
+		return e(err, "couldn't set test groups")
+	}
+
+	for _, tg := range tgs {
+		err = dd.db.Create(problem.TestGroupFromProto(datasetID, tg)).Error
+		if err != nil {
+			dd.Rollback()
+			return e(err, "couldn't set test groups")
+		}
+	}
+
+	return e(dd.Commit(), "couldn't set test groups")
+}
@@ -44,6 +44,9 @@ func (d *Datastore) UpdateGrader(gd *pgrader.UpdateRequest) error {
 	if len(gd.Name) > 0 {
 		g.Name = gd.Name
 	}
+	if gd.Protocol != nil {
+		g.Protocol = problem.Protocol(gd.Protocol.Value)
+	}
 
 	if err := dd.db.Save(g).Error; err != nil {
 		dd.Rollback()
@@ -596,6 +596,285 @@ func (d *Datastore) Migrate() error {
 				return tx.Model(&page.Page{}).DropColumn("object_id").Error
 			},
 		},
+		{ // test groups and scoring policies for datasets
+			ID: "201808010015",
+			Migrate: func(tx *gorm.DB) error {
+				type Dataset struct {
+					ScoringPolicy int32
+				}
+				type TestCase struct {
+					GroupNo int32
+				}
+				type TestGroup struct {
+					DatasetID uuid.UUID       `gorm:"type:uuid;primary_key"`
+					Number    int32           `gorm:"primary_key;auto_increment:false"`
+					Weight    decimal.Decimal `gorm:"type:numeric(5,2)"`
+				}
+				type GroupResult struct {
+					SubmissionID uuid.UUID       `gorm:"type:uuid;primary_key"`
+					GroupNo      int32           `gorm:"primary_key;auto_increment:false"`
+					Score        decimal.Decimal `gorm:"type:numeric(5,2)"`
+				}
+				if err := tx.AutoMigrate(&Dataset{}, &TestCase{}, &TestGroup{}, &GroupResult{}).Error; err != nil {
+					return err
+				}
+				err := tx.Model(&TestGroup{}).
+					AddForeignKey("dataset_id", "datasets(id)", "CASCADE", "CASCADE").Error
+				if err != nil {
+					return err
+				}
+
+				return tx.Model(&GroupResult{}).
+					AddForeignKey("submission_id", "submissions(id)", "CASCADE", "CASCADE").Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.DropTable(&problem.TestGroup{}, &submission.GroupResult{}).Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&problem.TestCase{}).DropColumn("group_no").Error; err != nil {
+					return err
+				}
+
+				return tx.Model(&problem.Dataset{}).DropColumn("scoring_policy").Error
+			},
+		},
+		{
+			ID: "201808050020",
+			Migrate: func(tx *gorm.DB) error {
+				type Dataset struct {
+					Type int32
+				}
+				type TestResult struct {
+					InteractorMemory int32
+					InteractorTime   time.Duration
+				}
+				return tx.AutoMigrate(&Dataset{}, &TestResult{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				err := tx.Model(&submission.TestResult{}).DropColumn("interactor_memory").Error
+				if err != nil {
+					return err
+				}
+				err = tx.Model(&submission.TestResult{}).DropColumn("interactor_time").Error
+				if err != nil {
+					return err
+				}
+
+				return tx.Model(&problem.Dataset{}).DropColumn("type").Error
+			},
+		},
+		{
+			ID: "201808070030",
+			Migrate: func(tx *gorm.DB) error {
+				type SubmissionResult struct {
+					Verdict int32 `gorm:"index"`
+				}
+				type TestResult struct {
+					Verdict int32
+				}
+				return tx.AutoMigrate(&SubmissionResult{}, &TestResult{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Model(&submission.TestResult{}).DropColumn("verdict").Error; err != nil {
+					return err
+				}
+
+				return tx.Model(&submission.Result{}).DropColumn("verdict").Error
+			},
+		},
+		{
+			ID: "201808090015",
+			Migrate: func(tx *gorm.DB) error {
+				type Grader struct {
+					Protocol int32
+				}
+				type TestResult struct {
+					GraderPrivateMessage string
+				}
+				return tx.AutoMigrate(&Grader{}, &TestResult{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Model(&submission.TestResult{}).DropColumn("grader_private_message").Error; err != nil {
+					return err
+				}
+
+				return tx.Model(&problem.Grader{}).DropColumn("protocol").Error
+			},
+		},
+		{
+			ID: "201808110020",
+			Migrate: func(tx *gorm.DB) error {
+				type Dataset struct {
+					Checker int32
+					Epsilon float64
+				}
+				return tx.AutoMigrate(&Dataset{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Model(&problem.Dataset{}).DropColumn("epsilon").Error; err != nil {
+					return err
+				}
+
+				return tx.Model(&problem.Dataset{}).DropColumn("checker").Error
+			},
+		},
+		{
+			ID: "201808130020",
+			Migrate: func(tx *gorm.DB) error {
+				type Dataset struct {
+					RequiredLabels    string
+					MinBenchmarkScore float64
+					RequiredCPUModel  string
+				}
+				return tx.AutoMigrate(&Dataset{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				for _, c := range []string{"required_labels", "min_benchmark_score", "required_cpu_model"} {
+					if err := tx.Model(&problem.Dataset{}).DropColumn(c).Error; err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			ID: "201808130030",
+			Migrate: func(tx *gorm.DB) error {
+				// unfinished submissions used to be stored with a zero finished_at instead of NULL
+				return tx.Exec("UPDATE submissions SET finished_at = NULL WHERE finished_at = ? OR finished_at = ?", time.Time{}, time.Unix(0, 0)).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return nil
+			},
+		},
+		{
+			ID: "201808140020",
+			Migrate: func(tx *gorm.DB) error {
+				type TestResult struct {
+					WallTime   time.Duration
+					MaxRSS     int32
+					ExitCode   int32
+					Signal     int32
+					StdoutSize int64
+				}
+				return tx.AutoMigrate(&TestResult{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				for _, c := range []string{"wall_time", "max_rss", "exit_code", "signal", "stdout_size"} {
+					if err := tx.Model(&submission.TestResult{}).DropColumn(c).Error; err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			ID: "201808150020",
+			Migrate: func(tx *gorm.DB) error {
+				type Dataset struct {
+					OutputLimit   int64
+					IdlenessLimit time.Duration
+				}
+				return tx.AutoMigrate(&Dataset{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Model(&problem.Dataset{}).DropColumn("output_limit").Error; err != nil {
+					return err
+				}
+
+				return tx.Model(&problem.Dataset{}).DropColumn("idleness_limit").Error
+			},
+		},
+		{
+			ID: "201808150030",
+			Migrate: func(tx *gorm.DB) error {
+				type Attachment struct {
+					SHA256 string `gorm:"column:sha256"`
+				}
+				return tx.AutoMigrate(&Attachment{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Model(&attachment.Attachment{}).DropColumn("sha256").Error
+			},
+		},
+		{
+			ID: "201808160020",
+			Migrate: func(tx *gorm.DB) error {
+				type TaskList struct {
+					ScoringMode int32
+				}
+				return tx.AutoMigrate(&TaskList{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Model(&tasklist.TaskList{}).DropColumn("scoring_mode").Error
+			},
+		},
+		{
+			ID: "201808170020",
+			Migrate: func(tx *gorm.DB) error {
+				type TaskList struct {
+					FreezeTime    *time.Time
+					RevealedUntil *time.Time
+				}
+				return tx.AutoMigrate(&TaskList{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Model(&tasklist.TaskList{}).DropColumn("freeze_time").Error; err != nil {
+					return err
+				}
+				return tx.Model(&tasklist.TaskList{}).DropColumn("revealed_until").Error
+			},
+		},
+		{
+			ID: "201808180020",
+			Migrate: func(tx *gorm.DB) error {
+				type TaskList struct {
+					ParticipationDuration time.Duration
+				}
+				type Participation struct {
+					StartTime *time.Time
+					EndTime   *time.Time
+				}
+				return tx.AutoMigrate(&TaskList{}).AutoMigrate(&Participation{}).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if err := tx.Model(&tasklist.TaskList{}).DropColumn("participation_duration").Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&tasklist.Participation{}).DropColumn("start_time").Error; err != nil {
+					return err
+				}
+				return tx.Model(&tasklist.Participation{}).DropColumn("end_time").Error
+			},
+		},
+		{
+			ID: "201808190020",
+			Migrate: func(tx *gorm.DB) error {
+				type TaskList struct {
+					RegistrationStartTime *time.Time
+					RegistrationEndTime   *time.Time
+					MaxParticipants       uint32
+				}
+				type Participation struct {
+					CreatedAt *time.Time
+				}
+				if err := tx.AutoMigrate(&TaskList{}).AutoMigrate(&Participation{}).Error; err != nil {
+					return err
+				}
+				// the users registered before this migration get its time
+				return tx.Model(&Participation{}).Where("created_at IS NULL").Update("created_at", time.Now()).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				for _, c := range []string{"registration_start_time", "registration_end_time", "max_participants"} {
+					if err := tx.Model(&tasklist.TaskList{}).DropColumn(c).Error; err != nil {
+						return err
+					}
+				}
+				return tx.Model(&tasklist.Participation{}).DropColumn("created_at").Error
+			},
+		},
 	})
 	return errors.Wrap(m.Migrate(), "failed to migrate schema")
 }
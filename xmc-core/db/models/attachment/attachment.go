@@ -16,6 +16,7 @@ type Attachment struct {
 	ObjectID    string `gorm:"unique_index:idx_object_id_filename"`
 	Filename    string `gorm:"unique_index:idx_object_id_filename"`
 	Size        int32
+	SHA256      string `gorm:"column:sha256"`
 	IsPublic    bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -30,6 +31,7 @@ func FromProto(att *pattachment.Attachment) *Attachment {
 		ObjectID:    att.ObjectId,
 		Filename:    att.Filename,
 		Size:        att.Size,
+		SHA256:      att.Sha256,
 		IsPublic:    att.IsPublic,
 	}
 	a.CreatedAt, _ = ptypes.Timestamp(att.CreatedAt)
@@ -46,6 +48,7 @@ func (a *Attachment) ToProto() *pattachment.Attachment {
 		ObjectId:    a.ObjectID,
 		Filename:    a.Filename,
 		Size:        a.Size,
+		Sha256:      a.SHA256,
 		IsPublic:    a.IsPublic,
 	}
 	att.CreatedAt, _ = ptypes.TimestampProto(a.CreatedAt)
@@ -1,6 +1,7 @@
 package problem
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -8,40 +9,155 @@ import (
 	pdataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 )
 
+// ScoringPolicy decides how the score of a submission is computed from the scores of its test cases
+type ScoringPolicy int32
+
+const (
+	// Average means that the score is the average of the test case scores
+	Average ScoringPolicy = 0
+
+	// GroupMin means that each test group gets its weight multiplied by the lowest score of its test cases
+	GroupMin ScoringPolicy = 1
+
+	// GroupAllOrNothing means that each test group gets its weight only if all of its test cases are passed
+	GroupAllOrNothing ScoringPolicy = 2
+)
+
+// UsesGroups returns whether the score is computed from the test groups of the dataset
+func (sp ScoringPolicy) UsesGroups() bool {
+	return sp == GroupMin || sp == GroupAllOrNothing
+}
+
+// Type is the way the user program is run on a test case
+type Type int32
+
+const (
+	// Batch means that the output of the user program is checked by the grader after it exits
+	Batch Type = 0
+
+	// Interactive means that the user program talks with the grader while it runs
+	Interactive Type = 1
+)
+
+// Checker is the way the output of the user program is checked in batch datasets
+type Checker int32
+
+const (
+	// Custom means that the output is checked by the grader of the dataset
+	Custom Checker = 0
+
+	// Tokens means that the outputs must have the same whitespace separated tokens
+	Tokens Checker = 1
+
+	// Lines means that the outputs must have the same lines, ignoring trailing whitespace
+	Lines Checker = 2
+
+	// FloatAbsolute means that numbers in the outputs may differ by at most epsilon
+	FloatAbsolute Checker = 3
+
+	// FloatRelative means that numbers in the outputs may differ by at most epsilon times the expected number
+	FloatRelative Checker = 4
+
+	// UnorderedLines means that the outputs must have the same lines in any order
+	UnorderedLines Checker = 5
+)
+
 // Dataset stores the information necessary for the evaluation of a submission,
 // like the grader's code, tests etc.
 type Dataset struct {
-	ID          uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v1mc()"`
-	Name        string    `gorm:"unique_index"`
-	GraderID    uuid.UUID `gorm:"type:uuid"`
-	Description string
-	TimeLimit   time.Duration
-	MemoryLimit int32
+	ID   uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v1mc()"`
+	Name string    `gorm:"unique_index"`
+	// GraderID is nil if the dataset uses a built-in checker
+	GraderID      *uuid.UUID `gorm:"type:uuid"`
+	Description   string
+	TimeLimit     time.Duration
+	MemoryLimit   int32
+	ScoringPolicy ScoringPolicy
+	Type          Type
+	Checker       Checker
+	Epsilon       float64
+
+	// RequiredLabels holds the labels required from the eval nodes, encoded as a JSON object
+	RequiredLabels    string
+	MinBenchmarkScore float64
+	RequiredCPUModel  string
+
+	// OutputLimit is in bytes, 0 means that the eval uses its default
+	OutputLimit   int64
+	IdlenessLimit time.Duration
+}
+
+// SetRequirements sets the requirements of the eval nodes that evaluate the submissions of the dataset
+func (d *Dataset) SetRequirements(r *pdataset.NodeRequirements) {
+	d.RequiredLabels = ""
+	d.MinBenchmarkScore = 0
+	d.RequiredCPUModel = ""
+	if r == nil {
+		return
+	}
+	if len(r.Labels) > 0 {
+		labels, _ := json.Marshal(r.Labels)
+		d.RequiredLabels = string(labels)
+	}
+	d.MinBenchmarkScore = r.MinBenchmarkScore
+	d.RequiredCPUModel = r.CpuModel
+}
+
+// Requirements returns the requirements of the eval nodes that evaluate the submissions of the dataset
+func (d *Dataset) Requirements() *pdataset.NodeRequirements {
+	r := &pdataset.NodeRequirements{
+		MinBenchmarkScore: d.MinBenchmarkScore,
+		CpuModel:          d.RequiredCPUModel,
+	}
+	if len(d.RequiredLabels) > 0 {
+		json.Unmarshal([]byte(d.RequiredLabels), &r.Labels)
+	}
+
+	return r
 }
 
 func DatasetFromProto(ds *pdataset.Dataset) *Dataset {
 	id, _ := uuid.Parse(ds.Id)
-	graderID, _ := uuid.Parse(ds.GraderId)
 	d := &Dataset{
-		ID:          id,
-		Name:        ds.Name,
-		GraderID:    graderID,
-		Description: ds.Description,
-		MemoryLimit: ds.MemoryLimit,
+		ID:            id,
+		Name:          ds.Name,
+		Description:   ds.Description,
+		MemoryLimit:   ds.MemoryLimit,
+		ScoringPolicy: ScoringPolicy(ds.ScoringPolicy),
+		Type:          Type(ds.Type),
+		Checker:       Checker(ds.Checker),
+		Epsilon:       ds.Epsilon,
+		OutputLimit:   ds.OutputLimit,
+	}
+	if graderID, err := uuid.Parse(ds.GraderId); err == nil {
+		d.GraderID = &graderID
 	}
+	d.SetRequirements(ds.Requirements)
 	d.TimeLimit, _ = ptypes.Duration(ds.TimeLimit)
+	if ds.IdlenessLimit != nil {
+		d.IdlenessLimit, _ = ptypes.Duration(ds.IdlenessLimit)
+	}
 
 	return d
 }
 
 func (d *Dataset) ToProto() *pdataset.Dataset {
 	ds := &pdataset.Dataset{
-		Id:          d.ID.String(),
-		Name:        d.Name,
-		GraderId:    d.GraderID.String(),
-		Description: d.Description,
-		TimeLimit:   ptypes.DurationProto(d.TimeLimit),
-		MemoryLimit: d.MemoryLimit,
+		Id:            d.ID.String(),
+		Name:          d.Name,
+		Description:   d.Description,
+		TimeLimit:     ptypes.DurationProto(d.TimeLimit),
+		MemoryLimit:   d.MemoryLimit,
+		ScoringPolicy: pdataset.ScoringPolicy(d.ScoringPolicy),
+		Type:          pdataset.Type(d.Type),
+		Checker:       pdataset.Checker(d.Checker),
+		Epsilon:       d.Epsilon,
+		Requirements:  d.Requirements(),
+		OutputLimit:   d.OutputLimit,
+		IdlenessLimit: ptypes.DurationProto(d.IdlenessLimit),
+	}
+	if d.GraderID != nil {
+		ds.GraderId = d.GraderID.String()
 	}
 
 	return ds
@@ -5,6 +5,20 @@ import (
 	pgrader "github.com/xmc-dev/xmc/xmc-core/proto/grader"
 )
 
+// Protocol is the way the grader reports the result of a test case
+type Protocol int32
+
+const (
+	// Legacy graders write the score to stdout and the message to stderr
+	Legacy Protocol = 0
+
+	// XMCV1 graders write a versioned key-value report to stdout
+	XMCV1 Protocol = 1
+
+	// Testlib graders give the verdict through their exit code
+	Testlib Protocol = 2
+)
+
 // Grader is a problem that gives a grade to a solution
 // based on a TestCase
 type Grader struct {
@@ -12,6 +26,7 @@ type Grader struct {
 	AttachmentID uuid.UUID `gorm:"type:uuid"`
 	Language     string
 	Name         string `gorm:"unique_index"`
+	Protocol     Protocol
 }
 
 func GraderFromProto(gr *pgrader.Grader) *Grader {
@@ -22,6 +37,7 @@ func GraderFromProto(gr *pgrader.Grader) *Grader {
 		AttachmentID: attachmentID,
 		Language:     gr.Language,
 		Name:         gr.Name,
+		Protocol:     Protocol(gr.Protocol),
 	}
 
 	return g
@@ -33,6 +49,7 @@ func (g *Grader) ToProto() *pgrader.Grader {
 		AttachmentId: g.AttachmentID.String(),
 		Language:     g.Language,
 		Name:         g.Name,
+		Protocol:     pgrader.Protocol(g.Protocol),
 	}
 
 	return gr
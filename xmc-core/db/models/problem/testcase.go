@@ -12,6 +12,8 @@ type TestCase struct {
 	Number             int32     `gorm:"unique_index:idx_dataset_id_number"`
 	InputAttachmentID  uuid.UUID `gorm:"type:uuid"`
 	OutputAttachmentID uuid.UUID `gorm:"type:uuid"`
+	// GroupNo is the number of the test group, 0 if the test case is not in a group
+	GroupNo int32
 }
 
 func (tc *TestCase) ToProto() *pdataset.TestCase {
@@ -20,5 +22,6 @@ func (tc *TestCase) ToProto() *pdataset.TestCase {
 		Number:             tc.Number,
 		InputAttachmentId:  tc.InputAttachmentID.String(),
 		OutputAttachmentId: tc.OutputAttachmentID.String(),
+		GroupNo:            tc.GroupNo,
 	}
 }
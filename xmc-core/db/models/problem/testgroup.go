@@ -0,0 +1,32 @@
+package problem
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	pdataset "github.com/xmc-dev/xmc/xmc-core/proto/dataset"
+)
+
+// TestGroup is a group of test cases of a dataset that are scored together.
+// The weight is the number of points given by the group.
+type TestGroup struct {
+	DatasetID uuid.UUID       `gorm:"type:uuid;primary_key"`
+	Number    int32           `gorm:"primary_key"`
+	Weight    decimal.Decimal `gorm:"type:numeric(5,2)"`
+}
+
+func TestGroupFromProto(datasetID uuid.UUID, tg *pdataset.TestGroup) *TestGroup {
+	t := &TestGroup{
+		DatasetID: datasetID,
+		Number:    tg.Number,
+	}
+	t.Weight, _ = decimal.NewFromString(tg.Weight)
+
+	return t
+}
+
+func (tg *TestGroup) ToProto() *pdataset.TestGroup {
+	return &pdataset.TestGroup{
+		Number: tg.Number,
+		Weight: tg.Weight.String(),
+	}
+}
@@ -23,6 +23,25 @@ const (
 	DONE State = 2
 )
 
+// Verdict is the outcome of the evaluation of a test case or of a submission
+type Verdict int32
+
+const (
+	NO_VERDICT              Verdict = 0
+	ACCEPTED                Verdict = 1
+	WRONG_ANSWER            Verdict = 2
+	PARTIAL                 Verdict = 3
+	TIME_LIMIT_EXCEEDED     Verdict = 4
+	MEMORY_LIMIT_EXCEEDED   Verdict = 5
+	RUNTIME_ERROR           Verdict = 6
+	COMPILATION_ERROR       Verdict = 7
+	SYSTEM_ERROR            Verdict = 8
+	COMPILATION_TIMEOUT     Verdict = 9
+	CANCELLED               Verdict = 10
+	OUTPUT_LIMIT_EXCEEDED   Verdict = 11
+	IDLENESS_LIMIT_EXCEEDED Verdict = 12
+)
+
 type Submission struct {
 	ID           uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v1mc()"`
 	TaskID       uuid.UUID `gorm:"type:uuid;index"`
@@ -48,8 +67,12 @@ func SubmissionFromProto(sb *psubmission.Submission) *Submission {
 	s.AttachmentID, _ = uuid.Parse(sb.AttachmentId)
 	s.UserID, _ = uuid.Parse(sb.UserId)
 	s.CreatedAt, _ = ptypes.Timestamp(sb.CreatedAt)
-	finishedAt, _ := ptypes.Timestamp(sb.FinishedAt)
-	s.FinishedAt = &finishedAt
+	if sb.FinishedAt != nil {
+		finishedAt, err := ptypes.Timestamp(sb.FinishedAt)
+		if err == nil && !finishedAt.IsZero() {
+			s.FinishedAt = &finishedAt
+		}
+	}
 
 	return s
 }
@@ -80,15 +103,18 @@ type Result struct {
 	CompilationMessage string
 	Score              decimal.Decimal
 	BuildCommand       string
+	Verdict            Verdict `gorm:"index"`
 }
 
-func (r *Result) ToProto(tr []*presult.TestResult) *presult.Result {
+func (r *Result) ToProto(tr []*presult.TestResult, gr []*presult.GroupResult) *presult.Result {
 	rs := &presult.Result{
 		ErrorMessage:       r.ErrorMessage,
 		CompilationMessage: r.CompilationMessage,
 		Score:              r.Score.String(),
 		TestResults:        tr,
 		BuildCommand:       r.BuildCommand,
+		GroupResults:       gr,
+		Verdict:            presult.Verdict(r.Verdict),
 	}
 
 	return rs
@@ -105,6 +131,19 @@ type TestResult struct {
 	GraderMessage string
 	Memory        int32
 	Time          time.Duration
+
+	InteractorMemory int32
+	InteractorTime   time.Duration
+
+	Verdict              Verdict
+	GraderPrivateMessage string
+
+	WallTime time.Duration
+	// MaxRSS is the peak resident set size of the user program in kilobytes
+	MaxRSS     int32
+	ExitCode   int32
+	Signal     int32
+	StdoutSize int64
 }
 
 func (t *TestResult) ToProto() *presult.TestResult {
@@ -114,7 +153,35 @@ func (t *TestResult) ToProto() *presult.TestResult {
 		GraderMessage: t.GraderMessage,
 		Memory:        t.Memory,
 		Time:          ptypes.DurationProto(t.Time),
+
+		InteractorMemory: t.InteractorMemory,
+		InteractorTime:   ptypes.DurationProto(t.InteractorTime),
+
+		Verdict:              presult.Verdict(t.Verdict),
+		GraderPrivateMessage: t.GraderPrivateMessage,
+
+		WallTime:   ptypes.DurationProto(t.WallTime),
+		MaxRss:     t.MaxRSS,
+		ExitCode:   t.ExitCode,
+		Signal:     t.Signal,
+		StdoutSize: t.StdoutSize,
 	}
 
 	return tr
 }
+
+// GroupResult is the score of a submission on a test group
+type GroupResult struct {
+	SubmissionID uuid.UUID       `gorm:"type:uuid;primary_key"`
+	GroupNo      int32           `gorm:"primary_key"`
+	Score        decimal.Decimal `gorm:"type:numeric(5,2)"`
+}
+
+func (g *GroupResult) ToProto() *presult.GroupResult {
+	gr := &presult.GroupResult{
+		GroupNo: g.GroupNo,
+		Score:   g.Score.String(),
+	}
+
+	return gr
+}
@@ -9,6 +9,20 @@ import (
 	ptsrange "github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
 )
 
+// ScoringMode decides how the scoreboard of a task list is computed
+type ScoringMode int32
+
+const (
+	// IOIMaxScore means that the score of a participant on a task is the best score of their submissions
+	IOIMaxScore ScoringMode = 0
+
+	// IOILastScore means that the score of a participant on a task is the score of their last submission
+	IOILastScore ScoringMode = 1
+
+	// ICPC means that a task is solved by an accepted submission and that rejected submissions add penalty time
+	ICPC ScoringMode = 2
+)
+
 // TaskList is a list of tasks with a wiki page. Users can solve the tasks
 // and get a rank.
 type TaskList struct {
@@ -21,11 +35,71 @@ type TaskList struct {
 	Title              string
 	PublicSubmissions  bool
 	WithParticipations bool
+	ScoringMode        ScoringMode
+	FreezeTime         *time.Time
+	RevealedUntil      *time.Time
+	// ParticipationDuration is the length of the personal time windows of the participants.
+	// If it is zero the participants share the time range of the task list.
+	ParticipationDuration time.Duration
+	// RegistrationStartTime and RegistrationEndTime are the time range in which users can participate
+	RegistrationStartTime *time.Time
+	RegistrationEndTime   *time.Time
+	// MaxParticipants is the maximum number of participants, zero means no limit
+	MaxParticipants uint32
+}
+
+// ResultHidden returns true if the result of a submission made at the given time
+// must be hidden because the scoreboard is frozen
+func (t *TaskList) ResultHidden(createdAt time.Time) bool {
+	if t.FreezeTime == nil || t.EndTime == nil {
+		return false
+	}
+	if createdAt.Before(*t.FreezeTime) || createdAt.After(*t.EndTime) {
+		return false
+	}
+
+	// revealing until the end time reveals everything
+	return t.RevealedUntil == nil || (!createdAt.Before(*t.RevealedUntil) && t.RevealedUntil.Before(*t.EndTime))
 }
 
 type Participation struct {
 	TaskListID uuid.UUID `gorm:"primary_key;type:uuid"`
 	UserID     uuid.UUID `gorm:"primary_key"`
+	// CreatedAt is when the user registered
+	CreatedAt time.Time
+	// StartTime and EndTime are the personal time window of the participant,
+	// they are nil until the participation is started
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+// RegistrationOpen returns true if users can participate at the given time
+// according to the registration time range. It doesn't check the other rules.
+func (t *TaskList) RegistrationOpen(now time.Time) bool {
+	if t.RegistrationStartTime == nil || t.RegistrationEndTime == nil {
+		return true
+	}
+
+	return !now.Before(*t.RegistrationStartTime) && !now.After(*t.RegistrationEndTime)
+}
+
+// Started returns true if the participant has started their personal time window
+func (p *Participation) Started() bool {
+	return p.StartTime != nil && p.EndTime != nil
+}
+
+func (p *Participation) ToProto() *ptasklist.Participation {
+	pp := &ptasklist.Participation{
+		TaskListId: p.TaskListID.String(),
+		UserId:     p.UserID.String(),
+	}
+	pp.RegisteredAt, _ = ptypes.TimestampProto(p.CreatedAt)
+	if p.Started() {
+		pp.StartTime, _ = ptypes.TimestampProto(*p.StartTime)
+		pp.EndTime, _ = ptypes.TimestampProto(*p.EndTime)
+	}
+
+	return pp
 }
 
 func FromProto(tl *ptasklist.TaskList) *TaskList {
@@ -48,6 +122,21 @@ func FromProto(tl *ptasklist.TaskList) *TaskList {
 		Title:              tl.Title,
 		PublicSubmissions:  tl.PublicSubmissions,
 		WithParticipations: tl.WithParticipations,
+		ScoringMode:        ScoringMode(tl.ScoringMode),
+		MaxParticipants:    tl.MaxParticipants,
+	}
+	if tl.FreezeTime != nil {
+		ft, _ := ptypes.Timestamp(tl.FreezeTime)
+		t.FreezeTime = &ft
+	}
+	if tl.ParticipationDuration != nil {
+		t.ParticipationDuration, _ = ptypes.Duration(tl.ParticipationDuration)
+	}
+	if tl.RegistrationTimeRange != nil {
+		st, _ := ptypes.Timestamp(tl.RegistrationTimeRange.Begin)
+		et, _ := ptypes.Timestamp(tl.RegistrationTimeRange.End)
+		t.RegistrationStartTime = &st
+		t.RegistrationEndTime = &et
 	}
 
 	return t
@@ -62,12 +151,28 @@ func (t *TaskList) ToProto() *ptasklist.TaskList {
 		Title:              t.Title,
 		PublicSubmissions:  t.PublicSubmissions,
 		WithParticipations: t.WithParticipations,
+		ScoringMode:        ptasklist.ScoringMode(t.ScoringMode),
+		MaxParticipants:    t.MaxParticipants,
 	}
 	tl.TimeRange = &ptsrange.TimestampRange{}
 	if t.StartTime != nil && t.EndTime != nil {
 		tl.TimeRange.Begin, _ = ptypes.TimestampProto(*t.StartTime)
 		tl.TimeRange.End, _ = ptypes.TimestampProto(*t.EndTime)
 	}
+	if t.FreezeTime != nil {
+		tl.FreezeTime, _ = ptypes.TimestampProto(*t.FreezeTime)
+	}
+	if t.RevealedUntil != nil {
+		tl.RevealedUntil, _ = ptypes.TimestampProto(*t.RevealedUntil)
+	}
+	if t.ParticipationDuration > 0 {
+		tl.ParticipationDuration = ptypes.DurationProto(t.ParticipationDuration)
+	}
+	tl.RegistrationTimeRange = &ptsrange.TimestampRange{}
+	if t.RegistrationStartTime != nil && t.RegistrationEndTime != nil {
+		tl.RegistrationTimeRange.Begin, _ = ptypes.TimestampProto(*t.RegistrationStartTime)
+		tl.RegistrationTimeRange.End, _ = ptypes.TimestampProto(*t.RegistrationEndTime)
+	}
 
 	return tl
 }
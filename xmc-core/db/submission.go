@@ -1,11 +1,14 @@
 package db
 
 import (
+	"time"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/xmc-dev/xmc/xmc-core/db/models/submission"
 	psubmission "github.com/xmc-dev/xmc/xmc-core/proto/submission"
+	"github.com/xmc-dev/xmc/xmc-core/scoreboard"
 )
 
 func (d *Datastore) CreateSubmission(sb *psubmission.Submission) (uuid.UUID, error) {
@@ -39,6 +42,14 @@ func (d *Datastore) ReadTestResults(id uuid.UUID) ([]*submission.TestResult, err
 	return tr, e(err, "couldn't read test results")
 }
 
+func (d *Datastore) ReadGroupResults(id uuid.UUID) ([]*submission.GroupResult, error) {
+	gr := []*submission.GroupResult{}
+
+	err := d.db.Where("submission_id = ?", id).Order("group_no ASC").Find(&gr).Error
+
+	return gr, e(err, "couldn't read group results")
+}
+
 func (d *Datastore) UpdateSubmission(req *psubmission.UpdateRequest) error {
 	dd := d.begin()
 	id, _ := uuid.Parse(req.Job.SubmissionId)
@@ -51,14 +62,29 @@ func (d *Datastore) UpdateSubmission(req *psubmission.UpdateRequest) error {
 	}
 	ss.EvalID = req.Job.EvalId
 	ss.State = submission.State(req.Job.State)
-	finishedAt, _ := ptypes.Timestamp(req.Job.FinishedAt)
-	ss.FinishedAt = &finishedAt
+	ss.FinishedAt = nil
+	if req.Job.FinishedAt != nil {
+		finishedAt, err := ptypes.Timestamp(req.Job.FinishedAt)
+		if err == nil && !finishedAt.IsZero() {
+			ss.FinishedAt = &finishedAt
+		}
+	}
 	err = dd.db.Save(ss).Error
 	if err != nil {
 		dd.Rollback()
 		return err
 	}
 
+	if req.ResetResult {
+		for _, r := range []interface{}{&submission.Result{}, &submission.TestResult{}, &submission.GroupResult{}} {
+			err = dd.db.Where("submission_id = ?", id).Delete(r).Error
+			if err != nil {
+				dd.Rollback()
+				return e(err, "couldn't reset submission result")
+			}
+		}
+	}
+
 	if req.Job.Result != nil {
 		err = dd.db.FirstOrCreate(&sr, submission.Result{SubmissionID: id}).Error
 		if err != nil {
@@ -69,6 +95,7 @@ func (d *Datastore) UpdateSubmission(req *psubmission.UpdateRequest) error {
 		sr.CompilationMessage = req.Job.Result.CompilationMessage
 		sr.Score, _ = decimal.NewFromString(req.Job.Result.Score)
 		sr.BuildCommand = req.Job.Result.BuildCommand
+		sr.Verdict = submission.Verdict(req.Job.Result.Verdict)
 		err = dd.db.Save(&sr).Error
 		if err != nil {
 			dd.Rollback()
@@ -88,12 +115,38 @@ func (d *Datastore) UpdateSubmission(req *psubmission.UpdateRequest) error {
 			t.GraderMessage = pt.GraderMessage
 			t.Memory = pt.Memory
 			t.Time, _ = ptypes.Duration(pt.Time)
+			t.InteractorMemory = pt.InteractorMemory
+			t.InteractorTime, _ = ptypes.Duration(pt.InteractorTime)
+			t.Verdict = submission.Verdict(pt.Verdict)
+			t.GraderPrivateMessage = pt.GraderPrivateMessage
+			t.WallTime, _ = ptypes.Duration(pt.WallTime)
+			t.MaxRSS = pt.MaxRss
+			t.ExitCode = pt.ExitCode
+			t.Signal = pt.Signal
+			t.StdoutSize = pt.StdoutSize
 			err = dd.db.Save(&t).Error
 			if err != nil {
 				dd.Rollback()
 				return e(err, "couldn't save test result")
 			}
 		}
+		for _, pg := range req.Job.Result.GroupResults {
+			g := submission.GroupResult{}
+			err = dd.db.FirstOrCreate(&g, submission.GroupResult{
+				SubmissionID: id,
+				GroupNo:      pg.GroupNo,
+			}).Error
+			if err != nil {
+				dd.Rollback()
+				return e(err, "couldn't create or save group result")
+			}
+			g.Score, _ = decimal.NewFromString(pg.Score)
+			err = dd.db.Save(&g).Error
+			if err != nil {
+				dd.Rollback()
+				return e(err, "couldn't save group result")
+			}
+		}
 	}
 
 	return e(dd.Commit(), "couldn't update submission")
@@ -112,7 +165,17 @@ func (d *Datastore) DeleteSubmission(id uuid.UUID) error {
 	return nil
 }
 
-func (d *Datastore) SearchSubmission(req *psubmission.SearchRequest) ([]*submission.Submission, uint32, error) {
+// frozenSubmission is the condition for the submissions whose results are hidden because the scoreboard
+// of their task list is frozen. It is the same as tasklist.TaskList.ResultHidden.
+const frozenSubmission = `EXISTS(SELECT 1 FROM tasks JOIN task_lists ON task_lists.id = tasks.task_list_id
+	WHERE tasks.id = submissions.task_id AND task_lists.freeze_time IS NOT NULL AND task_lists.end_time IS NOT NULL
+	AND submissions.created_at BETWEEN task_lists.freeze_time AND task_lists.end_time
+	AND (task_lists.revealed_until IS NULL OR
+		(submissions.created_at >= task_lists.revealed_until AND task_lists.revealed_until < task_lists.end_time)))`
+
+// SearchSubmission searches the submissions. If hideFrozen is true, the filters on the results
+// don't match the submissions of other users than viewerID whose results are hidden by a frozen scoreboard.
+func (d *Datastore) SearchSubmission(req *psubmission.SearchRequest, hideFrozen bool, viewerID uuid.UUID) ([]*submission.Submission, uint32, error) {
 	dd := d.begin()
 	ss := []*submission.Submission{}
 	query := dd.db.Joins("FULL OUTER JOIN submission_results on submissions.id = submission_results.submission_id")
@@ -123,6 +186,9 @@ func (d *Datastore) SearchSubmission(req *psubmission.SearchRequest) ([]*submiss
 	if len(req.DatasetId) > 0 {
 		query = query.Where("submissions.dataset_id = ?", req.DatasetId)
 	}
+	if len(req.TaskListId) > 0 {
+		query = query.Where("submissions.task_id IN (SELECT id FROM tasks WHERE task_list_id = ?)", req.TaskListId)
+	}
 	if len(req.EvalId) > 0 {
 		query = query.Where("submissions.eval_id = ?", req.EvalId)
 	}
@@ -156,6 +222,12 @@ func (d *Datastore) SearchSubmission(req *psubmission.SearchRequest) ([]*submiss
 	if len(req.CompilationMessage) > 0 {
 		query = query.Where("submission_results.compilation_message ~* ?", req.CompilationMessage)
 	}
+	if req.Verdict != nil {
+		query = query.Where("submission_results.verdict = ?", req.Verdict.Value)
+	}
+	if hideFrozen && (len(req.ErrorMessage) > 0 || len(req.CompilationMessage) > 0 || req.Verdict != nil) {
+		query = query.Where("submissions.user_id = ? OR NOT "+frozenSubmission, viewerID)
+	}
 	var cnt uint32
 	err := query.Model(&ss).Count(&cnt).Error
 	if err != nil {
@@ -179,3 +251,30 @@ func (d *Datastore) SubmissionSetAttachmentID(id, attID uuid.UUID) error {
 func (d *Datastore) SubmissionSetDatasetID(id, datasetID uuid.UUID) error {
 	return e(d.db.Exec("UPDATE submissions SET dataset_id = ? WHERE id = ?", datasetID, id).Error, "couldn't set submission's dataset id")
 }
+
+// ScoreboardSubmissions returns the submissions for the tasks of the task list that were created
+// in the given time range, sorted by creation time. The submissions that aren't evaluated yet,
+// that didn't compile or that failed to be evaluated are marked as invalid.
+func (d *Datastore) ScoreboardSubmissions(taskListID uuid.UUID, begin, end time.Time) ([]*scoreboard.Submission, error) {
+	ss := []*scoreboard.Submission{}
+	query := d.db.Table("submissions").
+		Select("submissions.id, submissions.user_id, submissions.task_id, submissions.created_at, "+
+			"COALESCE(submission_results.score, 0) AS score, "+
+			"COALESCE(submission_results.verdict = ?, false) AS accepted, "+
+			"NOT COALESCE(submissions.state = ? AND submission_results.verdict NOT IN (?), false) AS invalid",
+			submission.ACCEPTED, submission.DONE, []submission.Verdict{
+				submission.NO_VERDICT,
+				submission.COMPILATION_ERROR,
+				submission.COMPILATION_TIMEOUT,
+				submission.SYSTEM_ERROR,
+				submission.CANCELLED,
+			}).
+		Joins("LEFT JOIN submission_results ON submission_results.submission_id = submissions.id").
+		Where("submissions.task_id IN (SELECT id FROM tasks WHERE task_list_id = ?)", taskListID)
+	if !begin.IsZero() || !end.IsZero() {
+		query = query.Where("submissions.created_at <@ ?::tstzrange", tsrange(begin, end))
+	}
+	err := query.Order("submissions.created_at").Scan(&ss).Error
+
+	return ss, e(err, "couldn't read scoreboard submissions")
+}
@@ -122,3 +122,11 @@ func (d *Datastore) SearchTask(req *ptask.SearchRequest) ([]*problem.Task, uint3
 
 	return ts, cnt, e(err, "couldn't search tasks")
 }
+
+// TaskListTasks returns all the tasks of the task list, sorted by name
+func (d *Datastore) TaskListTasks(taskListID uuid.UUID) ([]*problem.Task, error) {
+	ts := []*problem.Task{}
+	err := d.db.Where("task_list_id = ?", taskListID).Order("name").Find(&ts).Error
+
+	return ts, e(err, "couldn't read task list tasks")
+}
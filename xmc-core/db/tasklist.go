@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
@@ -23,6 +25,15 @@ func (d *Datastore) ReadTaskList(id uuid.UUID) (*tasklist.TaskList, error) {
 	return t, e(err, "couldn't read task list")
 }
 
+// ReadTaskListForUpdate reads a task list and locks it until the end of the transaction,
+// so that the participations of the task list can be counted and created without races.
+func (d *Datastore) ReadTaskListForUpdate(id uuid.UUID) (*tasklist.TaskList, error) {
+	t := &tasklist.TaskList{}
+
+	err := d.db.Set("gorm:query_option", "FOR UPDATE").First(t, "id = ?", id).Error
+	return t, e(err, "couldn't read task list")
+}
+
 func (d *Datastore) GetTaskList(name string) (*tasklist.TaskList, error) {
 	t := &tasklist.TaskList{}
 
@@ -74,6 +85,36 @@ func (d *Datastore) UpdateTaskList(tl *ptasklist.UpdateRequest) error {
 	if tl.WithParticipations != nil {
 		t.WithParticipations = tl.WithParticipations.Value
 	}
+	if tl.ScoringMode != nil {
+		t.ScoringMode = tasklist.ScoringMode(tl.ScoringMode.Value)
+	}
+	if tl.SetNullFreezeTime {
+		t.FreezeTime = nil
+		t.RevealedUntil = nil
+	}
+	if tl.FreezeTime != nil {
+		ft, _ := ptypes.Timestamp(tl.FreezeTime)
+		if t.FreezeTime == nil || !t.FreezeTime.Equal(ft) {
+			t.RevealedUntil = nil
+		}
+		t.FreezeTime = &ft
+	}
+	if tl.ParticipationDuration != nil {
+		t.ParticipationDuration, _ = ptypes.Duration(tl.ParticipationDuration)
+	}
+	if tl.SetNullRegistrationTime {
+		t.RegistrationStartTime = nil
+		t.RegistrationEndTime = nil
+	}
+	if tl.RegistrationTimeRange != nil {
+		st, _ := ptypes.Timestamp(tl.RegistrationTimeRange.Begin)
+		et, _ := ptypes.Timestamp(tl.RegistrationTimeRange.End)
+		t.RegistrationStartTime = &st
+		t.RegistrationEndTime = &et
+	}
+	if tl.MaxParticipants != nil {
+		t.MaxParticipants = tl.MaxParticipants.Value
+	}
 
 	if err := dd.db.Save(t).Error; err != nil {
 		dd.Rollback()
@@ -83,6 +124,19 @@ func (d *Datastore) UpdateTaskList(tl *ptasklist.UpdateRequest) error {
 	return e(dd.Commit(), "couldn't update task list")
 }
 
+// SetTaskListRevealedUntil reveals the hidden results of the submissions made before the given time
+func (d *Datastore) SetTaskListRevealedUntil(id uuid.UUID, revealedUntil time.Time) error {
+	result := d.db.Model(&tasklist.TaskList{}).Where("id = ?", id).Update("revealed_until", revealedUntil)
+	if result.Error != nil {
+		return e(result.Error, "couldn't reveal task list results")
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 func (d *Datastore) DeleteTaskList(id uuid.UUID) error {
 	err := d.db.Model(&problem.Task{}).Where("task_list_id = ?", id).Update("task_list_id", gorm.Expr("NULL")).Error
 	if err != nil {
@@ -187,12 +241,42 @@ func (d *Datastore) CancelParticipation(taskListID, userID uuid.UUID) error {
 	return e(dd.Commit(), "couldn't cancel participation")
 }
 
+func (d *Datastore) CountParticipations(taskListID uuid.UUID) (uint32, error) {
+	var cnt uint32
+	err := d.db.Model(&tasklist.Participation{}).Where("task_list_id = ?", taskListID).Count(&cnt).Error
+
+	return cnt, e(err, "couldn't count participations")
+}
+
 func (d *Datastore) GetTaskListParticipants(taskListID uuid.UUID) ([]*tasklist.Participation, error) {
 	var ps []*tasklist.Participation
-	err := d.db.Find(&ps, "task_list_id = ?", taskListID).Error
+	err := d.db.Order("created_at").Find(&ps, "task_list_id = ?", taskListID).Error
 	if err != nil {
 		return nil, e(err, "couldn't get task list participants")
 	}
 
 	return ps, nil
 }
+
+func (d *Datastore) ReadParticipation(taskListID, userID uuid.UUID) (*tasklist.Participation, error) {
+	p := &tasklist.Participation{}
+
+	err := d.db.First(p, "task_list_id = ? AND user_id = ?", taskListID, userID).Error
+	return p, e(err, "couldn't read participation")
+}
+
+// StartParticipation sets the personal time window of a participation that is not started yet.
+// It returns ErrNotFound if there is no such participation.
+func (d *Datastore) StartParticipation(taskListID, userID uuid.UUID, startTime, endTime time.Time) error {
+	result := d.db.Model(&tasklist.Participation{}).
+		Where("task_list_id = ? AND user_id = ? AND start_time IS NULL", taskListID, userID).
+		Updates(map[string]interface{}{"start_time": startTime, "end_time": endTime})
+	if result.Error != nil {
+		return e(result.Error, "couldn't start participation")
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
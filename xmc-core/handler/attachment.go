@@ -207,7 +207,7 @@ func (*AttachmentService) Update(ctx context.Context, req *attachment.UpdateRequ
 			dd.Rollback()
 			return errors.InternalServerError(methodName, e(err))
 		}
-		err = dd.SetAttachmentSize(uuid, int32(len(req.Contents)))
+		err = dd.SetAttachmentContents(uuid, req.Contents)
 		if err != nil {
 			dd.Rollback()
 			return errors.InternalServerError(methodName, e(err))
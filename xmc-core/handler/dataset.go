@@ -10,7 +10,9 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"github.com/micro/go-micro/errors"
+	"github.com/shopspring/decimal"
 	"github.com/xmc-dev/xmc/xmc-core/db"
+	"github.com/xmc-dev/xmc/xmc-core/db/models/problem"
 	"github.com/xmc-dev/xmc/xmc-core/proto/attachment"
 	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 	"github.com/xmc-dev/xmc/xmc-core/proto/searchmeta"
@@ -24,6 +26,106 @@ func datasetSName(method string) string {
 	return fmt.Sprintf("%s.DatasetService.%s", "xmc.srv.core", method)
 }
 
+func validateTestGroups(tgs []*dataset.TestGroup) string {
+	seen := map[int32]bool{}
+	for _, tg := range tgs {
+		if tg.Number <= 0 || seen[tg.Number] {
+			return "invalid test group number"
+		}
+		seen[tg.Number] = true
+
+		w, err := decimal.NewFromString(tg.Weight)
+		if err != nil || w.Sign() < 0 {
+			return "invalid test group weight"
+		}
+	}
+
+	return ""
+}
+
+// validateGrouping checks that a dataset whose scoring policy uses test groups has some
+// and that all of its test cases are in one of them.
+func validateGrouping(sp dataset.ScoringPolicy, tgs []*problem.TestGroup, tcs []*problem.TestCase) string {
+	if !problem.ScoringPolicy(sp).UsesGroups() {
+		return ""
+	}
+	if len(tgs) == 0 {
+		return "the scoring policy requires test groups"
+	}
+	groups := map[int32]bool{}
+	for _, tg := range tgs {
+		groups[tg.Number] = true
+	}
+	for _, tc := range tcs {
+		if !groups[tc.GroupNo] {
+			return fmt.Sprintf("test case #%d is not in a test group", tc.Number)
+		}
+	}
+
+	return ""
+}
+
+// validateTestCaseGroup checks that the test group of a test case of the dataset exists
+// and that the test case is in a group if the scoring policy of the dataset uses them.
+func validateTestCaseGroup(dd *db.Datastore, datasetID uuid.UUID, groupNo int32) (string, error) {
+	d, err := dd.ReadDataset(datasetID)
+	if err != nil {
+		return "", err
+	}
+	if groupNo == 0 {
+		if d.ScoringPolicy.UsesGroups() {
+			return "the scoring policy of the dataset requires a group_no", nil
+		}
+		return "", nil
+	}
+
+	tgs, err := dd.ReadTestGroups(datasetID)
+	if err != nil {
+		return "", err
+	}
+	for _, tg := range tgs {
+		if tg.Number == groupNo {
+			return "", nil
+		}
+	}
+
+	return "test group doesn't exist", nil
+}
+
+func validScoringPolicy(sp dataset.ScoringPolicy) bool {
+	_, ok := dataset.ScoringPolicy_name[int32(sp)]
+	return ok
+}
+
+func validType(t dataset.Type) bool {
+	_, ok := dataset.Type_name[int32(t)]
+	return ok
+}
+
+func validChecker(c dataset.Checker) bool {
+	_, ok := dataset.Checker_name[int32(c)]
+	return ok
+}
+
+// validateChecker checks that the dataset has a grader if and only if it uses the CUSTOM checker
+// and that built-in checkers are used only by batch datasets.
+func validateChecker(c dataset.Checker, epsilon float64, graderID string, t dataset.Type) string {
+	switch {
+	case !validChecker(c):
+		return "invalid checker"
+	case epsilon < 0:
+		return "invalid epsilon"
+	case c == dataset.Checker_CUSTOM && len(graderID) == 0:
+		return "invalid grader_id"
+	case c != dataset.Checker_CUSTOM && len(graderID) > 0:
+		return "grader_id must be empty for built-in checkers"
+	case c != dataset.Checker_CUSTOM && t == dataset.Type_INTERACTIVE:
+		return "interactive datasets must use a custom checker"
+	}
+
+	return ""
+}
+
 func (*DatasetService) Create(ctx context.Context, req *dataset.CreateRequest, rsp *dataset.CreateResponse) error {
 	methodName := datasetSName("Create")
 	switch {
@@ -31,23 +133,47 @@ func (*DatasetService) Create(ctx context.Context, req *dataset.CreateRequest, r
 		return errors.BadRequest(methodName, "missing dataset")
 	case len(req.Dataset.Name) == 0:
 		return errors.BadRequest(methodName, "invalid name")
-	case len(req.Dataset.GraderId) == 0:
-		return errors.BadRequest(methodName, "invalid grader_id")
 	case req.Dataset.MemoryLimit == 0:
 		return errors.BadRequest(methodName, "invalid memory_limit")
 	case req.Dataset.TimeLimit == nil:
 		return errors.BadRequest(methodName, "invalid time_limit")
+	case !validScoringPolicy(req.Dataset.ScoringPolicy):
+		return errors.BadRequest(methodName, "invalid scoring_policy")
+	case !validType(req.Dataset.Type):
+		return errors.BadRequest(methodName, "invalid type")
 	}
 
 	_, err := ptypes.Duration(req.Dataset.TimeLimit)
 	if err != nil {
 		return errors.BadRequest(methodName, "invalid time_limit")
 	}
+	if msg := validateTestGroups(req.Dataset.TestGroups); len(msg) > 0 {
+		return errors.BadRequest(methodName, msg)
+	}
+	if problem.ScoringPolicy(req.Dataset.ScoringPolicy).UsesGroups() && len(req.Dataset.TestGroups) == 0 {
+		return errors.BadRequest(methodName, "the scoring policy requires test groups")
+	}
+	if msg := validateChecker(req.Dataset.Checker, req.Dataset.Epsilon, req.Dataset.GraderId, req.Dataset.Type); len(msg) > 0 {
+		return errors.BadRequest(methodName, msg)
+	}
+	if req.Dataset.Requirements != nil && req.Dataset.Requirements.MinBenchmarkScore < 0 {
+		return errors.BadRequest(methodName, "invalid min_benchmark_score")
+	}
+	if req.Dataset.OutputLimit < 0 {
+		return errors.BadRequest(methodName, "invalid output_limit")
+	}
+	if req.Dataset.IdlenessLimit != nil {
+		if d, err := ptypes.Duration(req.Dataset.IdlenessLimit); err != nil || d < 0 {
+			return errors.BadRequest(methodName, "invalid idleness_limit")
+		}
+	}
 
 	req.Dataset.Name = strings.ToLower(req.Dataset.Name)
 
-	id, err := db.DB.CreateDataset(req.Dataset)
+	dd := db.DB.BeginGroup()
+	id, err := dd.CreateDataset(req.Dataset)
 	if err != nil {
+		dd.Rollback()
 		if err == db.ErrUniqueViolation {
 			return errors.Conflict(methodName, "name must be unique")
 		} else if _, ok := err.(db.ErrHasDependants); ok {
@@ -55,10 +181,34 @@ func (*DatasetService) Create(ctx context.Context, req *dataset.CreateRequest, r
 		}
 		return errors.InternalServerError(methodName, e(err))
 	}
+
+	err = dd.SetTestGroups(id, req.Dataset.TestGroups)
+	if err != nil {
+		dd.Rollback()
+		return errors.InternalServerError(methodName, e(err))
+	}
+
+	if err := dd.Commit(); err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
 	rsp.Id = id.String()
 	return nil
 }
 
+func readTestGroups(datasetID uuid.UUID) ([]*dataset.TestGroup, error) {
+	tgs, err := db.DB.ReadTestGroups(datasetID)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := []*dataset.TestGroup{}
+	for _, tg := range tgs {
+		ts = append(ts, tg.ToProto())
+	}
+
+	return ts, nil
+}
+
 func (*DatasetService) Read(ctx context.Context, req *dataset.ReadRequest, rsp *dataset.ReadResponse) error {
 	methodName := datasetSName("Read")
 	if len(req.Id) == 0 {
@@ -79,6 +229,10 @@ func (*DatasetService) Read(ctx context.Context, req *dataset.ReadRequest, rsp *
 	}
 
 	rsp.Dataset = d.ToProto()
+	rsp.Dataset.TestGroups, err = readTestGroups(d.ID)
+	if err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
 	return nil
 }
 
@@ -98,15 +252,48 @@ func (*DatasetService) Get(ctx context.Context, req *dataset.GetRequest, rsp *da
 	}
 
 	rsp.Dataset = d.ToProto()
+	rsp.Dataset.TestGroups, err = readTestGroups(d.ID)
+	if err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
 	return nil
 }
 
 func (*DatasetService) Update(ctx context.Context, req *dataset.UpdateRequest, rsp *dataset.UpdateResponse) error {
 	methodName := datasetSName("Update")
-	_, err := uuid.Parse(req.Id)
+	id, err := uuid.Parse(req.Id)
 	if err != nil {
 		return errors.BadRequest(methodName, "invalid id")
 	}
+	if req.ScoringPolicy != nil && !validScoringPolicy(req.ScoringPolicy.Value) {
+		return errors.BadRequest(methodName, "invalid scoring_policy")
+	}
+	if req.Type != nil && !validType(req.Type.Value) {
+		return errors.BadRequest(methodName, "invalid type")
+	}
+	if msg := validateTestGroups(req.TestGroups); len(msg) > 0 {
+		return errors.BadRequest(methodName, msg)
+	}
+	if req.ClearTestGroups && len(req.TestGroups) > 0 {
+		return errors.BadRequest(methodName, "test_groups and clear_test_groups can't be used together")
+	}
+	if req.Checker != nil && !validChecker(req.Checker.Value) {
+		return errors.BadRequest(methodName, "invalid checker")
+	}
+	if req.Epsilon != nil && req.Epsilon.Value < 0 {
+		return errors.BadRequest(methodName, "invalid epsilon")
+	}
+	if req.Requirements != nil && req.Requirements.MinBenchmarkScore < 0 {
+		return errors.BadRequest(methodName, "invalid min_benchmark_score")
+	}
+	if req.OutputLimit != nil && req.OutputLimit.Value < 0 {
+		return errors.BadRequest(methodName, "invalid output_limit")
+	}
+	if req.IdlenessLimit != nil {
+		if d, err := ptypes.Duration(req.IdlenessLimit); err != nil || d < 0 {
+			return errors.BadRequest(methodName, "invalid idleness_limit")
+		}
+	}
 
 	dd := db.DB.BeginGroup()
 	if len(req.GraderId) > 0 {
@@ -136,6 +323,32 @@ func (*DatasetService) Update(ctx context.Context, req *dataset.UpdateRequest, r
 		return errors.InternalServerError(methodName, e(err))
 	}
 
+	// the dataset must still be consistent after the update
+	d, err := dd.ReadDataset(id)
+	if err != nil {
+		dd.Rollback()
+		return errors.InternalServerError(methodName, e(err))
+	}
+	ds := d.ToProto()
+	if msg := validateChecker(ds.Checker, ds.Epsilon, ds.GraderId, ds.Type); len(msg) > 0 {
+		dd.Rollback()
+		return errors.BadRequest(methodName, msg)
+	}
+	tgs, err := dd.ReadTestGroups(id)
+	if err != nil {
+		dd.Rollback()
+		return errors.InternalServerError(methodName, e(err))
+	}
+	tcs, _, err := dd.ReadTestCases(id)
+	if err != nil {
+		dd.Rollback()
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if msg := validateGrouping(ds.ScoringPolicy, tgs, tcs); len(msg) > 0 {
+		dd.Rollback()
+		return errors.BadRequest(methodName, msg)
+	}
+
 	if err := dd.Commit(); err != nil {
 		return errors.InternalServerError(methodName, e(err))
 	}
@@ -231,6 +444,8 @@ func (*DatasetService) AddTestCase(ctx context.Context, req *dataset.AddTestCase
 		return errors.BadRequest(methodName, "invalid input")
 	case req.Output == nil:
 		return errors.BadRequest(methodName, "invalid output")
+	case req.GroupNo < 0:
+		return errors.BadRequest(methodName, "invalid group_no")
 	}
 
 	// id is the dataset id
@@ -249,6 +464,18 @@ func (*DatasetService) AddTestCase(ctx context.Context, req *dataset.AddTestCase
 		dd.Rollback()
 		return errors.BadRequest(methodName, "test cases must have consecutive numbers")
 	}
+	msg, err := validateTestCaseGroup(dd, id, req.GroupNo)
+	if err != nil {
+		dd.Rollback()
+		if err == db.ErrNotFound {
+			return errors.BadRequest(methodName, "dataset doesn't exist")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if len(msg) > 0 {
+		dd.Rollback()
+		return errors.BadRequest(methodName, msg)
+	}
 
 	testCaseID, err := dd.CreateTestCase(req)
 	if err != nil {
@@ -348,6 +575,12 @@ func (*DatasetService) UpdateTestCase(ctx context.Context, req *dataset.UpdateTe
 	if err != nil {
 		return errors.BadRequest(methodName, "invalid id")
 	}
+	switch {
+	case req.GroupNo != nil && req.GroupNo.Value <= 0:
+		return errors.BadRequest(methodName, "invalid group_no")
+	case req.GroupNo != nil && req.SetNullGroup:
+		return errors.BadRequest(methodName, "group_no and set_null_group can't be used together")
+	}
 
 	dd := db.DB.BeginGroup()
 	t, err := dd.ReadTestCase(id, req.Number)
@@ -372,6 +605,11 @@ func (*DatasetService) UpdateTestCase(ctx context.Context, req *dataset.UpdateTe
 			dd.Rollback()
 			return errors.InternalServerError(methodName, e(err))
 		}
+		err = dd.SetAttachmentContents(att.ID, req.Input)
+		if err != nil {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
 	}
 
 	if req.Output != nil {
@@ -387,6 +625,32 @@ func (*DatasetService) UpdateTestCase(ctx context.Context, req *dataset.UpdateTe
 			dd.Rollback()
 			return errors.InternalServerError(methodName, e(err))
 		}
+		err = dd.SetAttachmentContents(att.ID, req.Output)
+		if err != nil {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
+	}
+
+	if req.GroupNo != nil || req.SetNullGroup {
+		var groupNo int32
+		if req.GroupNo != nil {
+			groupNo = req.GroupNo.Value
+		}
+		msg, err := validateTestCaseGroup(dd, id, groupNo)
+		if err != nil {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
+		if len(msg) > 0 {
+			dd.Rollback()
+			return errors.BadRequest(methodName, msg)
+		}
+		err = dd.TestCaseSetGroupNo(t.ID, groupNo)
+		if err != nil {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
 	}
 
 	if err := dd.Commit(); err != nil {
@@ -22,6 +22,11 @@ func graderSName(method string) string {
 	return fmt.Sprintf("%s.GraderService.%s", "xmc.srv.core", method)
 }
 
+func validProtocol(p grader.Protocol) bool {
+	_, ok := grader.Protocol_name[int32(p)]
+	return ok
+}
+
 func (*GraderService) Create(ctx context.Context, req *grader.CreateRequest, rsp *grader.CreateResponse) error {
 	methodName := graderSName("Create")
 	switch {
@@ -33,6 +38,8 @@ func (*GraderService) Create(ctx context.Context, req *grader.CreateRequest, rsp
 		return errors.BadRequest(methodName, "invalid language")
 	case len(req.Grader.Name) == 0:
 		return errors.BadRequest(methodName, "invalid name")
+	case !validProtocol(req.Grader.Protocol):
+		return errors.BadRequest(methodName, "invalid protocol")
 	}
 
 	req.Grader.AttachmentId = ""
@@ -120,6 +127,9 @@ func (*GraderService) Update(ctx context.Context, req *grader.UpdateRequest, rsp
 	if err != nil {
 		return errors.BadRequest(methodName, "invalid id")
 	}
+	if req.Protocol != nil && !validProtocol(req.Protocol.Value) {
+		return errors.BadRequest(methodName, "invalid protocol")
+	}
 
 	dd := db.DB.BeginGroup()
 	if req.Code != nil {
@@ -143,6 +153,11 @@ func (*GraderService) Update(ctx context.Context, req *grader.UpdateRequest, rsp
 			dd.Rollback()
 			return errors.InternalServerError(methodName, e(err))
 		}
+		err = dd.SetAttachmentContents(att.ID, req.Code)
+		if err != nil {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
 	}
 
 	err = dd.UpdateGrader(req)
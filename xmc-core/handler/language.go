@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"sort"
+
+	"github.com/micro/go-micro/client"
+	"github.com/xmc-dev/xmc/dispatcher-srv/proto/meta"
+	"github.com/xmc-dev/xmc/xmc-core/common"
+	"github.com/xmc-dev/xmc/xmc-core/proto/language"
+)
+
+type LanguageService struct{}
+
+// languageVersions returns the versions of the languages reported by the evals, which are the
+// ones that have the compilers installed, sorted and without duplicates
+func languageVersions(ctx context.Context) (map[string][]string, error) {
+	client := meta.NewMetaServiceClient("xmc.srv.dispatcher", client.DefaultClient)
+	rsp, err := client.GetEvals(ctx, &meta.GetEvalsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]map[string]bool)
+	versions := make(map[string][]string)
+	for _, ni := range rsp.Evals {
+		for _, l := range ni.Languages {
+			if seen[l.Code] == nil {
+				seen[l.Code] = make(map[string]bool)
+			}
+			if len(l.Version) == 0 || seen[l.Code][l.Version] {
+				continue
+			}
+			seen[l.Code][l.Version] = true
+			versions[l.Code] = append(versions[l.Code], l.Version)
+		}
+	}
+	for _, vs := range versions {
+		sort.Strings(vs)
+	}
+
+	return versions, nil
+}
+
+func (*LanguageService) List(ctx context.Context, req *language.ListRequest, rsp *language.ListResponse) error {
+	versions, err := languageVersions(ctx)
+	if err != nil {
+		// the languages are still listed, without their versions
+		log.WithError(err).Warn("Couldn't read the language versions of the evals")
+	}
+	ls := []*language.Language{}
+	for _, code := range common.Languages() {
+		spec, _ := common.GetLanguage(code)
+		ls = append(ls, &language.Language{
+			Code:             string(code),
+			Name:             spec.Name,
+			Versions:         versions[string(code)],
+			Extensions:       spec.Extensions,
+			TimeMultiplier:   spec.TimeMultiplier,
+			MemoryMultiplier: spec.MemoryMultiplier,
+		})
+	}
+
+	rsp.Languages = ls
+	return nil
+}
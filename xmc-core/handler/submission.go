@@ -13,6 +13,7 @@ import (
 	"github.com/xmc-dev/xmc/dispatcher-srv/proto/job"
 	"github.com/xmc-dev/xmc/xmc-core/common"
 	"github.com/xmc-dev/xmc/xmc-core/db"
+	"github.com/xmc-dev/xmc/xmc-core/db/models/problem"
 	msubmission "github.com/xmc-dev/xmc/xmc-core/db/models/submission"
 	"github.com/xmc-dev/xmc/xmc-core/db/models/tasklist"
 	"github.com/xmc-dev/xmc/xmc-core/proto/attachment"
@@ -38,34 +39,68 @@ func submissionSName(method string) string {
 	return fmt.Sprintf("%s.SubmissionService.%s", "xmc.srv.core", method)
 }
 
-func censorSubmission(s *submission.Submission) {
-	s.AttachmentId = ""
+// censorSubmission removes the result of a submission, leaving the state as it is
+// so that it doesn't tell whether the submission compiled.
+// frozen tells that the result is hidden because the scoreboard of the task list is frozen.
+func censorSubmission(s *submission.Submission, frozen bool) {
 	s.Censored = true
+	s.Frozen = frozen
 	s.Result = nil
 }
 
-func submissionIsPublic(d *db.Datastore, s *msubmission.Submission) (bool, error) {
+// hidePrivateMessages removes the messages that only the admins are allowed to see
+func hidePrivateMessages(s *submission.Submission) {
+	if s.Result == nil {
+		return
+	}
+	for _, tr := range s.Result.TestResults {
+		tr.GraderPrivateMessage = ""
+	}
+}
+
+// submissionVisibility returns whether the submission is public and whether its result
+// is hidden because the scoreboard of its task list is frozen
+func submissionVisibility(d *db.Datastore, s *msubmission.Submission) (public bool, hidden bool, err error) {
 	t, err := d.ReadTask(s.TaskID)
 	if err != nil {
-		return false, err
+		return false, false, err
 	}
 	tl, err := d.ReadTaskList(t.TaskListID)
 	if err != nil {
-		return false, err
+		return false, false, err
+	}
+	return tl.PublicSubmissions, tl.ResultHidden(s.CreatedAt), nil
+}
+
+// priorityClass returns the priority class of the submissions made now to the tasks of the task list.
+// Submissions made while a contest is running are evaluated first.
+func priorityClass(taskList *tasklist.TaskList) job.PriorityClass {
+	if taskList != nil && taskList.StartTime != nil && taskList.EndTime != nil &&
+		timeInRange(*taskList.StartTime, time.Now(), *taskList.EndTime) {
+		return job.PriorityClass_CONTEST_LIVE
 	}
-	return tl.PublicSubmissions, nil
+
+	return job.PriorityClass_PRACTICE
 }
 
-func sendToDispatcher(req *submission.CreateRequest, id, datasetID uuid.UUID, methodName string) error {
+func sendToDispatcher(req *submission.CreateRequest, id, userID uuid.UUID, ds *problem.Dataset, taskList *tasklist.TaskList, methodName string) error {
 	client := job.NewJobsServiceClient("xmc.srv.dispatcher", client.DefaultClient)
+	taskListID := ""
+	if taskList != nil {
+		taskListID = taskList.ID.String()
+	}
 	_, err := client.Create(C(), &job.CreateRequest{
 		Priority: 1,
 		Job: &job.Job{
-			DatasetId:    datasetID.String(),
-			Code:         req.Code,
-			Language:     req.Language,
-			SubmissionId: id.String(),
-			TaskId:       req.TaskId,
+			DatasetId:     ds.ID.String(),
+			Code:          req.Code,
+			Language:      req.Language,
+			SubmissionId:  id.String(),
+			TaskId:        req.TaskId,
+			UserId:        userID.String(),
+			TaskListId:    taskListID,
+			PriorityClass: priorityClass(taskList),
+			Requirements:  ds.Requirements(),
 		},
 	})
 	if err != nil {
@@ -115,6 +150,12 @@ func (*SubmissionService) Create(ctx context.Context, req *submission.CreateRequ
 		}
 	}
 
+	u, err := perms.AccountUUIDFromContext(ctx)
+	if err != nil {
+		dd.Rollback()
+		return errors.InternalServerError(methodName, err.Error())
+	}
+
 	// supreme submission permissions means that you can submit anytime
 	if !perms.HasScope(ctx, "manage/submission") {
 		if taskList == nil || (taskList.StartTime != nil &&
@@ -122,12 +163,20 @@ func (*SubmissionService) Create(ctx context.Context, req *submission.CreateRequ
 			dd.Rollback()
 			return errors.Forbidden(methodName, "task is not currently open for submissions")
 		}
-	}
-
-	u, err := perms.AccountUUIDFromContext(ctx)
-	if err != nil {
-		dd.Rollback()
-		return errors.InternalServerError(methodName, err.Error())
+		if taskList.WithParticipations {
+			p, err := dd.ReadParticipation(taskList.ID, u)
+			if err != nil {
+				dd.Rollback()
+				if err == db.ErrNotFound {
+					return errors.Forbidden(methodName, "you must participate to the task list to submit")
+				}
+				return errors.InternalServerError(methodName, e(err))
+			}
+			if taskList.ParticipationDuration > 0 && (!p.Started() || !timeInRange(*p.StartTime, time.Now(), *p.EndTime)) {
+				dd.Rollback()
+				return errors.Forbidden(methodName, "task is not currently open for submissions in your time window")
+			}
+		}
 	}
 	sb := &submission.Submission{
 		TaskId:   req.TaskId,
@@ -165,12 +214,17 @@ func (*SubmissionService) Create(ctx context.Context, req *submission.CreateRequ
 		dd.Rollback()
 		return errors.InternalServerError(methodName, e(err))
 	}
+	ds, err := dd.ReadDataset(task.DatasetID)
+	if err != nil {
+		dd.Rollback()
+		return errors.InternalServerError(methodName, e(err))
+	}
 
 	if err := dd.Commit(); err != nil {
 		return errors.InternalServerError(methodName, e(err))
 	}
 
-	err = sendToDispatcher(req, id, task.DatasetID, methodName)
+	err = sendToDispatcher(req, id, u, ds, taskList, methodName)
 	if err != nil {
 		return err
 	}
@@ -201,18 +255,16 @@ func (*SubmissionService) Read(ctx context.Context, req *submission.ReadRequest,
 	}
 
 	accID, err := perms.AccountUUIDFromContext(ctx)
-	censor := false
+	censor, hidden := false, false
 	if !perms.HasScope(ctx, "manage/submission") && (err != nil || s.UserID != accID) {
-		ok, err := submissionIsPublic(dd, s)
+		public, h, err := submissionVisibility(dd, s)
 		if err != nil {
 			dd.Rollback()
 			return errors.InternalServerError(methodName, err.Error())
 		}
-		if !ok {
-			censor = true
-		}
+		censor, hidden = !public, h
 	}
-	if !censor && req.IncludeTestResults {
+	if !censor && !hidden && req.IncludeTestResults {
 		trs, err := dd.ReadTestResults(id)
 		if err != nil {
 			dd.Rollback()
@@ -224,13 +276,22 @@ func (*SubmissionService) Read(ctx context.Context, req *submission.ReadRequest,
 			}
 		}
 	}
-	if !censor && req.IncludeResult {
+	if !censor && !hidden && req.IncludeResult {
 		r, err := dd.ReadSubmissionResult(id)
 		if err != nil && err != db.ErrNotFound {
 			dd.Rollback()
 			return errors.InternalServerError(methodName, e(err))
 		} else if err == nil {
-			res = r.ToProto(ts)
+			grs, err := dd.ReadGroupResults(id)
+			if err != nil {
+				dd.Rollback()
+				return errors.InternalServerError(methodName, e(err))
+			}
+			gs := []*result.GroupResult{}
+			for _, gr := range grs {
+				gs = append(gs, gr.ToProto())
+			}
+			res = r.ToProto(ts, gs)
 		}
 	}
 	if res == nil && ts != nil {
@@ -239,8 +300,13 @@ func (*SubmissionService) Read(ctx context.Context, req *submission.ReadRequest,
 		}
 	}
 	sub = s.ToProto(res)
+	if censor || hidden {
+		censorSubmission(sub, hidden)
+	}
 	if censor {
-		censorSubmission(sub)
+		sub.AttachmentId = ""
+	} else if !perms.HasScope(ctx, "manage/submission") {
+		hidePrivateMessages(sub)
 	}
 
 	if err := dd.Commit(); err != nil {
@@ -334,8 +400,10 @@ func (subService *SubmissionService) Search(ctx context.Context, req *submission
 		}
 	}
 
+	manage := perms.HasScope(ctx, "manage/submission")
+	accID, accErr := perms.AccountUUIDFromContext(ctx)
 	dd := db.DB.BeginGroup()
-	ss, cnt, err := dd.SearchSubmission(req)
+	ss, cnt, err := dd.SearchSubmission(req, !manage, accID)
 	if err != nil {
 		dd.Rollback()
 		return errors.InternalServerError(methodName, e(err))
@@ -344,19 +412,21 @@ func (subService *SubmissionService) Search(ctx context.Context, req *submission
 	subs := []*submission.Submission{}
 	for _, s := range ss {
 		// if we do not have the rights and the submission is not public then we censor it
-		var censor bool
+		var censor, hidden bool
 		var err error
-		if !perms.HasScope(ctx, "manage/submission") {
-			censor, err = submissionIsPublic(dd, s)
+		if !manage {
+			censor, hidden, err = submissionVisibility(dd, s)
 			if err != nil {
 				dd.Rollback()
 				return errors.InternalServerError(methodName, err.Error())
 			}
 			censor = !censor
+			// users can see the results of their own submissions
+			hidden = hidden && (accErr != nil || s.UserID != accID)
 		}
 		var r *result.Result
 		var ts []*result.TestResult
-		if !censor && req.IncludeTestResults {
+		if !censor && !hidden && req.IncludeTestResults {
 			trs, err := dd.ReadTestResults(s.ID)
 			if err != nil {
 				dd.Rollback()
@@ -367,13 +437,22 @@ func (subService *SubmissionService) Search(ctx context.Context, req *submission
 				ts = append(ts, tr.ToProto())
 			}
 		}
-		if !censor && req.IncludeResult {
+		if !censor && !hidden && req.IncludeResult {
 			res, err := dd.ReadSubmissionResult(s.ID)
 			if err != nil && err != db.ErrNotFound {
 				dd.Rollback()
 				return errors.InternalServerError(methodName, e(err))
 			} else if err == nil {
-				r = res.ToProto(ts)
+				grs, err := dd.ReadGroupResults(s.ID)
+				if err != nil {
+					dd.Rollback()
+					return errors.InternalServerError(methodName, e(err))
+				}
+				gs := []*result.GroupResult{}
+				for _, gr := range grs {
+					gs = append(gs, gr.ToProto())
+				}
+				r = res.ToProto(ts, gs)
 			}
 		}
 		if req.IncludeTestResults && r == nil {
@@ -382,8 +461,13 @@ func (subService *SubmissionService) Search(ctx context.Context, req *submission
 			}
 		}
 		sub := s.ToProto(r)
+		if censor || hidden {
+			censorSubmission(sub, hidden)
+		}
 		if censor {
-			censorSubmission(sub)
+			sub.AttachmentId = ""
+		} else if !manage {
+			hidePrivateMessages(sub)
 		}
 		subs = append(subs, sub)
 	}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
+	"github.com/micro/go-micro/client"
 	"github.com/micro/go-micro/errors"
+	"github.com/xmc-dev/xmc/account-srv/proto/account"
 	"github.com/xmc-dev/xmc/common/perms"
 	"github.com/xmc-dev/xmc/xmc-core/db"
 	mtasklist "github.com/xmc-dev/xmc/xmc-core/db/models/tasklist"
@@ -17,6 +19,7 @@ import (
 	"github.com/xmc-dev/xmc/xmc-core/proto/searchmeta"
 	"github.com/xmc-dev/xmc/xmc-core/proto/tasklist"
 	"github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
+	"github.com/xmc-dev/xmc/xmc-core/scoreboard"
 	"github.com/xmc-dev/xmc/xmc-core/util"
 )
 
@@ -58,6 +61,42 @@ func validateTimeRange(methodName string, timeRange *tsrange.TimestampRange) err
 	return nil
 }
 
+// validateFreezeTime checks that the freeze time of a task list is in its time range
+func validateFreezeTime(methodName string, freezeTime, startTime, endTime *time.Time) error {
+	if freezeTime == nil {
+		return nil
+	}
+	if startTime == nil || endTime == nil {
+		return errors.BadRequest(methodName, "freeze_time requires a time range")
+	}
+	if freezeTime.Before(*startTime) || freezeTime.After(*endTime) {
+		return errors.BadRequest(methodName, "freeze_time is not in the time range")
+	}
+	return nil
+}
+
+// validateScoringMode checks that a task list in ICPC mode has a time range, from which the penalty times are measured
+func validateScoringMode(methodName string, mode mtasklist.ScoringMode, startTime, endTime *time.Time) error {
+	if mode == mtasklist.ICPC && (startTime == nil || endTime == nil) {
+		return errors.BadRequest(methodName, "ICPC scoring_mode requires a time range")
+	}
+	return nil
+}
+
+// validateParticipationDuration checks that the personal time windows can be used
+func validateParticipationDuration(methodName string, d time.Duration, withParticipations bool, freezeTime *time.Time) error {
+	switch {
+	case d < 0:
+		return errors.BadRequest(methodName, "invalid participation_duration")
+	case d > 0 && !withParticipations:
+		return errors.BadRequest(methodName, "participation_duration requires participations")
+	case d > 0 && freezeTime != nil:
+		// the freeze time is the same for everybody, so it would freeze the windows at different times
+		return errors.BadRequest(methodName, "freeze_time can't be used with participation_duration")
+	}
+	return nil
+}
+
 func (*TaskListService) Create(ctx context.Context, req *tasklist.CreateRequest, rsp *tasklist.CreateResponse) error {
 	methodName := tasklistSName("Create")
 	switch {
@@ -69,6 +108,23 @@ func (*TaskListService) Create(ctx context.Context, req *tasklist.CreateRequest,
 	if err := validateTimeRange(methodName, req.TaskList.TimeRange); err != nil {
 		return err
 	}
+	if _, ok := tasklist.ScoringMode_name[int32(req.TaskList.ScoringMode)]; !ok {
+		return errors.BadRequest(methodName, "invalid scoring_mode")
+	}
+	t := mtasklist.FromProto(req.TaskList)
+	if err := validateFreezeTime(methodName, t.FreezeTime, t.StartTime, t.EndTime); err != nil {
+		return err
+	}
+	if err := validateScoringMode(methodName, t.ScoringMode, t.StartTime, t.EndTime); err != nil {
+		return err
+	}
+	if err := validateParticipationDuration(methodName, t.ParticipationDuration, t.WithParticipations, t.FreezeTime); err != nil {
+		return err
+	}
+	if err := validateTimeRange(methodName, req.TaskList.RegistrationTimeRange); err != nil {
+		return err
+	}
+	req.TaskList.RevealedUntil = nil
 
 	req.TaskList.Id = ""
 	req.TaskList.Name = strings.ToLower(req.TaskList.Name)
@@ -175,10 +231,67 @@ func (*TaskListService) Get(ctx context.Context, req *tasklist.GetRequest, rsp *
 func (*TaskListService) Update(ctx context.Context, req *tasklist.UpdateRequest, rsp *tasklist.UpdateResponse) error {
 	methodName := tasklistSName("Update")
 
-	_, err := uuid.Parse(req.Id)
+	id, err := uuid.Parse(req.Id)
 	if err != nil {
 		return errors.BadRequest(methodName, "invalid id")
 	}
+	if req.ScoringMode != nil {
+		if _, ok := tasklist.ScoringMode_name[int32(req.ScoringMode.Value)]; !ok {
+			return errors.BadRequest(methodName, "invalid scoring_mode")
+		}
+	}
+	if err := validateTimeRange(methodName, req.TimeRange); err != nil {
+		return err
+	}
+	if err := validateTimeRange(methodName, req.RegistrationTimeRange); err != nil {
+		return err
+	}
+	if req.FreezeTime != nil || req.TimeRange != nil || req.SetNullTime || req.ScoringMode != nil ||
+		req.ParticipationDuration != nil || req.WithParticipations != nil {
+		tl, err := db.DB.ReadTaskList(id)
+		if err != nil {
+			if err == db.ErrNotFound {
+				return errors.NotFound(methodName, "task list not found")
+			}
+			return errors.InternalServerError(methodName, e(err))
+		}
+		// the task list after the update
+		t := mtasklist.FromProto(&tasklist.TaskList{
+			TimeRange:             req.TimeRange,
+			FreezeTime:            req.FreezeTime,
+			ParticipationDuration: req.ParticipationDuration,
+		})
+		if req.SetNullTime {
+			tl.StartTime, tl.EndTime = nil, nil
+		}
+		if t.StartTime != nil {
+			tl.StartTime, tl.EndTime = t.StartTime, t.EndTime
+		}
+		if req.SetNullFreezeTime {
+			tl.FreezeTime = nil
+		}
+		if t.FreezeTime != nil {
+			tl.FreezeTime = t.FreezeTime
+		}
+		if req.ParticipationDuration != nil {
+			tl.ParticipationDuration = t.ParticipationDuration
+		}
+		if req.WithParticipations != nil {
+			tl.WithParticipations = req.WithParticipations.Value
+		}
+		if req.ScoringMode != nil {
+			tl.ScoringMode = mtasklist.ScoringMode(req.ScoringMode.Value)
+		}
+		if err := validateFreezeTime(methodName, tl.FreezeTime, tl.StartTime, tl.EndTime); err != nil {
+			return err
+		}
+		if err := validateScoringMode(methodName, tl.ScoringMode, tl.StartTime, tl.EndTime); err != nil {
+			return err
+		}
+		if err := validateParticipationDuration(methodName, tl.ParticipationDuration, tl.WithParticipations, tl.FreezeTime); err != nil {
+			return err
+		}
+	}
 
 	err = db.DB.UpdateTaskList(req)
 	if err != nil {
@@ -283,7 +396,8 @@ func (*TaskListService) Participate(ctx context.Context, req *tasklist.Participa
 	}
 
 	dd := db.DB.BeginGroup()
-	tl, err := dd.ReadTaskList(taskListID)
+	// the lock keeps concurrent participations from going over the maximum number of participants
+	tl, err := dd.ReadTaskListForUpdate(taskListID)
 	if err != nil {
 		dd.Rollback()
 		if err == db.ErrNotFound {
@@ -295,14 +409,45 @@ func (*TaskListService) Participate(ctx context.Context, req *tasklist.Participa
 		dd.Rollback()
 		return errors.BadRequest(methodName, "task list is without participations")
 	}
-	if tl.StartTime != nil {
+	switch {
+	case tl.RegistrationStartTime != nil && tl.RegistrationEndTime != nil:
+		if !tl.RegistrationOpen(time.Now()) {
+			dd.Rollback()
+			return errors.BadRequest(methodName, "task list participation must be made while the registration is open")
+		}
+	// with personal time windows users can participate until the end time
+	case tl.ParticipationDuration > 0:
+		if tl.EndTime != nil && time.Now().After(*tl.EndTime) {
+			dd.Rollback()
+			return errors.BadRequest(methodName, "task list participation must be before end time")
+		}
+	case tl.StartTime != nil:
 		if !time.Now().Before(*tl.StartTime) {
 			dd.Rollback()
 			return errors.BadRequest(methodName, "task list participation must be before start time")
 		}
 	}
+	if tl.MaxParticipants > 0 {
+		_, err := dd.ReadParticipation(taskListID, userID)
+		if err != nil && err != db.ErrNotFound {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
+		// users that already participate can participate again
+		if err == db.ErrNotFound {
+			cnt, err := dd.CountParticipations(taskListID)
+			if err != nil {
+				dd.Rollback()
+				return errors.InternalServerError(methodName, e(err))
+			}
+			if cnt >= tl.MaxParticipants {
+				dd.Rollback()
+				return errors.Forbidden(methodName, "task list has reached the maximum number of participants")
+			}
+		}
+	}
 
-	if err := db.DB.CreateParticipation(taskListID, userID); err != nil {
+	if err := dd.CreateParticipation(taskListID, userID); err != nil {
 		dd.Rollback()
 		if err == db.ErrNotFound {
 			return errors.NotFound(methodName, "task list not found")
@@ -344,7 +489,23 @@ func (*TaskListService) CancelParticipation(ctx context.Context, req *tasklist.C
 		dd.Rollback()
 		return errors.BadRequest(methodName, "task list is without participations")
 	}
-	if tl.StartTime != nil && !time.Now().Before(*tl.StartTime) {
+	if tl.ParticipationDuration > 0 {
+		p, err := dd.ReadParticipation(taskListID, userID)
+		if err != nil && err != db.ErrNotFound {
+			dd.Rollback()
+			return errors.InternalServerError(methodName, e(err))
+		}
+		if err == nil && p.Started() {
+			dd.Rollback()
+			return errors.BadRequest(methodName, "task list participation cancel must be made before starting it")
+		}
+	}
+	if tl.RegistrationStartTime != nil && tl.RegistrationEndTime != nil {
+		if !tl.RegistrationOpen(time.Now()) {
+			dd.Rollback()
+			return errors.BadRequest(methodName, "task list participation cancel must be made while the registration is open")
+		}
+	} else if tl.ParticipationDuration == 0 && tl.StartTime != nil && !time.Now().Before(*tl.StartTime) {
 		dd.Rollback()
 		return errors.BadRequest(methodName, "task list participation cancel must be made before start time")
 	}
@@ -381,6 +542,321 @@ func (*TaskListService) GetParticipants(ctx context.Context, req *tasklist.GetPa
 	for _, p := range parts {
 		rsp.UserIds = append(rsp.UserIds, p.UserID.String())
 	}
+	if len(parts) == 0 {
+		return nil
+	}
+
+	// the participants are listed without their names if the accounts can't be read
+	accounts, err := readAccounts(ctx, rsp.UserIds)
+	if err != nil {
+		log.WithError(err).WithField("task_list_id", taskListID).Warn("Couldn't read the accounts of the participants")
+	}
+	for _, p := range parts {
+		pp := p.ToProto()
+		pt := &tasklist.Participant{
+			UserId:       pp.UserId,
+			RegisteredAt: pp.RegisteredAt,
+			StartTime:    pp.StartTime,
+			EndTime:      pp.EndTime,
+		}
+		if acc, ok := accounts[pp.UserId]; ok {
+			pt.ClientId = acc.ClientId
+			pt.Name = acc.Name
+		}
+		rsp.Participants = append(rsp.Participants, pt)
+	}
+
+	return nil
+}
+
+// readAccounts returns the accounts with the given uuids by their uuid
+func readAccounts(ctx context.Context, uuids []string) (map[string]*account.Account, error) {
+	client := account.NewAccountsServiceClient("xmc.srv.account", client.DefaultClient)
+	rsp, err := client.Search(ctx, &account.SearchRequest{
+		Limit: uint32(len(uuids)),
+		Uuids: uuids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	accounts := make(map[string]*account.Account)
+	for _, acc := range rsp.Accounts {
+		accounts[acc.Uuid] = acc
+	}
+
+	return accounts, nil
+}
+
+func (*TaskListService) StartParticipation(ctx context.Context, req *tasklist.StartParticipationRequest, rsp *tasklist.StartParticipationResponse) error {
+	methodName := tasklistSName("StartParticipation")
+
+	taskListID, err := uuid.Parse(req.TaskListId)
+	if err != nil {
+		return errors.BadRequest(methodName, "invalid task_list_id")
+	}
+	userID, err := perms.AccountUUIDFromContext(ctx)
+	if err == perms.ErrMissingToken {
+		return errors.Forbidden(methodName, "you must be logged in to participate")
+	} else if err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
+
+	dd := db.DB.BeginGroup()
+	tl, err := dd.ReadTaskList(taskListID)
+	if err != nil {
+		dd.Rollback()
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "task list not found")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if tl.ParticipationDuration <= 0 {
+		dd.Rollback()
+		return errors.BadRequest(methodName, "task list is without personal time windows")
+	}
+	now := time.Now()
+	if tl.StartTime != nil && tl.EndTime != nil && !timeInRange(*tl.StartTime, now, *tl.EndTime) {
+		dd.Rollback()
+		return errors.BadRequest(methodName, "task list participation must be started between start time and end time")
+	}
+	p, err := dd.ReadParticipation(taskListID, userID)
+	if err != nil {
+		dd.Rollback()
+		if err == db.ErrNotFound {
+			return errors.Forbidden(methodName, "you are not participating to the task list")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if p.Started() {
+		dd.Rollback()
+		return errors.Conflict(methodName, "task list participation is already started")
+	}
+
+	end := now.Add(tl.ParticipationDuration)
+	if tl.EndTime != nil && end.After(*tl.EndTime) {
+		end = *tl.EndTime
+	}
+	if err := dd.StartParticipation(taskListID, userID, now, end); err != nil {
+		dd.Rollback()
+		if err == db.ErrNotFound {
+			return errors.Conflict(methodName, "task list participation is already started")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if err := dd.Commit(); err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
+	p.StartTime, p.EndTime = &now, &end
+	rsp.Participation = p.ToProto()
+
+	return nil
+}
+
+func (*TaskListService) ReadParticipation(ctx context.Context, req *tasklist.ReadParticipationRequest, rsp *tasklist.ReadParticipationResponse) error {
+	methodName := tasklistSName("ReadParticipation")
+
+	taskListID, err := uuid.Parse(req.TaskListId)
+	if err != nil {
+		return errors.BadRequest(methodName, "invalid task_list_id")
+	}
+	userID, err := perms.AccountUUIDFromContext(ctx)
+	if err == perms.ErrMissingToken {
+		return errors.Forbidden(methodName, "you must be logged in to participate")
+	} else if err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
+
+	p, err := db.DB.ReadParticipation(taskListID, userID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "participation not found")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	rsp.Participation = p.ToProto()
+
+	return nil
+}
+
+func (*TaskListService) GetScoreboard(ctx context.Context, req *tasklist.GetScoreboardRequest, rsp *tasklist.GetScoreboardResponse) error {
+	methodName := tasklistSName("GetScoreboard")
+
+	taskListID, err := uuid.Parse(req.TaskListId)
+	if err != nil {
+		return errors.BadRequest(methodName, "invalid task_list_id")
+	}
+	if req.Limit == 0 {
+		req.Limit = 10
+	} else if req.Limit > 250 {
+		req.Limit = 250
+	}
+
+	tl, err := db.DB.ReadTaskList(taskListID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "task list not found")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	tasks, err := db.DB.TaskListTasks(taskListID)
+	if err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
+	var participants []uuid.UUID
+	var userStarts map[uuid.UUID]time.Time
+	windows := make(map[uuid.UUID]*mtasklist.Participation)
+	if tl.WithParticipations {
+		parts, err := db.DB.GetTaskListParticipants(taskListID)
+		if err != nil {
+			return errors.InternalServerError(methodName, e(err))
+		}
+		participants = []uuid.UUID{}
+		for _, p := range parts {
+			if tl.ParticipationDuration > 0 {
+				// with personal time windows only the participants that started are ranked
+				if !p.Started() {
+					continue
+				}
+				windows[p.UserID] = p
+			}
+			participants = append(participants, p.UserID)
+		}
+	}
+	var start, end time.Time
+	if tl.StartTime != nil && tl.EndTime != nil {
+		start, end = *tl.StartTime, *tl.EndTime
+	}
+	subs, err := db.DB.ScoreboardSubmissions(taskListID, start, end)
+	if err != nil {
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if tl.ParticipationDuration > 0 {
+		userStarts = make(map[uuid.UUID]time.Time)
+		for _, p := range windows {
+			userStarts[p.UserID] = *p.StartTime
+		}
+		inWindow := subs[:0]
+		for _, s := range subs {
+			if p, ok := windows[s.UserID]; ok && timeInRange(*p.StartTime, s.CreatedAt, *p.EndTime) {
+				inWindow = append(inWindow, s)
+			}
+		}
+		subs = inWindow
+	}
+	// the scoreboard shows only scores, so everyone can see it, but not the frozen ones
+	if !perms.HasScope(ctx, "manage/submission") {
+		for _, s := range subs {
+			s.Hidden = tl.ResultHidden(s.CreatedAt)
+			rsp.Frozen = rsp.Frozen || s.Hidden
+		}
+	}
+
+	taskIDs := []uuid.UUID{}
+	for _, t := range tasks {
+		taskIDs = append(taskIDs, t.ID)
+		rsp.Tasks = append(rsp.Tasks, &tasklist.ScoreboardTask{
+			Id:    t.ID.String(),
+			Name:  t.Name,
+			Title: t.Title,
+		})
+	}
+	mode := scoreboard.MaxScore
+	switch tasklist.ScoringMode(tl.ScoringMode) {
+	case tasklist.ScoringMode_IOI_LAST_SCORE:
+		mode = scoreboard.LastScore
+	case tasklist.ScoringMode_ICPC:
+		mode = scoreboard.ICPC
+	}
+	entries := scoreboard.Compute(mode, taskIDs, participants, subs, start, userStarts)
+
+	total := uint32(len(entries))
+	if req.Offset < total {
+		entries = entries[req.Offset:]
+	} else {
+		entries = nil
+	}
+	if uint32(len(entries)) > req.Limit {
+		entries = entries[:req.Limit]
+	}
+	for _, en := range entries {
+		rsp.Entries = append(rsp.Entries, scoreboardEntryToProto(en))
+	}
+	rsp.Meta = &searchmeta.Meta{
+		PerPage: req.Limit,
+		Count:   uint32(len(rsp.Entries)),
+		Total:   total,
+	}
+
+	return nil
+}
+
+func scoreboardEntryToProto(en *scoreboard.Entry) *tasklist.ScoreboardEntry {
+	pe := &tasklist.ScoreboardEntry{
+		Rank:    uint32(en.Rank),
+		UserId:  en.UserID.String(),
+		Total:   en.Total.String(),
+		Time:    ptypes.DurationProto(en.Time),
+		Penalty: uint32(en.Penalty),
+	}
+	for _, ts := range en.TaskScores {
+		pts := &tasklist.TaskScore{
+			TaskId:   ts.TaskID.String(),
+			Score:    ts.Score.String(),
+			Attempts: uint32(ts.Attempts),
+		}
+		pts.Pending = uint32(ts.Pending)
+		if ts.SubmissionID != uuid.Nil {
+			pts.Time = ptypes.DurationProto(ts.Time)
+			pts.SubmissionId = ts.SubmissionID.String()
+		}
+		pe.TaskScores = append(pe.TaskScores, pts)
+	}
+
+	return pe
+}
+
+func (*TaskListService) RevealScoreboard(ctx context.Context, req *tasklist.RevealScoreboardRequest, rsp *tasklist.RevealScoreboardResponse) error {
+	methodName := tasklistSName("RevealScoreboard")
+
+	taskListID, err := uuid.Parse(req.TaskListId)
+	if err != nil {
+		return errors.BadRequest(methodName, "invalid task_list_id")
+	}
+	step, err := ptypes.Duration(req.Step)
+	if err != nil && req.Step != nil {
+		return errors.BadRequest(methodName, "invalid step")
+	}
+	if !perms.HasScope(ctx, "manage/submission") {
+		return errors.Forbidden(methodName, "you are not allowed to reveal the scoreboard")
+	}
+
+	tl, err := db.DB.ReadTaskList(taskListID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "task list not found")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	if tl.FreezeTime == nil || tl.EndTime == nil {
+		return errors.BadRequest(methodName, "task list is not frozen")
+	}
+
+	revealedUntil := *tl.FreezeTime
+	if tl.RevealedUntil != nil {
+		revealedUntil = *tl.RevealedUntil
+	}
+	revealedUntil = revealedUntil.Add(step)
+	if step <= 0 || revealedUntil.After(*tl.EndTime) {
+		revealedUntil = *tl.EndTime
+	}
+	err = db.DB.SetTaskListRevealedUntil(taskListID, revealedUntil)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NotFound(methodName, "task list not found")
+		}
+		return errors.InternalServerError(methodName, e(err))
+	}
+	rsp.RevealedUntil, _ = ptypes.TimestampProto(revealedUntil)
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	merrors "github.com/micro/go-micro/errors"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -20,6 +21,15 @@ type DatasetSpec struct {
 	TimeLimit   time.Duration
 	TestCases   []*TestCaseSpec
 
+	ScoringPolicy dataset.ScoringPolicy
+	TestGroups    []*dataset.TestGroup
+	Type          dataset.Type
+	Checker       dataset.Checker
+	Epsilon       float64
+	Requirements  *dataset.NodeRequirements
+	OutputLimit   int64
+	IdlenessLimit time.Duration
+
 	graderID  string
 	datasetID string
 }
@@ -28,6 +38,7 @@ type DatasetSpec struct {
 type TestCaseSpec struct {
 	DatasetID string
 	Number    int32
+	GroupNo   int32
 	Input     []byte
 	Output    []byte
 }
@@ -41,7 +52,8 @@ func datasetClient() dataset.DatasetServiceClient {
 }
 
 func (ds *DatasetSpec) getGraderID() error {
-	if len(ds.graderID) > 0 {
+	// datasets with built-in checkers have no grader
+	if len(ds.graderID) > 0 || len(ds.GraderName) == 0 {
 		return nil
 	}
 
@@ -139,6 +151,15 @@ func (ds *DatasetSpec) Import() error {
 				Description: ds.Description,
 				MemoryLimit: ds.MemoryLimit,
 				TimeLimit:   ptypes.DurationProto(ds.TimeLimit),
+
+				ScoringPolicy: ds.ScoringPolicy,
+				TestGroups:    ds.TestGroups,
+				Type:          ds.Type,
+				Checker:       ds.Checker,
+				Epsilon:       ds.Epsilon,
+				Requirements:  ds.Requirements,
+				OutputLimit:   ds.OutputLimit,
+				IdlenessLimit: ptypes.DurationProto(ds.IdlenessLimit),
 			},
 		})
 		if err != nil {
@@ -164,6 +185,16 @@ func (ds *DatasetSpec) Import() error {
 				Id:          ds.datasetID,
 				Description: ds.Description,
 				GraderId:    ds.graderID,
+
+				ScoringPolicy:   &dataset.ScoringPolicyValue{Value: ds.ScoringPolicy},
+				TestGroups:      ds.TestGroups,
+				ClearTestGroups: len(ds.TestGroups) == 0,
+				Type:            &dataset.TypeValue{Value: ds.Type},
+				Checker:         &dataset.CheckerValue{Value: ds.Checker},
+				Epsilon:         &wrappers.DoubleValue{Value: ds.Epsilon},
+				Requirements:    ds.Requirements,
+				OutputLimit:     &wrappers.Int64Value{Value: ds.OutputLimit},
+				IdlenessLimit:   ptypes.DurationProto(ds.IdlenessLimit),
 			})
 			if err != nil {
 				return errors.Wrapf(merrors.Parse(err.Error()), "importer: failed to update dataset %s", ds.Name)
@@ -206,10 +237,11 @@ func (tcs *TestCaseSpec) Import() error {
 	if isNew {
 		log.Info("Test case is new, going to be added to the dataset")
 		_, err = client.AddTestCase(context.TODO(), &dataset.AddTestCaseRequest{
-			Id:     tcs.DatasetID,
-			Number: tcs.Number,
-			Input:  tcs.Input,
-			Output: tcs.Output,
+			Id:      tcs.DatasetID,
+			Number:  tcs.Number,
+			GroupNo: tcs.GroupNo,
+			Input:   tcs.Input,
+			Output:  tcs.Output,
 		})
 		if err != nil {
 			return errors.Wrapf(merrors.Parse(err.Error()), "importer: failed to add test case #%d to dataset %s", tcs.Number, tcs.DatasetID)
@@ -222,12 +254,18 @@ func (tcs *TestCaseSpec) Import() error {
 		}
 		if needsUpdate {
 			log.Info("Test case needs update, going to be updated")
-			_, err = client.UpdateTestCase(context.TODO(), &dataset.UpdateTestCaseRequest{
+			req := &dataset.UpdateTestCaseRequest{
 				Id:     tcs.DatasetID,
 				Number: tcs.Number,
 				Input:  tcs.Input,
 				Output: tcs.Output,
-			})
+			}
+			if tcs.GroupNo > 0 {
+				req.GroupNo = &wrappers.Int32Value{Value: tcs.GroupNo}
+			} else {
+				req.SetNullGroup = true
+			}
+			_, err = client.UpdateTestCase(context.TODO(), req)
 			if err != nil {
 				return errors.Wrapf(merrors.Parse(err.Error()), "importer: failed to update test case #%d to dataset %s", tcs.Number, tcs.DatasetID)
 			}
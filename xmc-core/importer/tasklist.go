@@ -21,8 +21,15 @@ type TaskListSpec struct {
 	Title              string
 	PublicSubmissions  bool
 	WithParticipations bool
+	ScoringMode        tasklist.ScoringMode
 	StartTime          *time.Time
 	EndTime            *time.Time
+	FreezeTime         *time.Time
+	// ParticipationDuration is the length of the personal time windows, zero if there are none
+	ParticipationDuration time.Duration
+	RegistrationStartTime *time.Time
+	RegistrationEndTime   *time.Time
+	MaxParticipants       uint32
 
 	taskListID string
 }
@@ -83,6 +90,8 @@ func (tls *TaskListSpec) Import() error {
 				Title:              tls.Title,
 				PublicSubmissions:  tls.PublicSubmissions,
 				WithParticipations: tls.WithParticipations,
+				ScoringMode:        tls.ScoringMode,
+				MaxParticipants:    tls.MaxParticipants,
 			},
 		}
 		if tls.StartTime != nil && tls.EndTime != nil {
@@ -93,6 +102,20 @@ func (tls *TaskListSpec) Import() error {
 				End:   et,
 			}
 		}
+		if tls.FreezeTime != nil {
+			req.TaskList.FreezeTime, _ = ptypes.TimestampProto(*tls.FreezeTime)
+		}
+		if tls.ParticipationDuration > 0 {
+			req.TaskList.ParticipationDuration = ptypes.DurationProto(tls.ParticipationDuration)
+		}
+		if tls.RegistrationStartTime != nil && tls.RegistrationEndTime != nil {
+			st, _ := ptypes.TimestampProto(*tls.RegistrationStartTime)
+			et, _ := ptypes.TimestampProto(*tls.RegistrationEndTime)
+			req.TaskList.RegistrationTimeRange = &tsrange.TimestampRange{
+				Begin: st,
+				End:   et,
+			}
+		}
 		_, err := client.Create(context.TODO(), req)
 		if err != nil {
 			return errors.Wrapf(merrors.Parse(err.Error()), "importer: failed to create task list %s", tls.Name)
@@ -105,11 +128,13 @@ func (tls *TaskListSpec) Import() error {
 		}
 		if needsUpdate {
 			req := &tasklist.UpdateRequest{
+				Id:                 tls.taskListID,
 				Name:               tls.Name,
 				Description:        tls.Description,
 				Title:              tls.Title,
 				PublicSubmissions:  &wrappers.BoolValue{Value: tls.PublicSubmissions},
 				WithParticipations: &wrappers.BoolValue{Value: tls.WithParticipations},
+				ScoringMode:        &tasklist.ScoringModeValue{Value: tls.ScoringMode},
 			}
 			if tls.StartTime != nil && tls.EndTime != nil {
 				st, _ := ptypes.TimestampProto(*tls.StartTime)
@@ -119,6 +144,24 @@ func (tls *TaskListSpec) Import() error {
 					End:   et,
 				}
 			}
+			if tls.FreezeTime != nil {
+				req.FreezeTime, _ = ptypes.TimestampProto(*tls.FreezeTime)
+			} else {
+				req.SetNullFreezeTime = true
+			}
+			req.ParticipationDuration = ptypes.DurationProto(tls.ParticipationDuration)
+			if tls.RegistrationStartTime != nil && tls.RegistrationEndTime != nil {
+				st, _ := ptypes.TimestampProto(*tls.RegistrationStartTime)
+				et, _ := ptypes.TimestampProto(*tls.RegistrationEndTime)
+				req.RegistrationTimeRange = &tsrange.TimestampRange{
+					Begin: st,
+					End:   et,
+				}
+			} else {
+				req.SetNullRegistrationTime = true
+			}
+			req.MaxParticipants = &wrappers.UInt32Value{Value: tls.MaxParticipants}
+			_, err := client.Update(context.TODO(), req)
 			if err != nil {
 				return errors.Wrapf(merrors.Parse(err.Error()), "importer: failed to update task list %s", tls.Name)
 			}
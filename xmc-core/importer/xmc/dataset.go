@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/xmc-dev/xmc/xmc-core/importer"
+	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 	yaml "gopkg.in/yaml.v2"
 )
 
@@ -31,11 +33,41 @@ import (
 //	grader_name: example_grader
 //	memory_limit: 1024
 //	time_limit: 1.23s
+//	type: batch
+//	scoring_policy: group_min
+//	test_groups:
+//	  - weight: 40
+//	    tests: [1, 2, 3]
+//	  - weight: 60
+//	    tests: [4, 5]
 //
 // The dataset.yaml file must be a valid YAML file. The memory limit is expressed in bytes
 // and the time limit is in the format accepted by Go's library function time.ParseDuration.
 // In short, it is a sequence of integers, each with an optional fraction and a unit suffix.
 // Unit suffixes are "ns", "us", "ms", "s", "m", "h".
+//
+// The type can be "batch" (the default) or "interactive". In interactive datasets
+// the grader is the interactor, which talks with the user program through its stdin and stdout.
+//
+// The scoring_policy and test_groups fields are optional. The scoring policy can be
+// "average" (the default), "group_min" or "group_all_or_nothing". The test groups are numbered
+// from 1 in the order they are listed and each test case can be in at most one group.
+//
+// Instead of grader_name, batch datasets can set checker to one of the built-in checkers:
+// "tokens", "lines", "float_absolute", "float_relative" or "unordered_lines".
+// The floating-point checkers use the optional epsilon field as their tolerance.
+//
+// The optional requirements field restricts the eval nodes that evaluate the submissions:
+//
+//	requirements:
+//	  labels:
+//	    pool: contest
+//	  min_benchmark_score: 1000
+//	  cpu_model: Intel(R) Xeon(R) CPU E5-2680 v4 @ 2.40GHz
+//
+// The optional output_limit field is the maximum size in bytes of the output of the user program
+// and idleness_limit, in the same format as time_limit, is how long the user program may run
+// without using the CPU. If they are not set, the evals use their defaults.
 type DatasetImporter struct {
 }
 
@@ -44,6 +76,27 @@ type internalDatasetSpec struct {
 	Description string `yaml:"description"`
 	MemoryLimit int32  `yaml:"memory_limit"`
 	TimeLimit   string `yaml:"time_limit"`
+
+	Checker       string                  `yaml:"checker"`
+	Epsilon       float64                 `yaml:"epsilon"`
+	Type          string                  `yaml:"type"`
+	ScoringPolicy string                  `yaml:"scoring_policy"`
+	TestGroups    []internalTestGroupSpec `yaml:"test_groups"`
+
+	Requirements  *internalRequirementsSpec `yaml:"requirements"`
+	OutputLimit   int64                     `yaml:"output_limit"`
+	IdlenessLimit string                    `yaml:"idleness_limit"`
+}
+
+type internalRequirementsSpec struct {
+	Labels            map[string]string `yaml:"labels"`
+	MinBenchmarkScore float64           `yaml:"min_benchmark_score"`
+	CPUModel          string            `yaml:"cpu_model"`
+}
+
+type internalTestGroupSpec struct {
+	Weight float64 `yaml:"weight"`
+	Tests  []int32 `yaml:"tests"`
 }
 
 func NewDatasetImporter() *DatasetImporter {
@@ -151,7 +204,62 @@ func (di *DatasetImporter) ReadDataset(fp string) (*importer.DatasetSpec, error)
 		return nil, errors.Wrapf(err, "xmc-dataset-importer: couldn't parse time limit '%s'", is.TimeLimit)
 	}
 
+	if len(is.IdlenessLimit) > 0 {
+		ds.IdlenessLimit, err = time.ParseDuration(is.IdlenessLimit)
+		if err != nil {
+			return nil, errors.Wrapf(err, "xmc-dataset-importer: couldn't parse idleness limit '%s'", is.IdlenessLimit)
+		}
+	}
+	ds.OutputLimit = is.OutputLimit
+
+	t, ok := dataset.Type_value[strings.ToUpper(is.Type)]
+	if len(is.Type) > 0 && !ok {
+		return nil, errors.New("xmc-dataset-importer: invalid type " + is.Type)
+	}
+	ds.Type = dataset.Type(t)
+
+	c, ok := dataset.Checker_value[strings.ToUpper(is.Checker)]
+	if len(is.Checker) > 0 && !ok {
+		return nil, errors.New("xmc-dataset-importer: invalid checker " + is.Checker)
+	}
+	ds.Checker = dataset.Checker(c)
+	ds.Epsilon = is.Epsilon
+	ds.Requirements = &dataset.NodeRequirements{}
+	if is.Requirements != nil {
+		ds.Requirements = &dataset.NodeRequirements{
+			Labels:            is.Requirements.Labels,
+			MinBenchmarkScore: is.Requirements.MinBenchmarkScore,
+			CpuModel:          is.Requirements.CPUModel,
+		}
+	}
+
+	sp, ok := dataset.ScoringPolicy_value[strings.ToUpper(is.ScoringPolicy)]
+	if len(is.ScoringPolicy) > 0 && !ok {
+		return nil, errors.New("xmc-dataset-importer: invalid scoring policy " + is.ScoringPolicy)
+	}
+	ds.ScoringPolicy = dataset.ScoringPolicy(sp)
+
 	ds.TestCases, err = di.readTestCases(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, tg := range is.TestGroups {
+		no := int32(i + 1)
+		ds.TestGroups = append(ds.TestGroups, &dataset.TestGroup{
+			Number: no,
+			Weight: decimal.NewFromFloat(tg.Weight).String(),
+		})
+		for _, t := range tg.Tests {
+			if t < 1 || int(t) > len(ds.TestCases) {
+				return nil, errors.Errorf("xmc-dataset-importer: test group #%d has non-existent test #%d", no, t)
+			}
+			if ds.TestCases[t-1].GroupNo != 0 {
+				return nil, errors.Errorf("xmc-dataset-importer: test #%d is in more than one test group", t)
+			}
+			ds.TestCases[t-1].GroupNo = no
+		}
+	}
 
-	return ds, err
+	return ds, nil
 }
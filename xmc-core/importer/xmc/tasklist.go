@@ -13,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/xmc-dev/xmc/xmc-core/importer"
+	"github.com/xmc-dev/xmc/xmc-core/proto/tasklist"
 	"gopkg.in/yaml.v2"
 )
 
@@ -28,20 +29,41 @@ import (
 //	title: Title of its page
 //	public_submissions: false
 //	with_participations: false
+//	scoring_mode: icpc # optional
 //	start_time: 2018-07-20T11:25:40+02:00 # optional
 //  end_time: 2018-07-20T13:25:40+02:00 # required only if start_time is present
+//	freeze_time: 2018-07-20T12:25:40+02:00 # optional, requires start_time
+//	participation_duration: 1h # optional, requires with_participations
+//	registration_start_time: 2018-07-13T11:25:40+02:00 # optional
+//	registration_end_time: 2018-07-20T11:25:40+02:00 # required only if registration_start_time is present
+//	max_participants: 100 # optional
 //
-// The tasklist.yaml must be a valid YAML file.
+// The tasklist.yaml must be a valid YAML file. The scoring mode can be "ioi_max_score" (the default),
+// "ioi_last_score" or "icpc". From the freeze time until the end time the results
+// are hidden from the users that don't manage submissions.
+//
+// If participation_duration is set, each participant has their own time window of that
+// length, which begins when they start their participation. It is in the format accepted
+// by Go's library function time.ParseDuration.
+//
+// The users can participate only between the registration start and end times, if they are present,
+// and only while there are less than max_participants participants, if it is not zero.
 type TaskListImporter struct {
 }
 
 type internalTaskListSpec struct {
-	Description        string    `yaml:"description"`
-	Title              string    `yaml:"title"`
-	PublicSubmissions  bool      `yaml:"public_submissions"`
-	WithParticipations bool      `yaml:"with_participations"`
-	StartTime          time.Time `yaml:"start_time"`
-	EndTime            time.Time `yaml:"end_time"`
+	Description           string    `yaml:"description"`
+	Title                 string    `yaml:"title"`
+	PublicSubmissions     bool      `yaml:"public_submissions"`
+	WithParticipations    bool      `yaml:"with_participations"`
+	ScoringMode           string    `yaml:"scoring_mode"`
+	StartTime             time.Time `yaml:"start_time"`
+	EndTime               time.Time `yaml:"end_time"`
+	FreezeTime            time.Time `yaml:"freeze_time"`
+	ParticipationDuration string    `yaml:"participation_duration"`
+	RegistrationStartTime time.Time `yaml:"registration_start_time"`
+	RegistrationEndTime   time.Time `yaml:"registration_end_time"`
+	MaxParticipants       uint32    `yaml:"max_participants"`
 }
 
 func NewTaskListImporter() *TaskListImporter {
@@ -76,6 +98,11 @@ func (tli *TaskListImporter) ReadTaskList(fp string) (*importer.TaskListSpec, er
 	tls.Title = is.Title
 	tls.PublicSubmissions = is.PublicSubmissions
 	tls.WithParticipations = is.WithParticipations
+	sm, ok := tasklist.ScoringMode_value[strings.ToUpper(is.ScoringMode)]
+	if len(is.ScoringMode) > 0 && !ok {
+		return nil, errors.New("xmc-task-list-importer: invalid scoring mode " + is.ScoringMode)
+	}
+	tls.ScoringMode = tasklist.ScoringMode(sm)
 	if !is.StartTime.IsZero() {
 		if is.EndTime.IsZero() {
 			return nil, errors.New("xmc-task-list-importer: start_time present without an end_time")
@@ -83,6 +110,26 @@ func (tli *TaskListImporter) ReadTaskList(fp string) (*importer.TaskListSpec, er
 		tls.StartTime = &is.StartTime
 		tls.EndTime = &is.EndTime
 	}
+	if !is.RegistrationStartTime.IsZero() {
+		if is.RegistrationEndTime.IsZero() {
+			return nil, errors.New("xmc-task-list-importer: registration_start_time present without a registration_end_time")
+		}
+		tls.RegistrationStartTime = &is.RegistrationStartTime
+		tls.RegistrationEndTime = &is.RegistrationEndTime
+	}
+	tls.MaxParticipants = is.MaxParticipants
+	if len(is.ParticipationDuration) > 0 {
+		tls.ParticipationDuration, err = time.ParseDuration(is.ParticipationDuration)
+		if err != nil {
+			return nil, errors.Wrapf(err, "xmc-task-list-importer: couldn't parse participation duration '%s'", is.ParticipationDuration)
+		}
+	}
+	if !is.FreezeTime.IsZero() {
+		if is.StartTime.IsZero() {
+			return nil, errors.New("xmc-task-list-importer: freeze_time present without a start_time")
+		}
+		tls.FreezeTime = &is.FreezeTime
+	}
 	fmt.Println("!!tls ", tls)
 
 	return tls, nil
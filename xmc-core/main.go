@@ -7,11 +7,13 @@ import (
 	"github.com/x-cray/logrus-prefixed-formatter"
 	"github.com/xmc-dev/xmc/common/perms"
 	"github.com/xmc-dev/xmc/common/wait"
+	"github.com/xmc-dev/xmc/xmc-core/common"
 	"github.com/xmc-dev/xmc/xmc-core/db"
 	"github.com/xmc-dev/xmc/xmc-core/handler"
 	"github.com/xmc-dev/xmc/xmc-core/proto/attachment"
 	"github.com/xmc-dev/xmc/xmc-core/proto/dataset"
 	"github.com/xmc-dev/xmc/xmc-core/proto/grader"
+	"github.com/xmc-dev/xmc/xmc-core/proto/language"
 	"github.com/xmc-dev/xmc/xmc-core/proto/page"
 	"github.com/xmc-dev/xmc/xmc-core/proto/submission"
 	"github.com/xmc-dev/xmc/xmc-core/proto/task"
@@ -51,6 +53,12 @@ func main() {
 		logrus.SetLevel(logrus.InfoLevel)
 	}
 
+	if len(srv.LanguagesFile) > 0 {
+		if err := common.LoadLanguages(srv.LanguagesFile); err != nil {
+			log.WithError(err).Fatal("Couldn't load languages")
+		}
+	}
+
 	dbLog := log.WithFields(logrus.Fields{
 		"database_url": srv.DBURL,
 	})
@@ -78,6 +86,7 @@ func main() {
 	submission.RegisterSubmissionServiceHandler(srv.Micro.Server(), &handler.SubmissionService{})
 	page.RegisterPageServiceHandler(srv.Micro.Server(), &handler.PageService{})
 	tasklist.RegisterTaskListServiceHandler(srv.Micro.Server(), &handler.TaskListService{})
+	language.RegisterLanguageServiceHandler(srv.Micro.Server(), &handler.LanguageService{})
 
 	if err := srv.Micro.Run(); err != nil {
 		log.Fatal("Couldn't run service: ", err)
@@ -59,6 +59,8 @@ type Attachment struct {
 	CreatedAt   *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
 	UpdatedAt   *google_protobuf.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
 	IsPublic    bool                       `protobuf:"varint,9,opt,name=is_public,json=isPublic" json:"is_public,omitempty"`
+	// the hex encoded SHA-256 of the contents, empty for the attachments uploaded before it was recorded
+	Sha256 string `protobuf:"bytes,10,opt,name=sha256" json:"sha256,omitempty"`
 }
 
 func (m *Attachment) Reset()                    { *m = Attachment{} }
@@ -129,6 +131,13 @@ func (m *Attachment) GetIsPublic() bool {
 	return false
 }
 
+func (m *Attachment) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
 type CreateRequest struct {
 	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment" json:"attachment,omitempty"`
 	Contents   []byte      `protobuf:"bytes,2,opt,name=contents,proto3" json:"contents,omitempty"`
@@ -585,51 +594,51 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 724 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xae, 0xf3, 0x77, 0x93, 0x93, 0x38, 0x4a, 0xa7, 0x57, 0xf7, 0x5a, 0x46, 0x55, 0x2d, 0xb7,
-	0xd0, 0xf0, 0xe7, 0x88, 0x74, 0xc5, 0x32, 0x14, 0x84, 0x8a, 0x84, 0x40, 0x0e, 0xdd, 0xd0, 0x45,
-	0xe4, 0xd8, 0x93, 0x76, 0xaa, 0x38, 0x36, 0x9e, 0x49, 0x55, 0x78, 0x04, 0x9e, 0x80, 0xc7, 0x60,
-	0xcf, 0xcb, 0x21, 0xcf, 0x8c, 0xe3, 0x1f, 0x64, 0x27, 0x0b, 0x56, 0x99, 0x33, 0xf3, 0x7d, 0xe7,
-	0x9c, 0x99, 0xef, 0x7c, 0x0e, 0x4c, 0xae, 0x09, 0xbb, 0x59, 0xcf, 0x2d, 0x37, 0xf0, 0x47, 0xf7,
-	0xbe, 0xfb, 0xdc, 0xc3, 0x77, 0xf1, 0x2f, 0x5f, 0xbb, 0x41, 0x84, 0x47, 0x61, 0x14, 0xb0, 0x60,
-	0xe4, 0x30, 0xe6, 0xb8, 0x37, 0x3e, 0x5e, 0xb1, 0xcc, 0xd2, 0xe2, 0x67, 0xe8, 0xff, 0x7b, 0xdf,
-	0xb5, 0x68, 0x74, 0x67, 0xc5, 0x78, 0x2b, 0x3d, 0xd6, 0x77, 0xcc, 0x4d, 0xb1, 0x13, 0xc5, 0x0c,
-	0xe6, 0x64, 0x96, 0x22, 0xb7, 0x7e, 0x74, 0x1d, 0x04, 0xd7, 0x4b, 0x89, 0x9c, 0xaf, 0x17, 0x23,
-	0x46, 0x7c, 0x4c, 0x99, 0xe3, 0x87, 0x02, 0x60, 0xfe, 0xaa, 0x01, 0x4c, 0x36, 0x25, 0x51, 0x1f,
-	0x6a, 0xc4, 0xd3, 0x14, 0x43, 0x19, 0x76, 0xec, 0x1a, 0xf1, 0xd0, 0x03, 0xe8, 0xd0, 0xb3, 0x59,
-	0x30, 0xbf, 0xc5, 0x2e, 0xd3, 0x6a, 0x7c, 0xbb, 0x4d, 0xcf, 0x3e, 0xf0, 0x18, 0x19, 0xd0, 0xf5,
-	0x30, 0x75, 0x23, 0x12, 0x32, 0x12, 0xac, 0xb4, 0x3a, 0x3f, 0xce, 0x6e, 0xc5, 0x74, 0xc1, 0x9d,
-	0x11, 0x4f, 0x6b, 0x08, 0xba, 0xd8, 0xb8, 0xf0, 0x90, 0x0e, 0xed, 0x05, 0x59, 0xe2, 0x95, 0xe3,
-	0x63, 0xad, 0x29, 0xce, 0x92, 0x18, 0x21, 0x68, 0x50, 0xf2, 0x0d, 0x6b, 0x2d, 0x43, 0x19, 0x36,
-	0x6d, 0xbe, 0x46, 0x2f, 0x01, 0xdc, 0x08, 0x3b, 0x0c, 0x7b, 0x33, 0x87, 0x69, 0xff, 0x18, 0xca,
-	0xb0, 0x3b, 0xd6, 0x2d, 0x71, 0x41, 0x2b, 0xb9, 0xa0, 0xf5, 0x29, 0xb9, 0xa0, 0xdd, 0x91, 0xe8,
-	0x09, 0x8b, 0xa9, 0xeb, 0xd0, 0x4b, 0xa8, 0xed, 0xed, 0x54, 0x89, 0x9e, 0xb0, 0xf8, 0x0a, 0x84,
-	0xce, 0xc2, 0xf5, 0x7c, 0x49, 0x5c, 0xad, 0x63, 0x28, 0xc3, 0xb6, 0xdd, 0x26, 0xf4, 0x23, 0x8f,
-	0xcd, 0x10, 0xd4, 0x73, 0x5e, 0xc4, 0xc6, 0x5f, 0xd6, 0x98, 0x32, 0x74, 0x0e, 0x90, 0x0a, 0xc8,
-	0xdf, 0xb1, 0x3b, 0x3e, 0xb6, 0x4a, 0x04, 0xb6, 0xd2, 0x87, 0xb7, 0x33, 0xb4, 0xf8, 0x61, 0xdc,
-	0x60, 0xc5, 0xf0, 0x8a, 0x51, 0xfe, 0xe6, 0x3d, 0x7b, 0x13, 0x9b, 0x06, 0xf4, 0x93, 0x8a, 0x34,
-	0x0c, 0x56, 0x14, 0x17, 0x25, 0x33, 0x0f, 0xa1, 0x6b, 0x63, 0xc7, 0x4b, 0x3a, 0x2a, 0x1e, 0x4f,
-	0xa1, 0x27, 0x8e, 0x25, 0xfd, 0x6f, 0x74, 0x6c, 0x9e, 0x00, 0x7a, 0x8b, 0xd9, 0xb9, 0x6c, 0xb2,
-	0xac, 0xf4, 0x29, 0x1c, 0xe4, 0x50, 0xb2, 0x83, 0x01, 0xd4, 0xd7, 0xd1, 0x52, 0xe2, 0xe2, 0xa5,
-	0x79, 0x09, 0x83, 0x29, 0x66, 0xe2, 0x8d, 0x93, 0x64, 0xc7, 0xa0, 0xa6, 0x05, 0xe3, 0x71, 0x12,
-	0xd3, 0xd8, 0x4b, 0x37, 0x2f, 0x3c, 0xf4, 0x1f, 0xb4, 0xa4, 0x52, 0x75, 0xae, 0x94, 0x8c, 0xde,
-	0x35, 0xda, 0xca, 0xa0, 0x66, 0x1e, 0xc0, 0x7e, 0x26, 0xad, 0xa8, 0x6e, 0x7e, 0x05, 0xf5, 0x92,
-	0x8b, 0x5d, 0xd2, 0x75, 0x95, 0x1a, 0x3b, 0x38, 0x20, 0x3b, 0xe4, 0x8d, 0xfc, 0x90, 0x9b, 0x03,
-	0xe8, 0x27, 0xa5, 0x65, 0x33, 0x47, 0xa0, 0xbe, 0xc6, 0x4b, 0x5c, 0xda, 0x4c, 0x4c, 0x49, 0x00,
-	0x92, 0xf2, 0x43, 0x01, 0x75, 0xca, 0x6d, 0x9f, 0x70, 0xfe, 0x85, 0xe6, 0x92, 0xf8, 0x84, 0xf1,
-	0x7a, 0xaa, 0x2d, 0x82, 0xf8, 0x69, 0x82, 0xc5, 0x82, 0x62, 0xc6, 0xbd, 0xa6, 0xda, 0x32, 0x2a,
-	0x5e, 0x41, 0xd9, 0x62, 0xe2, 0x5a, 0x85, 0x89, 0xeb, 0x85, 0xfb, 0x7d, 0x57, 0xa0, 0x9f, 0xb4,
-	0x26, 0xb5, 0x7e, 0x03, 0xdd, 0x54, 0x30, 0xaa, 0x29, 0x46, 0x7d, 0xd7, 0x71, 0xcb, 0xf2, 0xd0,
-	0x0b, 0x68, 0xc4, 0x1f, 0x39, 0xde, 0x4d, 0x77, 0x7c, 0x98, 0xe7, 0x67, 0x3e, 0x82, 0xef, 0x31,
-	0x73, 0x6c, 0x0e, 0x1d, 0xff, 0x6c, 0xc2, 0x7e, 0x9a, 0x6e, 0x8a, 0xa3, 0x3b, 0xe2, 0x62, 0x74,
-	0x05, 0x2d, 0x61, 0x27, 0xf4, 0xa8, 0xb4, 0x89, 0x9c, 0xc3, 0xf5, 0xd3, 0xad, 0x38, 0x29, 0xcc,
-	0x1e, 0xba, 0x84, 0x46, 0x6c, 0x35, 0x74, 0x52, 0x4a, 0xc9, 0x18, 0x55, 0x7f, 0xb8, 0x05, 0xb5,
-	0x49, 0x7b, 0x0b, 0xdd, 0x8c, 0x8d, 0xd0, 0xd3, 0x52, 0xde, 0x9f, 0x96, 0xd4, 0x9f, 0xed, 0x06,
-	0xde, 0xd4, 0xf2, 0xa0, 0xb3, 0xb1, 0x0c, 0x7a, 0x5c, 0x4a, 0x2e, 0xba, 0x55, 0x7f, 0xb2, 0x0b,
-	0x74, 0x53, 0xe5, 0x0a, 0x5a, 0xc2, 0x08, 0x15, 0x2a, 0xe4, 0x4c, 0x5a, 0xa1, 0x42, 0xc1, 0x51,
-	0x3c, 0xb9, 0xb0, 0x4c, 0x45, 0xf2, 0x9c, 0xe9, 0x2a, 0x92, 0x17, 0xbc, 0xc7, 0x93, 0x8b, 0x09,
-	0xaf, 0x48, 0x9e, 0x73, 0x67, 0x45, 0xf2, 0xbc, 0x55, 0xcc, 0xbd, 0x57, 0xbd, 0xcf, 0x99, 0x6f,
-	0xec, 0xbc, 0xc5, 0xff, 0xa7, 0xce, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xab, 0x25, 0xfd, 0x9a,
-	0x72, 0x08, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xfd, 0x9c, 0xbf, 0x2f, 0x99, 0xc4, 0x51, 0xba, 0x45, 0x60, 0x19, 0x55, 0xb5, 0xdc, 0x42,
+	0xc2, 0x9f, 0x23, 0x52, 0x81, 0xc4, 0x65, 0x28, 0x08, 0x15, 0x09, 0x81, 0x1c, 0x7a, 0x43, 0x2f,
+	0x22, 0xc7, 0xde, 0xb4, 0x5b, 0xc5, 0xb1, 0xf1, 0x6e, 0xaa, 0xc2, 0x23, 0xf0, 0x04, 0x3c, 0x06,
+	0xef, 0xc2, 0x0b, 0x21, 0xef, 0xae, 0x63, 0x3b, 0xc8, 0x71, 0x2e, 0xb8, 0xca, 0xce, 0xee, 0x39,
+	0x33, 0xb3, 0x7b, 0xe6, 0x38, 0x30, 0xbe, 0x24, 0xec, 0x6a, 0x35, 0xb3, 0xdc, 0xc0, 0x1f, 0xde,
+	0xfa, 0xee, 0x33, 0x0f, 0xdf, 0xc4, 0xbf, 0x7c, 0xed, 0x06, 0x11, 0x1e, 0x86, 0x51, 0xc0, 0x82,
+	0xa1, 0xc3, 0x98, 0xe3, 0x5e, 0xf9, 0x78, 0xc9, 0x32, 0x4b, 0x8b, 0x9f, 0xa1, 0x7b, 0xb7, 0xbe,
+	0x6b, 0xd1, 0xe8, 0xc6, 0x8a, 0xf1, 0x56, 0x7a, 0xac, 0xef, 0x98, 0x9b, 0x62, 0x27, 0x8a, 0x19,
+	0xcc, 0xc9, 0x2c, 0x45, 0x6e, 0xfd, 0xf0, 0x32, 0x08, 0x2e, 0x17, 0x12, 0x39, 0x5b, 0xcd, 0x87,
+	0x8c, 0xf8, 0x98, 0x32, 0xc7, 0x0f, 0x05, 0xc0, 0xfc, 0x5d, 0x01, 0x18, 0xaf, 0x4b, 0xa2, 0x2e,
+	0x54, 0x88, 0xa7, 0x29, 0x86, 0x32, 0x68, 0xd9, 0x15, 0xe2, 0xa1, 0xfb, 0xd0, 0xa2, 0x27, 0xd3,
+	0x60, 0x76, 0x8d, 0x5d, 0xa6, 0x55, 0xf8, 0x76, 0x93, 0x9e, 0x7c, 0xe4, 0x31, 0x32, 0xa0, 0xed,
+	0x61, 0xea, 0x46, 0x24, 0x64, 0x24, 0x58, 0x6a, 0x55, 0x7e, 0x9c, 0xdd, 0x8a, 0xe9, 0x82, 0x3b,
+	0x25, 0x9e, 0x56, 0x13, 0x74, 0xb1, 0x71, 0xe6, 0x21, 0x1d, 0x9a, 0x73, 0xb2, 0xc0, 0x4b, 0xc7,
+	0xc7, 0x5a, 0x5d, 0x9c, 0x25, 0x31, 0x42, 0x50, 0xa3, 0xe4, 0x3b, 0xd6, 0x1a, 0x86, 0x32, 0xa8,
+	0xdb, 0x7c, 0x8d, 0x5e, 0x01, 0xb8, 0x11, 0x76, 0x18, 0xf6, 0xa6, 0x0e, 0xd3, 0xfe, 0x37, 0x94,
+	0x41, 0x7b, 0xa4, 0x5b, 0xe2, 0x82, 0x56, 0x72, 0x41, 0xeb, 0x73, 0x72, 0x41, 0xbb, 0x25, 0xd1,
+	0x63, 0x16, 0x53, 0x57, 0xa1, 0x97, 0x50, 0x9b, 0xe5, 0x54, 0x89, 0x1e, 0xb3, 0xf8, 0x0a, 0x84,
+	0x4e, 0xc3, 0xd5, 0x6c, 0x41, 0x5c, 0xad, 0x65, 0x28, 0x83, 0xa6, 0xdd, 0x24, 0xf4, 0x13, 0x8f,
+	0xd1, 0x5d, 0x68, 0xd0, 0x2b, 0x67, 0xf4, 0xe2, 0xa5, 0x06, 0xfc, 0x02, 0x32, 0x32, 0x43, 0x50,
+	0x4f, 0x79, 0x71, 0x1b, 0x7f, 0x5d, 0x61, 0xca, 0xd0, 0x29, 0x40, 0x2a, 0x2c, 0x7f, 0xdf, 0xf6,
+	0xe8, 0xc8, 0x2a, 0x10, 0xde, 0x4a, 0x05, 0xb1, 0x33, 0xb4, 0xf8, 0xc1, 0xdc, 0x60, 0xc9, 0xf0,
+	0x92, 0x51, 0xae, 0x45, 0xc7, 0x5e, 0xc7, 0xa6, 0x01, 0xdd, 0xa4, 0x22, 0x0d, 0x83, 0x25, 0xc5,
+	0x9b, 0x52, 0x9a, 0x07, 0xd0, 0xb6, 0xb1, 0xe3, 0x25, 0x1d, 0x6d, 0x1e, 0x4f, 0xa0, 0x23, 0x8e,
+	0x25, 0xfd, 0x5f, 0x74, 0x6c, 0x1e, 0x03, 0x7a, 0x87, 0xd9, 0xa9, 0x6c, 0xb2, 0xa8, 0x74, 0x1f,
+	0xf6, 0x73, 0x28, 0xd9, 0x41, 0x0f, 0xaa, 0xab, 0x68, 0x21, 0x71, 0xf1, 0xd2, 0x3c, 0x87, 0xde,
+	0x04, 0x33, 0xf1, 0xf6, 0x49, 0xb2, 0x23, 0x50, 0xd3, 0x82, 0xf1, 0x98, 0x89, 0x29, 0xed, 0xa4,
+	0x9b, 0x67, 0x5e, 0xac, 0x93, 0x54, 0xb0, 0xca, 0x15, 0x94, 0xd1, 0xfb, 0x5a, 0x53, 0xe9, 0x55,
+	0xcc, 0x7d, 0xd8, 0xcb, 0xa4, 0x15, 0xd5, 0xcd, 0x6f, 0xa0, 0x9e, 0xf3, 0x21, 0x28, 0xe8, 0x7a,
+	0x9b, 0x1a, 0x3b, 0x38, 0x23, 0x3b, 0xfc, 0xb5, 0xfc, 0xf0, 0x9b, 0x3d, 0xe8, 0x26, 0xa5, 0x65,
+	0x33, 0x87, 0xa0, 0xbe, 0xc1, 0x0b, 0x5c, 0xd8, 0x4c, 0x4c, 0x49, 0x00, 0x92, 0xf2, 0x53, 0x01,
+	0x75, 0xc2, 0x3f, 0x07, 0x09, 0xe7, 0x0e, 0xd4, 0x17, 0xc4, 0x27, 0x8c, 0xd7, 0x53, 0x6d, 0x11,
+	0xc4, 0x4f, 0x13, 0xcc, 0xe7, 0x14, 0x33, 0xee, 0x41, 0xd5, 0x96, 0xd1, 0xe6, 0x15, 0x94, 0x12,
+	0x73, 0x57, 0xb6, 0x98, 0xbb, 0xba, 0x71, 0xbf, 0x1f, 0x0a, 0x74, 0x93, 0xd6, 0xa4, 0xd6, 0x6f,
+	0xa1, 0x9d, 0x0a, 0x46, 0x35, 0xc5, 0xa8, 0xee, 0x3a, 0x6e, 0x59, 0x1e, 0x7a, 0x0e, 0x35, 0x1f,
+	0x33, 0x87, 0x77, 0xd3, 0x1e, 0x1d, 0xe4, 0xf9, 0x99, 0x8f, 0xe3, 0x07, 0xcc, 0x1c, 0x9b, 0x43,
+	0x47, 0xbf, 0xea, 0xb0, 0x97, 0xa6, 0x9b, 0xe0, 0xe8, 0x86, 0xb8, 0x18, 0x5d, 0x40, 0x43, 0xd8,
+	0x09, 0x3d, 0x2c, 0x6c, 0x22, 0xe7, 0x70, 0xbd, 0x5f, 0x8a, 0x93, 0xc2, 0xfc, 0x87, 0xce, 0xa1,
+	0x16, 0x5b, 0x0d, 0x1d, 0x17, 0x52, 0x32, 0x46, 0xd5, 0x1f, 0x94, 0xa0, 0xd6, 0x69, 0xaf, 0xa1,
+	0x9d, 0xb1, 0x11, 0x7a, 0x52, 0xc8, 0xfb, 0xdb, 0x92, 0xfa, 0xd3, 0xdd, 0xc0, 0xeb, 0x5a, 0x1e,
+	0xb4, 0xd6, 0x96, 0x41, 0x8f, 0x0a, 0xc9, 0x9b, 0x6e, 0xd5, 0x1f, 0xef, 0x02, 0x5d, 0x57, 0xb9,
+	0x80, 0x86, 0x30, 0xc2, 0x16, 0x15, 0x72, 0x26, 0xd5, 0xfb, 0xa5, 0xb8, 0x6c, 0x72, 0x61, 0x99,
+	0x2d, 0xc9, 0x73, 0xa6, 0xd3, 0xfb, 0xa5, 0xb8, 0x6c, 0x72, 0x31, 0xe1, 0x5b, 0x92, 0xe7, 0xdc,
+	0xa9, 0xf7, 0x4b, 0x71, 0x49, 0xf2, 0xd7, 0x9d, 0x2f, 0x99, 0x6f, 0xec, 0xac, 0xc1, 0xff, 0xbf,
+	0x4e, 0xfe, 0x0c, 0x00, 0xc9, 0xdb, 0x6f, 0x44, 0x8a, 0x08, 0x00, 0x00,
 }
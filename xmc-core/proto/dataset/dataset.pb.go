@@ -8,8 +8,13 @@ It is generated from these files:
 	github.com/xmc-dev/xmc/xmc-core/proto/dataset/dataset.proto
 
 It has these top-level messages:
+	ScoringPolicyValue
+	TypeValue
+	CheckerValue
+	NodeRequirements
 	Dataset
 	TestCase
+	TestGroup
 	CreateRequest
 	CreateResponse
 	ReadRequest
@@ -40,6 +45,7 @@ import fmt "fmt"
 import math "math"
 import xmc_srv_core_searchmeta "github.com/xmc-dev/xmc/xmc-core/proto/searchmeta"
 import google_protobuf "github.com/golang/protobuf/ptypes/duration"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/wrappers"
 
 import (
 	client "github.com/micro/go-micro/client"
@@ -58,19 +64,210 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ScoringPolicy decides how the score of a submission is computed from the scores of its test cases
+type ScoringPolicy int32
+
+const (
+	// the score is the average of the test case scores
+	ScoringPolicy_AVERAGE ScoringPolicy = 0
+	// each test group gets its weight multiplied by the lowest score of its test cases
+	ScoringPolicy_GROUP_MIN ScoringPolicy = 1
+	// each test group gets its weight only if all of its test cases are passed
+	ScoringPolicy_GROUP_ALL_OR_NOTHING ScoringPolicy = 2
+)
+
+var ScoringPolicy_name = map[int32]string{
+	0: "AVERAGE",
+	1: "GROUP_MIN",
+	2: "GROUP_ALL_OR_NOTHING",
+}
+var ScoringPolicy_value = map[string]int32{
+	"AVERAGE":              0,
+	"GROUP_MIN":            1,
+	"GROUP_ALL_OR_NOTHING": 2,
+}
+
+func (x ScoringPolicy) String() string {
+	return proto.EnumName(ScoringPolicy_name, int32(x))
+}
+func (ScoringPolicy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Type is the way the user program is run on a test case
+type Type int32
+
+const (
+	// the user program reads the input and writes the output which is then checked by the grader
+	Type_BATCH Type = 0
+	// the user program talks with the grader (the interactor) through its stdin and stdout
+	Type_INTERACTIVE Type = 1
+)
+
+var Type_name = map[int32]string{
+	0: "BATCH",
+	1: "INTERACTIVE",
+}
+var Type_value = map[string]int32{
+	"BATCH":       0,
+	"INTERACTIVE": 1,
+}
+
+func (x Type) String() string {
+	return proto.EnumName(Type_name, int32(x))
+}
+func (Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// Checker is the way the output of the user program is checked in batch datasets
+type Checker int32
+
+const (
+	// the output is checked by the grader of the dataset
+	Checker_CUSTOM Checker = 0
+	// the outputs must have the same whitespace separated tokens
+	Checker_TOKENS Checker = 1
+	// the outputs must have the same lines, ignoring trailing whitespace and trailing empty lines
+	Checker_LINES Checker = 2
+	// like TOKENS, but numbers may differ by at most epsilon
+	Checker_FLOAT_ABSOLUTE Checker = 3
+	// like TOKENS, but numbers may differ by at most epsilon times the expected number
+	Checker_FLOAT_RELATIVE Checker = 4
+	// like LINES, but the lines may be in any order
+	Checker_UNORDERED_LINES Checker = 5
+)
+
+var Checker_name = map[int32]string{
+	0: "CUSTOM",
+	1: "TOKENS",
+	2: "LINES",
+	3: "FLOAT_ABSOLUTE",
+	4: "FLOAT_RELATIVE",
+	5: "UNORDERED_LINES",
+}
+var Checker_value = map[string]int32{
+	"CUSTOM":          0,
+	"TOKENS":          1,
+	"LINES":           2,
+	"FLOAT_ABSOLUTE":  3,
+	"FLOAT_RELATIVE":  4,
+	"UNORDERED_LINES": 5,
+}
+
+func (x Checker) String() string {
+	return proto.EnumName(Checker_name, int32(x))
+}
+func (Checker) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type ScoringPolicyValue struct {
+	Value ScoringPolicy `protobuf:"varint,1,opt,name=value,enum=xmc.srv.core.dataset.ScoringPolicy" json:"value,omitempty"`
+}
+
+func (m *ScoringPolicyValue) Reset()                    { *m = ScoringPolicyValue{} }
+func (m *ScoringPolicyValue) String() string            { return proto.CompactTextString(m) }
+func (*ScoringPolicyValue) ProtoMessage()               {}
+func (*ScoringPolicyValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *ScoringPolicyValue) GetValue() ScoringPolicy {
+	if m != nil {
+		return m.Value
+	}
+	return ScoringPolicy_AVERAGE
+}
+
+type TypeValue struct {
+	Value Type `protobuf:"varint,1,opt,name=value,enum=xmc.srv.core.dataset.Type" json:"value,omitempty"`
+}
+
+func (m *TypeValue) Reset()                    { *m = TypeValue{} }
+func (m *TypeValue) String() string            { return proto.CompactTextString(m) }
+func (*TypeValue) ProtoMessage()               {}
+func (*TypeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *TypeValue) GetValue() Type {
+	if m != nil {
+		return m.Value
+	}
+	return Type_BATCH
+}
+
+type CheckerValue struct {
+	Value Checker `protobuf:"varint,1,opt,name=value,enum=xmc.srv.core.dataset.Checker" json:"value,omitempty"`
+}
+
+func (m *CheckerValue) Reset()                    { *m = CheckerValue{} }
+func (m *CheckerValue) String() string            { return proto.CompactTextString(m) }
+func (*CheckerValue) ProtoMessage()               {}
+func (*CheckerValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *CheckerValue) GetValue() Checker {
+	if m != nil {
+		return m.Value
+	}
+	return Checker_CUSTOM
+}
+
+// NodeRequirements are the capabilities an eval node must have to evaluate the submissions of a dataset,
+// so that their timings are comparable
+type NodeRequirements struct {
+	// the node must have all these labels, with the same values
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// the node must have at least this benchmark score
+	MinBenchmarkScore float64 `protobuf:"fixed64,2,opt,name=min_benchmark_score,json=minBenchmarkScore" json:"min_benchmark_score,omitempty"`
+	// if not empty, the CPU model of the node must be exactly this one
+	CpuModel string `protobuf:"bytes,3,opt,name=cpu_model,json=cpuModel" json:"cpu_model,omitempty"`
+}
+
+func (m *NodeRequirements) Reset()                    { *m = NodeRequirements{} }
+func (m *NodeRequirements) String() string            { return proto.CompactTextString(m) }
+func (*NodeRequirements) ProtoMessage()               {}
+func (*NodeRequirements) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *NodeRequirements) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *NodeRequirements) GetMinBenchmarkScore() float64 {
+	if m != nil {
+		return m.MinBenchmarkScore
+	}
+	return 0
+}
+
+func (m *NodeRequirements) GetCpuModel() string {
+	if m != nil {
+		return m.CpuModel
+	}
+	return ""
+}
+
 type Dataset struct {
-	Id          string                    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Name        string                    `protobuf:"bytes,6,opt,name=name" json:"name,omitempty"`
-	GraderId    string                    `protobuf:"bytes,2,opt,name=grader_id,json=graderId" json:"grader_id,omitempty"`
-	Description string                    `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
-	MemoryLimit int32                     `protobuf:"varint,4,opt,name=memory_limit,json=memoryLimit" json:"memory_limit,omitempty"`
-	TimeLimit   *google_protobuf.Duration `protobuf:"bytes,5,opt,name=time_limit,json=timeLimit" json:"time_limit,omitempty"`
+	Id            string                    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name          string                    `protobuf:"bytes,6,opt,name=name" json:"name,omitempty"`
+	GraderId      string                    `protobuf:"bytes,2,opt,name=grader_id,json=graderId" json:"grader_id,omitempty"`
+	Description   string                    `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	MemoryLimit   int32                     `protobuf:"varint,4,opt,name=memory_limit,json=memoryLimit" json:"memory_limit,omitempty"`
+	TimeLimit     *google_protobuf.Duration `protobuf:"bytes,5,opt,name=time_limit,json=timeLimit" json:"time_limit,omitempty"`
+	ScoringPolicy ScoringPolicy             `protobuf:"varint,7,opt,name=scoring_policy,json=scoringPolicy,enum=xmc.srv.core.dataset.ScoringPolicy" json:"scoring_policy,omitempty"`
+	TestGroups    []*TestGroup              `protobuf:"bytes,8,rep,name=test_groups,json=testGroups" json:"test_groups,omitempty"`
+	Type          Type                      `protobuf:"varint,9,opt,name=type,enum=xmc.srv.core.dataset.Type" json:"type,omitempty"`
+	// grader_id is empty if the checker is not CUSTOM
+	Checker Checker `protobuf:"varint,10,opt,name=checker,enum=xmc.srv.core.dataset.Checker" json:"checker,omitempty"`
+	// the tolerance of the FLOAT_ABSOLUTE and FLOAT_RELATIVE checkers
+	Epsilon      float64           `protobuf:"fixed64,11,opt,name=epsilon" json:"epsilon,omitempty"`
+	Requirements *NodeRequirements `protobuf:"bytes,12,opt,name=requirements" json:"requirements,omitempty"`
+	// the maximum size in bytes of the output of the user program, 0 means the default of the eval
+	OutputLimit int64 `protobuf:"varint,13,opt,name=output_limit,json=outputLimit" json:"output_limit,omitempty"`
+	// how long the user program may run without using the CPU, 0 means the default of the eval.
+	// The program may run for the time limit plus the idleness limit of wall time. If it is killed
+	// by the wall time limit before reaching the time limit, the verdict is IDLENESS_LIMIT_EXCEEDED.
+	IdlenessLimit *google_protobuf.Duration `protobuf:"bytes,14,opt,name=idleness_limit,json=idlenessLimit" json:"idleness_limit,omitempty"`
 }
 
 func (m *Dataset) Reset()                    { *m = Dataset{} }
 func (m *Dataset) String() string            { return proto.CompactTextString(m) }
 func (*Dataset) ProtoMessage()               {}
-func (*Dataset) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*Dataset) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Dataset) GetId() string {
 	if m != nil {
@@ -114,17 +311,74 @@ func (m *Dataset) GetTimeLimit() *google_protobuf.Duration {
 	return nil
 }
 
+func (m *Dataset) GetScoringPolicy() ScoringPolicy {
+	if m != nil {
+		return m.ScoringPolicy
+	}
+	return ScoringPolicy_AVERAGE
+}
+
+func (m *Dataset) GetTestGroups() []*TestGroup {
+	if m != nil {
+		return m.TestGroups
+	}
+	return nil
+}
+
+func (m *Dataset) GetType() Type {
+	if m != nil {
+		return m.Type
+	}
+	return Type_BATCH
+}
+
+func (m *Dataset) GetChecker() Checker {
+	if m != nil {
+		return m.Checker
+	}
+	return Checker_CUSTOM
+}
+
+func (m *Dataset) GetEpsilon() float64 {
+	if m != nil {
+		return m.Epsilon
+	}
+	return 0
+}
+
+func (m *Dataset) GetRequirements() *NodeRequirements {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+func (m *Dataset) GetOutputLimit() int64 {
+	if m != nil {
+		return m.OutputLimit
+	}
+	return 0
+}
+
+func (m *Dataset) GetIdlenessLimit() *google_protobuf.Duration {
+	if m != nil {
+		return m.IdlenessLimit
+	}
+	return nil
+}
+
 type TestCase struct {
 	Id                 string `protobuf:"bytes,4,opt,name=id" json:"id,omitempty"`
 	Number             int32  `protobuf:"varint,1,opt,name=number" json:"number,omitempty"`
 	InputAttachmentId  string `protobuf:"bytes,2,opt,name=input_attachment_id,json=inputAttachmentId" json:"input_attachment_id,omitempty"`
 	OutputAttachmentId string `protobuf:"bytes,3,opt,name=output_attachment_id,json=outputAttachmentId" json:"output_attachment_id,omitempty"`
+	GroupNo            int32  `protobuf:"varint,5,opt,name=group_no,json=groupNo" json:"group_no,omitempty"`
 }
 
 func (m *TestCase) Reset()                    { *m = TestCase{} }
 func (m *TestCase) String() string            { return proto.CompactTextString(m) }
 func (*TestCase) ProtoMessage()               {}
-func (*TestCase) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*TestCase) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *TestCase) GetId() string {
 	if m != nil {
@@ -154,6 +408,37 @@ func (m *TestCase) GetOutputAttachmentId() string {
 	return ""
 }
 
+func (m *TestCase) GetGroupNo() int32 {
+	if m != nil {
+		return m.GroupNo
+	}
+	return 0
+}
+
+type TestGroup struct {
+	Number int32  `protobuf:"varint,1,opt,name=number" json:"number,omitempty"`
+	Weight string `protobuf:"bytes,2,opt,name=weight" json:"weight,omitempty"`
+}
+
+func (m *TestGroup) Reset()                    { *m = TestGroup{} }
+func (m *TestGroup) String() string            { return proto.CompactTextString(m) }
+func (*TestGroup) ProtoMessage()               {}
+func (*TestGroup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *TestGroup) GetNumber() int32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *TestGroup) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 type CreateRequest struct {
 	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset" json:"dataset,omitempty"`
 }
@@ -161,7 +446,7 @@ type CreateRequest struct {
 func (m *CreateRequest) Reset()                    { *m = CreateRequest{} }
 func (m *CreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()               {}
-func (*CreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*CreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *CreateRequest) GetDataset() *Dataset {
 	if m != nil {
@@ -177,7 +462,7 @@ type CreateResponse struct {
 func (m *CreateResponse) Reset()                    { *m = CreateResponse{} }
 func (m *CreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()               {}
-func (*CreateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*CreateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *CreateResponse) GetId() string {
 	if m != nil {
@@ -193,7 +478,7 @@ type ReadRequest struct {
 func (m *ReadRequest) Reset()                    { *m = ReadRequest{} }
 func (m *ReadRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()               {}
-func (*ReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*ReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *ReadRequest) GetId() string {
 	if m != nil {
@@ -209,7 +494,7 @@ type ReadResponse struct {
 func (m *ReadResponse) Reset()                    { *m = ReadResponse{} }
 func (m *ReadResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()               {}
-func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *ReadResponse) GetDataset() *Dataset {
 	if m != nil {
@@ -225,7 +510,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *GetRequest) GetName() string {
 	if m != nil {
@@ -241,7 +526,7 @@ type GetResponse struct {
 func (m *GetResponse) Reset()                    { *m = GetResponse{} }
 func (m *GetResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()               {}
-func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GetResponse) GetDataset() *Dataset {
 	if m != nil {
@@ -251,18 +536,32 @@ func (m *GetResponse) GetDataset() *Dataset {
 }
 
 type UpdateRequest struct {
-	Id          string                    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Description string                    `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	GraderId    string                    `protobuf:"bytes,3,opt,name=grader_id,json=graderId" json:"grader_id,omitempty"`
-	Name        string                    `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
-	MemoryLimit int32                     `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit" json:"memory_limit,omitempty"`
-	TimeLimit   *google_protobuf.Duration `protobuf:"bytes,6,opt,name=time_limit,json=timeLimit" json:"time_limit,omitempty"`
+	Id            string                    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Description   string                    `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	GraderId      string                    `protobuf:"bytes,3,opt,name=grader_id,json=graderId" json:"grader_id,omitempty"`
+	Name          string                    `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	MemoryLimit   int32                     `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit" json:"memory_limit,omitempty"`
+	TimeLimit     *google_protobuf.Duration `protobuf:"bytes,6,opt,name=time_limit,json=timeLimit" json:"time_limit,omitempty"`
+	ScoringPolicy *ScoringPolicyValue       `protobuf:"bytes,7,opt,name=scoring_policy,json=scoringPolicy" json:"scoring_policy,omitempty"`
+	// if not empty, replaces the test groups of the dataset.
+	// Test cases in removed groups are left without a group.
+	TestGroups []*TestGroup `protobuf:"bytes,8,rep,name=test_groups,json=testGroups" json:"test_groups,omitempty"`
+	Type       *TypeValue   `protobuf:"bytes,9,opt,name=type" json:"type,omitempty"`
+	// setting a checker other than CUSTOM removes the grader of the dataset
+	Checker *CheckerValue                 `protobuf:"bytes,10,opt,name=checker" json:"checker,omitempty"`
+	Epsilon *google_protobuf1.DoubleValue `protobuf:"bytes,11,opt,name=epsilon" json:"epsilon,omitempty"`
+	// if set, replaces the requirements of the dataset
+	Requirements  *NodeRequirements            `protobuf:"bytes,12,opt,name=requirements" json:"requirements,omitempty"`
+	OutputLimit   *google_protobuf1.Int64Value `protobuf:"bytes,13,opt,name=output_limit,json=outputLimit" json:"output_limit,omitempty"`
+	IdlenessLimit *google_protobuf.Duration    `protobuf:"bytes,14,opt,name=idleness_limit,json=idlenessLimit" json:"idleness_limit,omitempty"`
+	// removes all the test groups of the dataset, can't be used with test_groups
+	ClearTestGroups bool `protobuf:"varint,15,opt,name=clear_test_groups,json=clearTestGroups" json:"clear_test_groups,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()               {}
-func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *UpdateRequest) GetId() string {
 	if m != nil {
@@ -306,13 +605,76 @@ func (m *UpdateRequest) GetTimeLimit() *google_protobuf.Duration {
 	return nil
 }
 
+func (m *UpdateRequest) GetScoringPolicy() *ScoringPolicyValue {
+	if m != nil {
+		return m.ScoringPolicy
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetTestGroups() []*TestGroup {
+	if m != nil {
+		return m.TestGroups
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetType() *TypeValue {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetChecker() *CheckerValue {
+	if m != nil {
+		return m.Checker
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetEpsilon() *google_protobuf1.DoubleValue {
+	if m != nil {
+		return m.Epsilon
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetRequirements() *NodeRequirements {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetOutputLimit() *google_protobuf1.Int64Value {
+	if m != nil {
+		return m.OutputLimit
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetIdlenessLimit() *google_protobuf.Duration {
+	if m != nil {
+		return m.IdlenessLimit
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetClearTestGroups() bool {
+	if m != nil {
+		return m.ClearTestGroups
+	}
+	return false
+}
+
 type UpdateResponse struct {
 }
 
 func (m *UpdateResponse) Reset()                    { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()               {}
-func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 type DeleteRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -321,7 +683,7 @@ type DeleteRequest struct {
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *DeleteRequest) GetId() string {
 	if m != nil {
@@ -336,7 +698,7 @@ type DeleteResponse struct {
 func (m *DeleteResponse) Reset()                    { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()               {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 type SearchRequest struct {
 	Limit       uint32 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
@@ -349,7 +711,7 @@ type SearchRequest struct {
 func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
 func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()               {}
-func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *SearchRequest) GetLimit() uint32 {
 	if m != nil {
@@ -394,7 +756,7 @@ type SearchResponse struct {
 func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
 func (m *SearchResponse) String() string            { return proto.CompactTextString(m) }
 func (*SearchResponse) ProtoMessage()               {}
-func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *SearchResponse) GetDatasets() []*Dataset {
 	if m != nil {
@@ -411,16 +773,17 @@ func (m *SearchResponse) GetMeta() *xmc_srv_core_searchmeta.Meta {
 }
 
 type AddTestCaseRequest struct {
-	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Number int32  `protobuf:"varint,2,opt,name=number" json:"number,omitempty"`
-	Input  []byte `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
-	Output []byte `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Number  int32  `protobuf:"varint,2,opt,name=number" json:"number,omitempty"`
+	Input   []byte `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Output  []byte `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	GroupNo int32  `protobuf:"varint,5,opt,name=group_no,json=groupNo" json:"group_no,omitempty"`
 }
 
 func (m *AddTestCaseRequest) Reset()                    { *m = AddTestCaseRequest{} }
 func (m *AddTestCaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*AddTestCaseRequest) ProtoMessage()               {}
-func (*AddTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*AddTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *AddTestCaseRequest) GetId() string {
 	if m != nil {
@@ -450,13 +813,20 @@ func (m *AddTestCaseRequest) GetOutput() []byte {
 	return nil
 }
 
+func (m *AddTestCaseRequest) GetGroupNo() int32 {
+	if m != nil {
+		return m.GroupNo
+	}
+	return 0
+}
+
 type AddTestCaseResponse struct {
 }
 
 func (m *AddTestCaseResponse) Reset()                    { *m = AddTestCaseResponse{} }
 func (m *AddTestCaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddTestCaseResponse) ProtoMessage()               {}
-func (*AddTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*AddTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 type GetTestCasesRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -465,7 +835,7 @@ type GetTestCasesRequest struct {
 func (m *GetTestCasesRequest) Reset()                    { *m = GetTestCasesRequest{} }
 func (m *GetTestCasesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTestCasesRequest) ProtoMessage()               {}
-func (*GetTestCasesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*GetTestCasesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *GetTestCasesRequest) GetId() string {
 	if m != nil {
@@ -481,7 +851,7 @@ type GetTestCasesResponse struct {
 func (m *GetTestCasesResponse) Reset()                    { *m = GetTestCasesResponse{} }
 func (m *GetTestCasesResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTestCasesResponse) ProtoMessage()               {}
-func (*GetTestCasesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*GetTestCasesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *GetTestCasesResponse) GetTestCases() []*TestCase {
 	if m != nil {
@@ -498,7 +868,7 @@ type GetTestCaseRequest struct {
 func (m *GetTestCaseRequest) Reset()                    { *m = GetTestCaseRequest{} }
 func (m *GetTestCaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTestCaseRequest) ProtoMessage()               {}
-func (*GetTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*GetTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *GetTestCaseRequest) GetId() string {
 	if m != nil {
@@ -521,7 +891,7 @@ type GetTestCaseResponse struct {
 func (m *GetTestCaseResponse) Reset()                    { *m = GetTestCaseResponse{} }
 func (m *GetTestCaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTestCaseResponse) ProtoMessage()               {}
-func (*GetTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*GetTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *GetTestCaseResponse) GetTestCase() *TestCase {
 	if m != nil {
@@ -535,12 +905,16 @@ type UpdateTestCaseRequest struct {
 	Number int32  `protobuf:"varint,2,opt,name=number" json:"number,omitempty"`
 	Input  []byte `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
 	Output []byte `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	// the number of a test group of the dataset
+	GroupNo *google_protobuf1.Int32Value `protobuf:"bytes,5,opt,name=group_no,json=groupNo" json:"group_no,omitempty"`
+	// removes the test case from its test group
+	SetNullGroup bool `protobuf:"varint,6,opt,name=set_null_group,json=setNullGroup" json:"set_null_group,omitempty"`
 }
 
 func (m *UpdateTestCaseRequest) Reset()                    { *m = UpdateTestCaseRequest{} }
 func (m *UpdateTestCaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateTestCaseRequest) ProtoMessage()               {}
-func (*UpdateTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*UpdateTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *UpdateTestCaseRequest) GetId() string {
 	if m != nil {
@@ -570,13 +944,27 @@ func (m *UpdateTestCaseRequest) GetOutput() []byte {
 	return nil
 }
 
+func (m *UpdateTestCaseRequest) GetGroupNo() *google_protobuf1.Int32Value {
+	if m != nil {
+		return m.GroupNo
+	}
+	return nil
+}
+
+func (m *UpdateTestCaseRequest) GetSetNullGroup() bool {
+	if m != nil {
+		return m.SetNullGroup
+	}
+	return false
+}
+
 type UpdateTestCaseResponse struct {
 }
 
 func (m *UpdateTestCaseResponse) Reset()                    { *m = UpdateTestCaseResponse{} }
 func (m *UpdateTestCaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateTestCaseResponse) ProtoMessage()               {}
-func (*UpdateTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*UpdateTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 type RemoveTestCaseRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -586,7 +974,7 @@ type RemoveTestCaseRequest struct {
 func (m *RemoveTestCaseRequest) Reset()                    { *m = RemoveTestCaseRequest{} }
 func (m *RemoveTestCaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*RemoveTestCaseRequest) ProtoMessage()               {}
-func (*RemoveTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*RemoveTestCaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *RemoveTestCaseRequest) GetId() string {
 	if m != nil {
@@ -608,11 +996,16 @@ type RemoveTestCaseResponse struct {
 func (m *RemoveTestCaseResponse) Reset()                    { *m = RemoveTestCaseResponse{} }
 func (m *RemoveTestCaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*RemoveTestCaseResponse) ProtoMessage()               {}
-func (*RemoveTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*RemoveTestCaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func init() {
+	proto.RegisterType((*ScoringPolicyValue)(nil), "xmc.srv.core.dataset.ScoringPolicyValue")
+	proto.RegisterType((*TypeValue)(nil), "xmc.srv.core.dataset.TypeValue")
+	proto.RegisterType((*CheckerValue)(nil), "xmc.srv.core.dataset.CheckerValue")
+	proto.RegisterType((*NodeRequirements)(nil), "xmc.srv.core.dataset.NodeRequirements")
 	proto.RegisterType((*Dataset)(nil), "xmc.srv.core.dataset.Dataset")
 	proto.RegisterType((*TestCase)(nil), "xmc.srv.core.dataset.TestCase")
+	proto.RegisterType((*TestGroup)(nil), "xmc.srv.core.dataset.TestGroup")
 	proto.RegisterType((*CreateRequest)(nil), "xmc.srv.core.dataset.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "xmc.srv.core.dataset.CreateResponse")
 	proto.RegisterType((*ReadRequest)(nil), "xmc.srv.core.dataset.ReadRequest")
@@ -635,6 +1028,9 @@ func init() {
 	proto.RegisterType((*UpdateTestCaseResponse)(nil), "xmc.srv.core.dataset.UpdateTestCaseResponse")
 	proto.RegisterType((*RemoveTestCaseRequest)(nil), "xmc.srv.core.dataset.RemoveTestCaseRequest")
 	proto.RegisterType((*RemoveTestCaseResponse)(nil), "xmc.srv.core.dataset.RemoveTestCaseResponse")
+	proto.RegisterEnum("xmc.srv.core.dataset.ScoringPolicy", ScoringPolicy_name, ScoringPolicy_value)
+	proto.RegisterEnum("xmc.srv.core.dataset.Type", Type_name, Type_value)
+	proto.RegisterEnum("xmc.srv.core.dataset.Checker", Checker_name, Checker_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -859,61 +1255,103 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 892 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0xd3, 0x58,
-	0x14, 0xae, 0xf3, 0x6a, 0x72, 0xf2, 0xd0, 0xcc, 0x6d, 0x5a, 0x65, 0x3c, 0x6a, 0x27, 0xf5, 0xcc,
-	0x48, 0x29, 0x0f, 0x07, 0xca, 0x02, 0x50, 0x41, 0xa8, 0xb4, 0x22, 0x54, 0x2a, 0x42, 0x72, 0xc9,
-	0x86, 0x4d, 0xe4, 0xf8, 0xde, 0xa6, 0x46, 0x75, 0x1c, 0xec, 0x9b, 0xa8, 0x6c, 0xf8, 0x0d, 0x2c,
-	0x58, 0xf3, 0x7f, 0x58, 0xf1, 0x97, 0x90, 0xef, 0xc3, 0xb1, 0x1d, 0x3b, 0x4d, 0xa9, 0xc4, 0xca,
-	0xbe, 0xe7, 0x7c, 0xe7, 0x7d, 0xee, 0x67, 0xc3, 0xc1, 0xc8, 0xa6, 0x17, 0xd3, 0xa1, 0x6e, 0xb9,
-	0x4e, 0xf7, 0xca, 0xb1, 0xee, 0x63, 0x32, 0x0b, 0x9e, 0xec, 0xdd, 0x72, 0x3d, 0xd2, 0x9d, 0x78,
-	0x2e, 0x75, 0xbb, 0xd8, 0xa4, 0xa6, 0x4f, 0xa8, 0x7c, 0xea, 0x4c, 0x8a, 0x9a, 0x57, 0x8e, 0xa5,
-	0xfb, 0xde, 0x4c, 0x0f, 0x90, 0xba, 0xd0, 0xa9, 0x87, 0xab, 0xb9, 0xf4, 0x89, 0xe9, 0x59, 0x17,
-	0x0e, 0xa1, 0x66, 0xe4, 0x95, 0x3b, 0x56, 0x77, 0x46, 0xae, 0x3b, 0xba, 0x14, 0xc8, 0xe1, 0xf4,
-	0xbc, 0x8b, 0xa7, 0x9e, 0x49, 0x6d, 0x77, 0xcc, 0xf5, 0xda, 0x77, 0x05, 0xd6, 0x8f, 0x79, 0x38,
-	0xd4, 0x80, 0x9c, 0x8d, 0x5b, 0x4a, 0x5b, 0xe9, 0x54, 0x8c, 0x9c, 0x8d, 0x11, 0x82, 0xc2, 0xd8,
-	0x74, 0x48, 0xab, 0xc4, 0x24, 0xec, 0x1d, 0xfd, 0x0d, 0x95, 0x91, 0x67, 0x62, 0xe2, 0x0d, 0x6c,
-	0xdc, 0xca, 0x31, 0x45, 0x99, 0x0b, 0x4e, 0x30, 0x6a, 0x43, 0x15, 0x13, 0xdf, 0xf2, 0xec, 0x49,
-	0x10, 0xa1, 0x95, 0x67, 0xea, 0xa8, 0x08, 0xed, 0x42, 0xcd, 0x21, 0x8e, 0xeb, 0x7d, 0x1a, 0x5c,
-	0xda, 0x8e, 0x4d, 0x5b, 0x85, 0xb6, 0xd2, 0x29, 0x1a, 0x55, 0x2e, 0x3b, 0x0d, 0x44, 0xe8, 0x09,
-	0x00, 0xb5, 0x1d, 0x22, 0x00, 0xc5, 0xb6, 0xd2, 0xa9, 0xee, 0xff, 0xa5, 0xf3, 0x32, 0x74, 0x59,
-	0x86, 0x7e, 0x2c, 0xca, 0x30, 0x2a, 0x01, 0x98, 0x59, 0x6a, 0x5f, 0x15, 0x28, 0xbf, 0x23, 0x3e,
-	0x3d, 0x32, 0x7d, 0x22, 0x8a, 0x29, 0x84, 0xc5, 0x6c, 0x41, 0x69, 0x3c, 0x75, 0x86, 0xc4, 0x63,
-	0x05, 0x16, 0x0d, 0x71, 0x42, 0x3a, 0x6c, 0xd8, 0xe3, 0xc9, 0x94, 0x0e, 0x4c, 0x4a, 0xcd, 0xa0,
-	0x75, 0x63, 0x3a, 0x2f, 0xed, 0x4f, 0xa6, 0x3a, 0x0c, 0x35, 0x27, 0x18, 0x3d, 0x80, 0xa6, 0x3b,
-	0xa5, 0x8b, 0x06, 0xbc, 0x58, 0xc4, 0x75, 0x51, 0x0b, 0xed, 0x35, 0xd4, 0x8f, 0x3c, 0x62, 0x52,
-	0x62, 0x90, 0x8f, 0x53, 0xe2, 0x53, 0xf4, 0x18, 0xd6, 0xc5, 0x84, 0x59, 0x2e, 0xd5, 0xfd, 0x6d,
-	0x3d, 0x6d, 0xfc, 0xba, 0x98, 0x8b, 0x21, 0xd1, 0x5a, 0x1b, 0x1a, 0xd2, 0x93, 0x3f, 0x71, 0xc7,
-	0x61, 0x95, 0xe1, 0xc8, 0xb4, 0x6d, 0xa8, 0x1a, 0xc4, 0xc4, 0x32, 0x52, 0x52, 0xdd, 0x83, 0x1a,
-	0x57, 0x0b, 0xf3, 0x5b, 0x64, 0x02, 0x3d, 0x42, 0x65, 0x18, 0xb9, 0x28, 0xca, 0x7c, 0x51, 0xb4,
-	0x57, 0x50, 0x65, 0x88, 0xdb, 0x46, 0xfa, 0xa1, 0x40, 0xbd, 0x3f, 0xc1, 0x91, 0xf6, 0x25, 0xd7,
-	0x34, 0xb1, 0x75, 0xb9, 0xc5, 0xad, 0x8b, 0x2d, 0x6d, 0x3e, 0xb1, 0xb4, 0x32, 0xf9, 0x42, 0x64,
-	0xcb, 0x93, 0x6b, 0x5a, 0xbc, 0x6e, 0x4d, 0x4b, 0x37, 0x58, 0xd3, 0x3f, 0xa0, 0x21, 0x0b, 0xe2,
-	0xcd, 0xd1, 0xfe, 0x81, 0xfa, 0x31, 0xb9, 0x24, 0x99, 0x25, 0x06, 0x26, 0x12, 0x20, 0x4c, 0xbe,
-	0x28, 0x50, 0x3f, 0x63, 0x97, 0x5d, 0xda, 0x34, 0xa1, 0xc8, 0x73, 0x09, 0xcc, 0xea, 0x06, 0x3f,
-	0x04, 0x6b, 0xef, 0x9e, 0x9f, 0x07, 0x6d, 0xcf, 0x31, 0xb1, 0x38, 0x2d, 0x6f, 0x49, 0xa2, 0xa3,
-	0x85, 0xc5, 0x8e, 0xca, 0xa6, 0x15, 0x23, 0x13, 0xff, 0x0c, 0x0d, 0x99, 0x91, 0x18, 0xfa, 0x53,
-	0x28, 0x8b, 0x31, 0xfa, 0x2d, 0xa5, 0x9d, 0xbf, 0x7e, 0xea, 0x21, 0x1c, 0x3d, 0x84, 0x42, 0xc0,
-	0x62, 0x2c, 0xeb, 0x05, 0xb3, 0x08, 0xcb, 0xbd, 0x21, 0xd4, 0x34, 0x18, 0x54, 0xfb, 0x00, 0xe8,
-	0x10, 0x63, 0x49, 0x00, 0x59, 0xdb, 0x32, 0xe7, 0x81, 0x5c, 0x8c, 0x07, 0x9a, 0x50, 0x64, 0x97,
-	0x9d, 0x35, 0xa3, 0x66, 0xf0, 0x03, 0x6b, 0x1f, 0xbb, 0xd1, 0xac, 0x09, 0x35, 0x43, 0x9c, 0xb4,
-	0x4d, 0xd8, 0x88, 0xc5, 0x12, 0x53, 0xf9, 0x1f, 0x36, 0x7a, 0x84, 0x4a, 0xb1, 0x9f, 0x35, 0xce,
-	0x3e, 0x34, 0xe3, 0x30, 0xd1, 0xaf, 0xe7, 0x00, 0x94, 0xf8, 0x74, 0x60, 0x05, 0x52, 0xd1, 0xb1,
-	0x9d, 0xf4, 0x8e, 0x85, 0xa1, 0x2b, 0x54, 0xba, 0xd1, 0x9e, 0x01, 0x8a, 0xb8, 0xbd, 0x61, 0x03,
-	0x34, 0x23, 0x96, 0x7b, 0x98, 0xd3, 0x01, 0x54, 0xc2, 0x9c, 0xc4, 0xd5, 0xbd, 0x2e, 0xa5, 0xb2,
-	0x4c, 0x49, 0x73, 0x60, 0x93, 0xaf, 0xfa, 0xef, 0x99, 0x4a, 0x0b, 0xb6, 0x92, 0xe1, 0xc4, 0x60,
-	0x5e, 0xc0, 0xa6, 0x41, 0x1c, 0x77, 0xf6, 0xab, 0x89, 0x04, 0xae, 0x93, 0x0e, 0xb8, 0xeb, 0xfd,
-	0x6f, 0x65, 0x68, 0x88, 0xfd, 0x3d, 0x23, 0xde, 0xcc, 0xb6, 0x08, 0xea, 0x43, 0x89, 0xf3, 0x34,
-	0xfa, 0x37, 0xbd, 0x55, 0xb1, 0xef, 0x81, 0xfa, 0xdf, 0x72, 0x90, 0x28, 0x61, 0x0d, 0xbd, 0x85,
-	0x42, 0xc0, 0xde, 0x68, 0x37, 0x1d, 0x1f, 0x21, 0x7e, 0x55, 0x5b, 0x06, 0x09, 0x1d, 0x9e, 0x42,
-	0xbe, 0x47, 0x28, 0x6a, 0xa7, 0x83, 0xe7, 0x04, 0xaf, 0xee, 0x2e, 0x41, 0x84, 0xde, 0xfa, 0x50,
-	0xe2, 0xdd, 0xcf, 0xaa, 0x3a, 0x46, 0xe3, 0x59, 0x55, 0x27, 0xa8, 0x91, 0xb9, 0xe5, 0xdc, 0x97,
-	0xe5, 0x36, 0x46, 0x9d, 0x59, 0x6e, 0x13, 0xf4, 0xc9, 0xdc, 0x72, 0xb6, 0xca, 0x72, 0x1b, 0x63,
-	0xd7, 0x2c, 0xb7, 0x71, 0xc2, 0xd3, 0xd6, 0x10, 0x86, 0x6a, 0x84, 0x18, 0x50, 0x27, 0xdd, 0x6c,
-	0x91, 0xa7, 0xd4, 0xbd, 0x15, 0x90, 0x61, 0x94, 0x11, 0xd4, 0xa2, 0x04, 0x82, 0xf6, 0x32, 0xe7,
-	0x93, 0xe4, 0x22, 0xf5, 0xce, 0x2a, 0xd0, 0x68, 0x39, 0x11, 0x4d, 0x56, 0x39, 0x8b, 0xac, 0xa3,
-	0xee, 0xad, 0x80, 0x0c, 0xa3, 0x38, 0xf2, 0x8b, 0x18, 0x06, 0xba, 0xbb, 0x6c, 0x39, 0x92, 0xb1,
-	0xee, 0xad, 0x06, 0x8e, 0x86, 0x8b, 0xdf, 0xe5, 0xac, 0x70, 0xa9, 0x94, 0x91, 0x15, 0x2e, 0x9d,
-	0x1e, 0xb4, 0xb5, 0x97, 0x95, 0xf7, 0xf2, 0x67, 0x66, 0x58, 0x62, 0x3f, 0x06, 0x8f, 0x7e, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xa0, 0x2a, 0xd8, 0x36, 0x2c, 0x0c, 0x00, 0x00,
+	// 1559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x76, 0xda, 0xca,
+	0x15, 0xb6, 0x30, 0x60, 0xd8, 0x02, 0x4c, 0xc6, 0x4e, 0x16, 0x21, 0x4d, 0x42, 0x54, 0xb7, 0x8b,
+	0xb8, 0x29, 0x4e, 0x71, 0x57, 0x9a, 0x34, 0x49, 0x1b, 0x6c, 0x13, 0x42, 0x8a, 0x21, 0x4b, 0x86,
+	0x5c, 0xf4, 0x46, 0x4b, 0x96, 0x26, 0x58, 0x2b, 0xfa, 0xa1, 0xd2, 0xe0, 0xc4, 0x37, 0xbd, 0xeb,
+	0xea, 0x45, 0x6f, 0xfa, 0x04, 0xe7, 0x15, 0xce, 0x83, 0x9c, 0xf7, 0x38, 0xcf, 0x71, 0x96, 0xe6,
+	0x07, 0x4b, 0x18, 0x61, 0x72, 0x92, 0x73, 0xae, 0xd0, 0xcc, 0x7c, 0xfb, 0x9b, 0x3d, 0xfb, 0xe7,
+	0x9b, 0x01, 0x9e, 0x8f, 0x2d, 0x72, 0x36, 0x3d, 0x6d, 0x18, 0x9e, 0xb3, 0xf7, 0xd9, 0x31, 0xfe,
+	0x68, 0xe2, 0xf3, 0xf0, 0x97, 0x7e, 0x1b, 0x9e, 0x8f, 0xf7, 0x26, 0xbe, 0x47, 0xbc, 0x3d, 0x53,
+	0x27, 0x7a, 0x80, 0x89, 0xf8, 0x6d, 0xd0, 0x59, 0xb4, 0xfd, 0xd9, 0x31, 0x1a, 0x81, 0x7f, 0xde,
+	0x08, 0x91, 0x0d, 0xbe, 0x56, 0x6d, 0xad, 0x46, 0x19, 0x60, 0xdd, 0x37, 0xce, 0x1c, 0x4c, 0xf4,
+	0xc8, 0x27, 0x23, 0xae, 0xde, 0x1b, 0x7b, 0xde, 0xd8, 0xe6, 0xc8, 0xd3, 0xe9, 0x87, 0x3d, 0x73,
+	0xea, 0xeb, 0xc4, 0xf2, 0xdc, 0xa4, 0xf5, 0x4f, 0xbe, 0x3e, 0x99, 0x60, 0x3f, 0x60, 0xeb, 0xca,
+	0x00, 0xd0, 0x89, 0xe1, 0xf9, 0x96, 0x3b, 0x7e, 0xe7, 0xd9, 0x96, 0x71, 0xf1, 0x5e, 0xb7, 0xa7,
+	0x18, 0x3d, 0x83, 0xcc, 0x79, 0xf8, 0x51, 0x91, 0x6a, 0x52, 0xbd, 0xd4, 0xfc, 0x6d, 0x63, 0x91,
+	0xfb, 0x8d, 0x98, 0xa1, 0xca, 0x2c, 0x94, 0x97, 0x90, 0x1f, 0x5e, 0x4c, 0x30, 0xe3, 0x79, 0x1c,
+	0xe7, 0xa9, 0x2e, 0xe6, 0x09, 0xf1, 0xc2, 0xfc, 0x10, 0x0a, 0x87, 0x67, 0xd8, 0xf8, 0x88, 0x7d,
+	0xc6, 0xb0, 0x1f, 0x67, 0xb8, 0xbb, 0x98, 0x81, 0x9b, 0x08, 0x92, 0x1f, 0x25, 0x28, 0xf7, 0x3d,
+	0x13, 0xab, 0xf8, 0x5f, 0x53, 0xcb, 0xc7, 0x0e, 0x76, 0x49, 0x80, 0xde, 0x42, 0xd6, 0xd6, 0x4f,
+	0xb1, 0x1d, 0x54, 0xa4, 0xda, 0x7a, 0x5d, 0x6e, 0x36, 0x17, 0x53, 0xcd, 0xdb, 0x35, 0x7a, 0xd4,
+	0xa8, 0xed, 0x12, 0xff, 0x42, 0xe5, 0x0c, 0xa8, 0x01, 0x5b, 0x8e, 0xe5, 0x6a, 0xa7, 0xd8, 0x35,
+	0xce, 0x1c, 0xdd, 0xff, 0xa8, 0x05, 0x21, 0x47, 0x25, 0x55, 0x93, 0xea, 0x92, 0x7a, 0xc3, 0xb1,
+	0xdc, 0x03, 0xb1, 0x12, 0xc6, 0x08, 0xa3, 0x3b, 0x90, 0x37, 0x26, 0x53, 0xcd, 0xf1, 0x4c, 0x6c,
+	0x57, 0xd6, 0x6b, 0x52, 0x3d, 0xaf, 0xe6, 0x8c, 0xc9, 0xf4, 0x38, 0x1c, 0x57, 0x9f, 0x81, 0x1c,
+	0xd9, 0x03, 0x95, 0x61, 0xfd, 0x23, 0xbe, 0xa0, 0xe7, 0xcd, 0xab, 0xe1, 0x27, 0xda, 0x16, 0x31,
+	0x48, 0xd1, 0x39, 0x36, 0xf8, 0x6b, 0xea, 0xa9, 0xa4, 0xfc, 0x2f, 0x03, 0x1b, 0x47, 0xcc, 0x71,
+	0x54, 0x82, 0x94, 0x65, 0x72, 0xb3, 0x94, 0x65, 0x22, 0x04, 0x69, 0x57, 0x77, 0x70, 0x25, 0x4b,
+	0x67, 0xe8, 0x77, 0xe8, 0xc7, 0xd8, 0xd7, 0x4d, 0xec, 0x6b, 0x96, 0xc9, 0xd9, 0x72, 0x6c, 0xa2,
+	0x6b, 0xa2, 0x1a, 0xc8, 0x26, 0x0e, 0x0c, 0xdf, 0x9a, 0x84, 0xf5, 0xc3, 0xdd, 0x8c, 0x4e, 0xa1,
+	0x07, 0x50, 0x70, 0xb0, 0xe3, 0xf9, 0x17, 0x9a, 0x6d, 0x39, 0x16, 0xa9, 0xa4, 0x6b, 0x52, 0x3d,
+	0xa3, 0xca, 0x6c, 0xae, 0x17, 0x4e, 0xa1, 0xa7, 0x00, 0xc4, 0x72, 0x30, 0x07, 0x64, 0x6a, 0x52,
+	0x5d, 0x6e, 0xde, 0x6e, 0xb0, 0x22, 0x6c, 0x88, 0x22, 0x6c, 0x1c, 0xf1, 0x22, 0x55, 0xf3, 0x21,
+	0x98, 0x59, 0xbe, 0x85, 0x52, 0xc0, 0x0a, 0x4a, 0x9b, 0xd0, 0x8a, 0xaa, 0x6c, 0xac, 0x5e, 0x7c,
+	0xc5, 0x20, 0x3a, 0x44, 0xaf, 0x40, 0x26, 0x38, 0x20, 0xda, 0xd8, 0xf7, 0xa6, 0x93, 0xa0, 0x92,
+	0xa3, 0x09, 0xbf, 0x9f, 0x50, 0x7d, 0x38, 0x20, 0x9d, 0x10, 0xa7, 0x02, 0x11, 0x9f, 0x61, 0x86,
+	0xd3, 0xe4, 0x62, 0x82, 0x2b, 0xf9, 0x6b, 0x0b, 0x97, 0xe2, 0xd0, 0x5f, 0x60, 0xc3, 0x60, 0x45,
+	0x58, 0x81, 0x55, 0x2a, 0x55, 0xa0, 0x51, 0x05, 0x36, 0xf0, 0x24, 0xb0, 0x6c, 0xcf, 0xad, 0xc8,
+	0xb4, 0x7c, 0xc4, 0x10, 0xbd, 0x85, 0x82, 0x1f, 0x29, 0xc4, 0x4a, 0x81, 0x06, 0xf3, 0xf7, 0xab,
+	0x95, 0xad, 0x1a, 0xb3, 0x0d, 0x33, 0xe7, 0x4d, 0xc9, 0x64, 0x4a, 0x78, 0x62, 0x8a, 0x35, 0xa9,
+	0xbe, 0xae, 0xca, 0x6c, 0x8e, 0xc5, 0xff, 0x15, 0x94, 0x2c, 0xd3, 0xc6, 0x2e, 0x0e, 0x02, 0x0e,
+	0x2a, 0x5d, 0x97, 0xbd, 0xa2, 0x30, 0xa0, 0x0c, 0xca, 0xf7, 0x12, 0xe4, 0xc2, 0x68, 0x1e, 0xea,
+	0x01, 0xe6, 0xe5, 0x98, 0x9e, 0x95, 0xe3, 0x2d, 0xc8, 0xba, 0x53, 0xe7, 0x14, 0xfb, 0xb4, 0x44,
+	0x33, 0x2a, 0x1f, 0x85, 0xad, 0x64, 0xb9, 0xa1, 0x63, 0x3a, 0x21, 0x7a, 0x28, 0x6d, 0x2e, 0xb9,
+	0x2c, 0xce, 0x1b, 0x74, 0xa9, 0x35, 0x5b, 0xe9, 0x9a, 0xe8, 0x31, 0x6c, 0xf3, 0x93, 0xc4, 0x0d,
+	0x58, 0xb9, 0x22, 0xb6, 0x16, 0xb3, 0xb8, 0x0d, 0x39, 0x5a, 0x07, 0x9a, 0xeb, 0xd1, 0x82, 0xcc,
+	0xa8, 0x1b, 0x74, 0xdc, 0xf7, 0x94, 0xe7, 0x90, 0x9f, 0xa5, 0x3f, 0xd1, 0xc3, 0x5b, 0x90, 0xfd,
+	0x84, 0xad, 0xf1, 0x19, 0xe1, 0x4e, 0xf1, 0x91, 0xf2, 0x06, 0x8a, 0x87, 0x3e, 0xd6, 0x09, 0x8d,
+	0x3b, 0x0e, 0x48, 0x58, 0x03, 0x3c, 0x1d, 0x94, 0x41, 0x4e, 0xaa, 0x01, 0xde, 0xb1, 0xaa, 0x40,
+	0x2b, 0x35, 0x28, 0x09, 0xa6, 0x60, 0xe2, 0xb9, 0xb3, 0xe8, 0xcd, 0x9a, 0x59, 0xb9, 0x0b, 0xb2,
+	0x8a, 0x75, 0x53, 0xec, 0x34, 0xbf, 0xdc, 0x81, 0x02, 0x5b, 0xe6, 0xe6, 0x5f, 0xe1, 0x09, 0x74,
+	0x30, 0x11, 0xdb, 0x08, 0x09, 0x91, 0x2e, 0x25, 0x44, 0x79, 0x0d, 0x32, 0x45, 0x7c, 0xed, 0x4e,
+	0xff, 0xcd, 0x42, 0x71, 0x34, 0x31, 0x23, 0xe1, 0x9b, 0x17, 0xb0, 0x39, 0x3d, 0x4a, 0x5d, 0xd5,
+	0xa3, 0x98, 0x9c, 0xad, 0xcf, 0xc9, 0x99, 0x70, 0x3e, 0x1d, 0xd1, 0xbf, 0x79, 0x01, 0xcb, 0x5c,
+	0x27, 0x60, 0xd9, 0x2f, 0x10, 0xb0, 0xc1, 0x42, 0x01, 0x93, 0x9b, 0xf5, 0x15, 0x04, 0x8c, 0x5e,
+	0x76, 0xdf, 0x5e, 0xc5, 0xf6, 0x23, 0x2a, 0x96, 0x6c, 0x2a, 0xae, 0x6b, 0x2e, 0x65, 0x2f, 0xe2,
+	0x52, 0x26, 0x37, 0x95, 0xa5, 0x52, 0xc6, 0x4c, 0x85, 0x09, 0x7a, 0x12, 0xd7, 0x33, 0xb9, 0xf9,
+	0x9b, 0xab, 0xc1, 0xf3, 0xa6, 0xa7, 0x36, 0xdf, 0xf2, 0x17, 0x51, 0xbb, 0xbf, 0x2d, 0x50, 0x3b,
+	0xb9, 0x79, 0xe7, 0x8a, 0x23, 0x5d, 0x97, 0x3c, 0xf9, 0x33, 0xf3, 0xe3, 0xdb, 0x4a, 0x21, 0xda,
+	0x85, 0x1b, 0x86, 0x8d, 0x75, 0x5f, 0x8b, 0x26, 0x70, 0xb3, 0x26, 0xd5, 0x73, 0xea, 0x26, 0x5d,
+	0x98, 0xe5, 0x2b, 0x50, 0xca, 0x50, 0x12, 0x8d, 0xc0, 0x9a, 0x4a, 0xb9, 0x0f, 0xc5, 0x23, 0x6c,
+	0xe3, 0xc4, 0xd6, 0x08, 0x4d, 0x04, 0x80, 0x9b, 0xfc, 0x5f, 0x82, 0xe2, 0x09, 0x7d, 0x1c, 0x0a,
+	0x9b, 0x6d, 0xc8, 0x30, 0xdf, 0x43, 0xb3, 0xa2, 0xca, 0x06, 0xa1, 0x98, 0x79, 0x1f, 0x3e, 0x84,
+	0xed, 0x9a, 0xa2, 0xd3, 0x7c, 0xb4, 0xbc, 0x95, 0xe6, 0x3a, 0x31, 0x7d, 0xb5, 0x13, 0x45, 0xb3,
+	0x65, 0x22, 0x4a, 0xf1, 0x6f, 0x28, 0x09, 0x8f, 0xb8, 0x58, 0x3c, 0x83, 0x1c, 0xcf, 0xa0, 0x78,
+	0x84, 0x5d, 0xa3, 0x16, 0x33, 0x38, 0xfa, 0x13, 0xa4, 0x1d, 0x4c, 0x74, 0xea, 0xf5, 0x15, 0xb3,
+	0xc8, 0xab, 0xf8, 0x18, 0x13, 0x5d, 0xa5, 0x50, 0xe5, 0x3f, 0x12, 0xa0, 0x96, 0x69, 0x8a, 0x1b,
+	0x29, 0x49, 0x66, 0x2e, 0x65, 0x3f, 0x15, 0x93, 0xfd, 0x6d, 0xc8, 0xd0, 0xdb, 0x87, 0x46, 0xa3,
+	0xa0, 0xb2, 0x01, 0x8d, 0x1f, 0xad, 0x14, 0x1a, 0x85, 0x82, 0xca, 0x47, 0xcb, 0x2e, 0x99, 0x9b,
+	0xb0, 0x15, 0x73, 0x83, 0x67, 0xec, 0x77, 0xb0, 0xd5, 0xc1, 0x44, 0x4c, 0x07, 0x49, 0xa9, 0x1e,
+	0xc1, 0x76, 0x1c, 0xc6, 0x63, 0xf9, 0x12, 0x68, 0xa3, 0x6b, 0x46, 0x38, 0xcb, 0xa3, 0x79, 0x2f,
+	0x59, 0x1b, 0xe8, 0xd6, 0x79, 0x22, 0x68, 0x94, 0x17, 0x80, 0x22, 0xb4, 0x5f, 0x18, 0x1b, 0x45,
+	0x8d, 0xf9, 0x3e, 0xf3, 0xe9, 0x39, 0xe4, 0x67, 0x3e, 0xf1, 0xeb, 0xe0, 0x3a, 0x97, 0x72, 0xc2,
+	0x25, 0xe5, 0x07, 0x09, 0x6e, 0xb2, 0x3e, 0xf8, 0x75, 0x32, 0xf6, 0x64, 0x2e, 0x63, 0x09, 0x02,
+	0xb1, 0xdf, 0xe4, 0x42, 0xc5, 0xd3, 0x89, 0x76, 0xa0, 0x14, 0x60, 0xa2, 0xb9, 0x53, 0xdb, 0x66,
+	0x8d, 0x4d, 0x2f, 0x89, 0x9c, 0x5a, 0x08, 0x30, 0xe9, 0x4f, 0x6d, 0x9b, 0x76, 0xb5, 0x52, 0x81,
+	0x5b, 0xf3, 0x87, 0xe1, 0x79, 0xff, 0x3b, 0xdc, 0x54, 0xb1, 0xe3, 0x9d, 0xff, 0xdc, 0x63, 0x86,
+	0xd4, 0xf3, 0x04, 0x8c, 0x7a, 0xb7, 0x0d, 0xc5, 0xd8, 0xad, 0x82, 0x64, 0xd8, 0x68, 0xbd, 0x6f,
+	0xab, 0xad, 0x4e, 0xbb, 0xbc, 0x86, 0x8a, 0x90, 0xef, 0xa8, 0x83, 0xd1, 0x3b, 0xed, 0xb8, 0xdb,
+	0x2f, 0x4b, 0xa8, 0x02, 0xdb, 0x6c, 0xd8, 0xea, 0xf5, 0xb4, 0x81, 0xaa, 0xf5, 0x07, 0xc3, 0x37,
+	0xdd, 0x7e, 0xa7, 0x9c, 0xda, 0x55, 0x20, 0x1d, 0xde, 0x09, 0x28, 0x0f, 0x99, 0x83, 0xd6, 0xf0,
+	0xf0, 0x4d, 0x79, 0x0d, 0x6d, 0x82, 0xdc, 0xed, 0x0f, 0xdb, 0x6a, 0xeb, 0x70, 0xd8, 0x7d, 0xdf,
+	0x2e, 0x4b, 0xbb, 0x16, 0x6c, 0x70, 0xfd, 0x47, 0x00, 0xd9, 0xc3, 0xd1, 0xc9, 0x70, 0x70, 0x5c,
+	0x5e, 0x0b, 0xbf, 0x87, 0x83, 0x7f, 0xb4, 0xfb, 0x27, 0x65, 0x29, 0x34, 0xef, 0x75, 0xfb, 0xed,
+	0x93, 0x72, 0x0a, 0x21, 0x28, 0xbd, 0xee, 0x0d, 0x5a, 0x43, 0xad, 0x75, 0x70, 0x32, 0xe8, 0x8d,
+	0x86, 0xed, 0xf2, 0xfa, 0xe5, 0x9c, 0xda, 0xee, 0xb5, 0x28, 0x6b, 0x1a, 0x6d, 0xc1, 0xe6, 0xa8,
+	0x3f, 0x50, 0x8f, 0xda, 0x6a, 0xfb, 0x48, 0x63, 0xc6, 0x99, 0xe6, 0x77, 0x39, 0x28, 0x71, 0x41,
+	0x38, 0xc1, 0xfe, 0xb9, 0x65, 0x60, 0x34, 0x82, 0x2c, 0x7b, 0x30, 0xa1, 0x84, 0x7f, 0x07, 0xb1,
+	0x87, 0x59, 0x75, 0x67, 0x39, 0x88, 0x27, 0x66, 0x0d, 0x0d, 0x20, 0x1d, 0x3e, 0xa3, 0xd0, 0x83,
+	0xc5, 0xf8, 0xc8, 0x0b, 0xac, 0xaa, 0x2c, 0x83, 0xcc, 0x08, 0x7b, 0xb0, 0xde, 0xc1, 0x04, 0xd5,
+	0x16, 0x83, 0x2f, 0x5f, 0x5a, 0xd5, 0x07, 0x4b, 0x10, 0x33, 0xb6, 0x11, 0x64, 0x59, 0x4d, 0x25,
+	0x9d, 0x3a, 0xf6, 0x9e, 0xaa, 0xee, 0x2c, 0x07, 0x45, 0x69, 0xd9, 0x65, 0x92, 0x44, 0x1b, 0xbb,
+	0x8b, 0xaa, 0x3b, 0xcb, 0x41, 0x51, 0x5a, 0x26, 0xff, 0x49, 0xb4, 0xb1, 0xeb, 0xaa, 0xba, 0xb3,
+	0x1c, 0x34, 0xa3, 0x35, 0x41, 0x8e, 0xa8, 0x29, 0x4a, 0x78, 0x5c, 0x5d, 0xd5, 0xfd, 0xea, 0xc3,
+	0x15, 0x90, 0xb3, 0x5d, 0xc6, 0x50, 0x88, 0xaa, 0x2e, 0x7a, 0x98, 0x98, 0x9f, 0x79, 0x01, 0xaf,
+	0xee, 0xae, 0x02, 0x8d, 0x1e, 0x27, 0xb2, 0x92, 0x74, 0x9c, 0xab, 0x52, 0x5d, 0x7d, 0xb8, 0x02,
+	0x72, 0xb6, 0x8b, 0x23, 0x9e, 0x18, 0xb3, 0x8d, 0xfe, 0xb0, 0xac, 0x38, 0xe6, 0xf7, 0x7a, 0xb4,
+	0x1a, 0x38, 0xba, 0x5d, 0x5c, 0xa1, 0x92, 0xb6, 0x5b, 0x28, 0x84, 0xd5, 0x47, 0xab, 0x81, 0xc5,
+	0x76, 0x07, 0xf9, 0x7f, 0x8a, 0x7f, 0x15, 0xa7, 0x59, 0x2a, 0xdd, 0xfb, 0x3f, 0x0d, 0x00, 0x33,
+	0x7f, 0x49, 0xa7, 0xad, 0x13, 0x00, 0x00,
 }
@@ -8,6 +8,7 @@ It is generated from these files:
 	github.com/xmc-dev/xmc/xmc-core/proto/grader/grader.proto
 
 It has these top-level messages:
+	ProtocolValue
 	Grader
 	CreateRequest
 	CreateResponse
@@ -46,17 +47,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Protocol is the way the grader reports the result of a test case
+type Protocol int32
+
+const (
+	// the score, a number between 0 and 1, is written to stdout and the message to stderr
+	Protocol_LEGACY Protocol = 0
+	// the grader writes to stdout a header line "xmc-grader 1" followed by "key: value" lines:
+	// verdict (accepted, wrong_answer or partial), score, message and private_message
+	Protocol_XMC_V1 Protocol = 1
+	// testlib checker: the verdict is given by the exit code and the message is written to stderr
+	Protocol_TESTLIB Protocol = 2
+)
+
+var Protocol_name = map[int32]string{
+	0: "LEGACY",
+	1: "XMC_V1",
+	2: "TESTLIB",
+}
+var Protocol_value = map[string]int32{
+	"LEGACY":  0,
+	"XMC_V1":  1,
+	"TESTLIB": 2,
+}
+
+func (x Protocol) String() string {
+	return proto.EnumName(Protocol_name, int32(x))
+}
+func (Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type ProtocolValue struct {
+	Value Protocol `protobuf:"varint,1,opt,name=value,enum=xmc.srv.core.grader.Protocol" json:"value,omitempty"`
+}
+
+func (m *ProtocolValue) Reset()                    { *m = ProtocolValue{} }
+func (m *ProtocolValue) String() string            { return proto.CompactTextString(m) }
+func (*ProtocolValue) ProtoMessage()               {}
+func (*ProtocolValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *ProtocolValue) GetValue() Protocol {
+	if m != nil {
+		return m.Value
+	}
+	return Protocol_LEGACY
+}
+
 type Grader struct {
-	Id           string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	AttachmentId string `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId" json:"attachment_id,omitempty"`
-	Language     string `protobuf:"bytes,3,opt,name=language" json:"language,omitempty"`
-	Name         string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Id           string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AttachmentId string   `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId" json:"attachment_id,omitempty"`
+	Language     string   `protobuf:"bytes,3,opt,name=language" json:"language,omitempty"`
+	Name         string   `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Protocol     Protocol `protobuf:"varint,5,opt,name=protocol,enum=xmc.srv.core.grader.Protocol" json:"protocol,omitempty"`
 }
 
 func (m *Grader) Reset()                    { *m = Grader{} }
 func (m *Grader) String() string            { return proto.CompactTextString(m) }
 func (*Grader) ProtoMessage()               {}
-func (*Grader) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*Grader) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
 func (m *Grader) GetId() string {
 	if m != nil {
@@ -86,6 +133,13 @@ func (m *Grader) GetName() string {
 	return ""
 }
 
+func (m *Grader) GetProtocol() Protocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return Protocol_LEGACY
+}
+
 type CreateRequest struct {
 	Grader *Grader `protobuf:"bytes,1,opt,name=grader" json:"grader,omitempty"`
 	Code   []byte  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
@@ -94,7 +148,7 @@ type CreateRequest struct {
 func (m *CreateRequest) Reset()                    { *m = CreateRequest{} }
 func (m *CreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()               {}
-func (*CreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*CreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *CreateRequest) GetGrader() *Grader {
 	if m != nil {
@@ -117,7 +171,7 @@ type CreateResponse struct {
 func (m *CreateResponse) Reset()                    { *m = CreateResponse{} }
 func (m *CreateResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()               {}
-func (*CreateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*CreateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *CreateResponse) GetId() string {
 	if m != nil {
@@ -133,7 +187,7 @@ type ReadRequest struct {
 func (m *ReadRequest) Reset()                    { *m = ReadRequest{} }
 func (m *ReadRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()               {}
-func (*ReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*ReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *ReadRequest) GetId() string {
 	if m != nil {
@@ -149,7 +203,7 @@ type ReadResponse struct {
 func (m *ReadResponse) Reset()                    { *m = ReadResponse{} }
 func (m *ReadResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()               {}
-func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*ReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *ReadResponse) GetGrader() *Grader {
 	if m != nil {
@@ -165,7 +219,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *GetRequest) GetName() string {
 	if m != nil {
@@ -181,7 +235,7 @@ type GetResponse struct {
 func (m *GetResponse) Reset()                    { *m = GetResponse{} }
 func (m *GetResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()               {}
-func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *GetResponse) GetGrader() *Grader {
 	if m != nil {
@@ -191,16 +245,17 @@ func (m *GetResponse) GetGrader() *Grader {
 }
 
 type UpdateRequest struct {
-	Id       string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Code     []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Language string `protobuf:"bytes,3,opt,name=language" json:"language,omitempty"`
-	Name     string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Id       string         `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Code     []byte         `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Language string         `protobuf:"bytes,3,opt,name=language" json:"language,omitempty"`
+	Name     string         `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	Protocol *ProtocolValue `protobuf:"bytes,5,opt,name=protocol" json:"protocol,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()               {}
-func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *UpdateRequest) GetId() string {
 	if m != nil {
@@ -230,13 +285,20 @@ func (m *UpdateRequest) GetName() string {
 	return ""
 }
 
+func (m *UpdateRequest) GetProtocol() *ProtocolValue {
+	if m != nil {
+		return m.Protocol
+	}
+	return nil
+}
+
 type UpdateResponse struct {
 }
 
 func (m *UpdateResponse) Reset()                    { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()               {}
-func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 type DeleteRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -245,7 +307,7 @@ type DeleteRequest struct {
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *DeleteRequest) GetId() string {
 	if m != nil {
@@ -260,7 +322,7 @@ type DeleteResponse struct {
 func (m *DeleteResponse) Reset()                    { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()               {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 type SearchRequest struct {
 	Limit    uint32 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
@@ -272,7 +334,7 @@ type SearchRequest struct {
 func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
 func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()               {}
-func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *SearchRequest) GetLimit() uint32 {
 	if m != nil {
@@ -310,7 +372,7 @@ type SearchResponse struct {
 func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
 func (m *SearchResponse) String() string            { return proto.CompactTextString(m) }
 func (*SearchResponse) ProtoMessage()               {}
-func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *SearchResponse) GetGraders() []*Grader {
 	if m != nil {
@@ -327,6 +389,7 @@ func (m *SearchResponse) GetMeta() *xmc_srv_core_searchmeta.Meta {
 }
 
 func init() {
+	proto.RegisterType((*ProtocolValue)(nil), "xmc.srv.core.grader.ProtocolValue")
 	proto.RegisterType((*Grader)(nil), "xmc.srv.core.grader.Grader")
 	proto.RegisterType((*CreateRequest)(nil), "xmc.srv.core.grader.CreateRequest")
 	proto.RegisterType((*CreateResponse)(nil), "xmc.srv.core.grader.CreateResponse")
@@ -340,6 +403,7 @@ func init() {
 	proto.RegisterType((*DeleteResponse)(nil), "xmc.srv.core.grader.DeleteResponse")
 	proto.RegisterType((*SearchRequest)(nil), "xmc.srv.core.grader.SearchRequest")
 	proto.RegisterType((*SearchResponse)(nil), "xmc.srv.core.grader.SearchResponse")
+	proto.RegisterEnum("xmc.srv.core.grader.Protocol", Protocol_name, Protocol_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -484,38 +548,44 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0xdb, 0x4c,
-	0x10, 0x8d, 0x2d, 0x7d, 0xfa, 0xd2, 0xb1, 0x65, 0xca, 0xb6, 0x14, 0xa1, 0x12, 0xa2, 0x6e, 0x2e,
-	0xbd, 0x54, 0xa6, 0x0e, 0x3d, 0xf4, 0xd8, 0xa4, 0x60, 0x5a, 0xc8, 0x45, 0xa6, 0x50, 0x7a, 0x29,
-	0x1b, 0xed, 0xc4, 0x11, 0x58, 0x96, 0x2b, 0xad, 0x4d, 0xe8, 0x1f, 0x5f, 0x8a, 0x66, 0xb5, 0x8a,
-	0x24, 0xa4, 0xf4, 0xd7, 0x49, 0xbb, 0x3b, 0x6f, 0xde, 0xcc, 0xbc, 0x7d, 0x2b, 0x78, 0xbb, 0x4e,
-	0xd4, 0xed, 0xfe, 0x3a, 0x8c, 0xb3, 0x74, 0x7e, 0x97, 0xc6, 0xaf, 0x24, 0x1e, 0xca, 0x2f, 0xad,
-	0xe3, 0x2c, 0xc7, 0xf9, 0x2e, 0xcf, 0x54, 0x36, 0x5f, 0xe7, 0x42, 0x62, 0x5e, 0x7d, 0x42, 0x3a,
-	0x63, 0x4f, 0xee, 0xd2, 0x38, 0x2c, 0xf2, 0x43, 0x58, 0xe2, 0x42, 0x1d, 0xf2, 0xdf, 0xfd, 0x1e,
-	0x5f, 0x81, 0x22, 0x8f, 0x6f, 0x53, 0x54, 0xa2, 0xb1, 0xd4, 0xbc, 0x3c, 0x05, 0x67, 0x49, 0x64,
-	0x6c, 0x06, 0xe3, 0x44, 0x7a, 0xa3, 0x60, 0xf4, 0xf2, 0x51, 0x34, 0x4e, 0x24, 0x3b, 0x03, 0x57,
-	0x28, 0x25, 0x4a, 0xf4, 0x56, 0x7d, 0x4d, 0xa4, 0x37, 0xa6, 0xd0, 0xf4, 0xfe, 0xf0, 0x83, 0x64,
-	0x3e, 0x1c, 0x6f, 0xc4, 0x76, 0xbd, 0x17, 0x6b, 0xf4, 0x2c, 0x8a, 0xd7, 0x7b, 0xc6, 0xc0, 0xde,
-	0x8a, 0x14, 0x3d, 0x9b, 0xce, 0x69, 0xcd, 0x3f, 0x83, 0x7b, 0x99, 0xa3, 0x50, 0x18, 0xe1, 0xb7,
-	0x3d, 0x16, 0x8a, 0x9d, 0x83, 0xa3, 0x87, 0xa1, 0xca, 0x93, 0xc5, 0xf3, 0xb0, 0x67, 0xd0, 0x50,
-	0xb7, 0x18, 0x55, 0xd0, 0x92, 0x39, 0xce, 0x24, 0x52, 0x47, 0xd3, 0x88, 0xd6, 0x3c, 0x80, 0x99,
-	0x61, 0x2e, 0x76, 0xd9, 0xb6, 0xc0, 0xee, 0x40, 0xfc, 0x04, 0x26, 0x11, 0x0a, 0x69, 0x2a, 0x77,
-	0xc3, 0x97, 0x30, 0xd5, 0xe1, 0x2a, 0xfd, 0x6f, 0x3a, 0xe3, 0x01, 0xc0, 0x12, 0x95, 0x29, 0x61,
-	0x14, 0x18, 0x35, 0x14, 0xb8, 0x80, 0x09, 0x21, 0xfe, 0xa5, 0x4a, 0x0c, 0xee, 0xa7, 0x9d, 0x6c,
-	0xa8, 0xd8, 0xbd, 0xbb, 0x1e, 0x81, 0xfe, 0xf8, 0xaa, 0x1e, 0xc3, 0xcc, 0x14, 0xd1, 0xbd, 0xf2,
-	0x53, 0x70, 0xdf, 0xe3, 0x06, 0x07, 0xcb, 0x96, 0x29, 0x06, 0x50, 0xa5, 0xa4, 0xe0, 0xae, 0xc8,
-	0x72, 0x26, 0xe5, 0x29, 0xfc, 0xb7, 0x49, 0xd2, 0x44, 0x51, 0x96, 0x1b, 0xe9, 0x0d, 0x7b, 0x06,
-	0x4e, 0x76, 0x73, 0x53, 0xa0, 0xa2, 0x8e, 0xdd, 0xa8, 0xda, 0xd5, 0x7d, 0x59, 0xf7, 0x7d, 0xb5,
-	0xe6, 0xb0, 0xdb, 0x73, 0xf0, 0xef, 0x30, 0x33, 0xe5, 0x2a, 0x7d, 0xdf, 0xc0, 0xff, 0x5a, 0xb4,
-	0xc2, 0x1b, 0x05, 0xd6, 0xaf, 0x04, 0x36, 0x58, 0xf6, 0x1a, 0xec, 0xf2, 0x91, 0x50, 0x3b, 0x93,
-	0xc5, 0x49, 0x3b, 0xa7, 0xf1, 0x88, 0xae, 0x50, 0x89, 0x88, 0xa0, 0x8b, 0x1f, 0x16, 0xb8, 0x9a,
-	0x66, 0x85, 0xf9, 0x21, 0x89, 0x91, 0xad, 0xc0, 0xd1, 0x96, 0x64, 0xbc, 0xb7, 0x68, 0xeb, 0x25,
-	0xf8, 0x67, 0x0f, 0x62, 0x2a, 0x3d, 0x8f, 0xd8, 0x15, 0xd8, 0xa5, 0x4d, 0x59, 0xd0, 0x0b, 0x6f,
-	0x18, 0xdc, 0x7f, 0xf1, 0x00, 0xa2, 0xa6, 0xfb, 0x08, 0xd6, 0x12, 0x15, 0x3b, 0xed, 0x57, 0xa5,
-	0xb6, 0xb2, 0x1f, 0x0c, 0x03, 0x6a, 0xae, 0x15, 0x38, 0xda, 0x31, 0x03, 0xf3, 0xb6, 0x3c, 0x3b,
-	0x30, 0x6f, 0xc7, 0x72, 0x44, 0xaa, 0x3d, 0x35, 0x40, 0xda, 0x72, 0xe4, 0x00, 0x69, 0xc7, 0x94,
-	0x44, 0xaa, 0x7d, 0x32, 0x40, 0xda, 0xf2, 0xec, 0x00, 0x69, 0xdb, 0x68, 0xfc, 0xe8, 0xe2, 0xf8,
-	0x4b, 0xf5, 0x3e, 0xaf, 0x1d, 0xfa, 0xb7, 0x9e, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xd7,
-	0x0f, 0xf2, 0xf0, 0x05, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdf, 0x6b, 0x9b, 0x50,
+	0x14, 0xae, 0x49, 0x6a, 0xb3, 0x63, 0x0d, 0xe1, 0x6e, 0x0c, 0x71, 0x94, 0x3a, 0xf3, 0x32, 0x06,
+	0x33, 0x34, 0x61, 0x0f, 0x7d, 0x19, 0x34, 0x69, 0x09, 0x1d, 0x0d, 0x0c, 0xd3, 0x95, 0x6e, 0x2f,
+	0xe5, 0x56, 0x4f, 0x53, 0x21, 0xc6, 0x4c, 0x6f, 0x42, 0xd9, 0xbf, 0xb3, 0x97, 0xfd, 0x95, 0x63,
+	0x78, 0xaf, 0xd7, 0x9a, 0x60, 0x5c, 0xb7, 0x3d, 0x79, 0xf4, 0x7c, 0xe7, 0x3b, 0x3f, 0x3f, 0xe1,
+	0x78, 0x1a, 0xb0, 0xfb, 0xe5, 0xad, 0xe3, 0x45, 0x61, 0xf7, 0x21, 0xf4, 0xde, 0xf9, 0xb8, 0x4a,
+	0x9f, 0xdc, 0xf6, 0xa2, 0x18, 0xbb, 0x8b, 0x38, 0x62, 0x51, 0x77, 0x1a, 0x53, 0x1f, 0xe3, 0xec,
+	0xe1, 0xf0, 0x6f, 0xe4, 0xf9, 0x43, 0xe8, 0x39, 0x49, 0xbc, 0x72, 0x52, 0x9c, 0x23, 0x5c, 0xe6,
+	0xc9, 0xd3, 0xf8, 0x12, 0xa4, 0xb1, 0x77, 0x1f, 0x22, 0xa3, 0x05, 0x53, 0xf0, 0xda, 0xa7, 0xa0,
+	0x7f, 0x4a, 0x0d, 0x2f, 0x9a, 0x5d, 0xd1, 0xd9, 0x12, 0x49, 0x1f, 0x76, 0x57, 0xa9, 0x61, 0x28,
+	0x96, 0xf2, 0xa6, 0xd5, 0x3b, 0x70, 0x4a, 0x12, 0x3b, 0x32, 0xc4, 0x15, 0x58, 0xfb, 0xa7, 0x02,
+	0xea, 0x88, 0xbb, 0x48, 0x0b, 0x6a, 0x81, 0xcf, 0x83, 0x9f, 0xb9, 0xb5, 0xc0, 0x27, 0x1d, 0xd0,
+	0x29, 0x63, 0x34, 0x4d, 0x3a, 0x67, 0x37, 0x81, 0x6f, 0xd4, 0xb8, 0x6b, 0xff, 0xf1, 0xe3, 0xb9,
+	0x4f, 0x4c, 0x68, 0xce, 0xe8, 0x7c, 0xba, 0xa4, 0x53, 0x34, 0xea, 0xdc, 0x9f, 0xbf, 0x13, 0x02,
+	0x8d, 0x39, 0x0d, 0xd1, 0x68, 0xf0, 0xef, 0xdc, 0x26, 0xc7, 0xd0, 0x5c, 0x64, 0x25, 0x18, 0xbb,
+	0x4f, 0xa9, 0x33, 0x87, 0xdb, 0xd7, 0xa0, 0x0f, 0x63, 0xa4, 0x0c, 0x5d, 0xfc, 0xb6, 0xc4, 0x84,
+	0x91, 0x3e, 0xa8, 0x02, 0xcd, 0x8b, 0xd6, 0x7a, 0xaf, 0x4a, 0x99, 0x44, 0x77, 0x6e, 0x06, 0x4d,
+	0x8b, 0xf2, 0x22, 0x1f, 0x79, 0x33, 0xfb, 0x2e, 0xb7, 0x6d, 0x0b, 0x5a, 0x92, 0x39, 0x59, 0x44,
+	0xf3, 0x04, 0x37, 0x67, 0x61, 0x1f, 0x80, 0xe6, 0x22, 0xf5, 0x65, 0xe6, 0x4d, 0xf7, 0x10, 0xf6,
+	0x85, 0x3b, 0x0b, 0xff, 0x97, 0xca, 0x6c, 0x0b, 0x60, 0x84, 0x4c, 0xa6, 0x90, 0xc3, 0x53, 0x1e,
+	0x87, 0x67, 0x0f, 0x40, 0xe3, 0x88, 0xff, 0xc9, 0xf2, 0x43, 0x01, 0xfd, 0xf3, 0xc2, 0x2f, 0x8c,
+	0x71, 0x73, 0xef, 0x25, 0x13, 0xfa, 0xeb, 0x35, 0x7f, 0xd8, 0x58, 0xb3, 0xd6, 0xb3, 0x2b, 0xd7,
+	0xcc, 0x2f, 0xb8, 0xb0, 0xeb, 0x36, 0xb4, 0x64, 0x91, 0xa2, 0x59, 0xfb, 0x10, 0xf4, 0x53, 0x9c,
+	0xe1, 0xd6, 0xb2, 0xd3, 0x10, 0x09, 0xc8, 0x42, 0x42, 0xd0, 0x27, 0x5c, 0x35, 0x32, 0xe4, 0x05,
+	0xec, 0xce, 0x82, 0x30, 0x60, 0x3c, 0x4a, 0x77, 0xc5, 0x0b, 0x79, 0x09, 0x6a, 0x74, 0x77, 0x97,
+	0x20, 0xe3, 0x1d, 0xeb, 0x6e, 0xf6, 0x96, 0xf7, 0x55, 0x2f, 0xf4, 0x55, 0x9c, 0x43, 0x63, 0x7d,
+	0x0e, 0xf6, 0x77, 0x68, 0xc9, 0x74, 0xd9, 0x82, 0xde, 0xc3, 0x9e, 0xe8, 0x33, 0x31, 0x14, 0xab,
+	0xfe, 0xa7, 0x0d, 0x49, 0x2c, 0x39, 0x82, 0x46, 0xaa, 0x73, 0x5e, 0x8e, 0xb6, 0xa9, 0x8f, 0xc2,
+	0x7f, 0x60, 0x8c, 0x8c, 0xba, 0x1c, 0xfa, 0xb6, 0x0b, 0x4d, 0x39, 0x4a, 0x02, 0xa0, 0x5e, 0x9c,
+	0x8d, 0x4e, 0x86, 0x5f, 0xda, 0x3b, 0xa9, 0x7d, 0x3d, 0x1e, 0xde, 0x5c, 0x1d, 0xb5, 0x15, 0xa2,
+	0xc1, 0xde, 0xe5, 0xd9, 0xe4, 0xf2, 0xe2, 0x7c, 0xd0, 0xae, 0xf5, 0x7e, 0xd5, 0x41, 0x17, 0x79,
+	0x27, 0x18, 0xaf, 0x02, 0x0f, 0xc9, 0x04, 0x54, 0x21, 0x02, 0x52, 0xbe, 0xaa, 0x35, 0xed, 0x99,
+	0x9d, 0x4a, 0x4c, 0xb6, 0x80, 0x1d, 0x32, 0x86, 0x46, 0x2a, 0x0c, 0x62, 0x95, 0xc2, 0x0b, 0x92,
+	0x32, 0x5f, 0x57, 0x20, 0x72, 0xba, 0x8f, 0x50, 0x1f, 0x21, 0x23, 0x87, 0xe5, 0x63, 0xcc, 0xc5,
+	0x63, 0x5a, 0xdb, 0x01, 0x39, 0xd7, 0x04, 0x54, 0x71, 0x62, 0x5b, 0xfa, 0x5d, 0x13, 0x89, 0xd9,
+	0xa9, 0xc4, 0x14, 0x49, 0xc5, 0x11, 0x6e, 0x21, 0x5d, 0x3b, 0x61, 0xb3, 0x53, 0x89, 0x29, 0x92,
+	0x8a, 0xc3, 0xda, 0x42, 0xba, 0x76, 0xe4, 0x66, 0xa7, 0x12, 0x23, 0x49, 0x07, 0xcd, 0xaf, 0xd9,
+	0x1f, 0xe1, 0x56, 0xe5, 0xaa, 0xeb, 0xff, 0x1e, 0x00, 0x4c, 0x42, 0x03, 0xaa, 0xe4, 0x06, 0x00,
+	0x00,
 }
@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: github.com/xmc-dev/xmc/xmc-core/proto/language/language.proto
+
+/*
+Package language is a generated protocol buffer package.
+
+It is generated from these files:
+	github.com/xmc-dev/xmc/xmc-core/proto/language/language.proto
+
+It has these top-level messages:
+	Language
+	ListRequest
+	ListResponse
+*/
+package language
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	client "github.com/micro/go-micro/client"
+	server "github.com/micro/go-micro/server"
+	context "golang.org/x/net/context"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Language struct {
+	// code is the value used in the language fields of submissions and graders
+	Code             string   `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	Name             string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Extensions       []string `protobuf:"bytes,4,rep,name=extensions" json:"extensions,omitempty"`
+	TimeMultiplier   float64  `protobuf:"fixed64,5,opt,name=time_multiplier,json=timeMultiplier" json:"time_multiplier,omitempty"`
+	MemoryMultiplier float64  `protobuf:"fixed64,6,opt,name=memory_multiplier,json=memoryMultiplier" json:"memory_multiplier,omitempty"`
+	// the versions of the compiler reported by the evals, empty if no eval has it installed
+	Versions []string `protobuf:"bytes,7,rep,name=versions" json:"versions,omitempty"`
+}
+
+func (m *Language) Reset()                    { *m = Language{} }
+func (m *Language) String() string            { return proto.CompactTextString(m) }
+func (*Language) ProtoMessage()               {}
+func (*Language) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *Language) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Language) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Language) GetExtensions() []string {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+func (m *Language) GetTimeMultiplier() float64 {
+	if m != nil {
+		return m.TimeMultiplier
+	}
+	return 0
+}
+
+func (m *Language) GetMemoryMultiplier() float64 {
+	if m != nil {
+		return m.MemoryMultiplier
+	}
+	return 0
+}
+
+func (m *Language) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type ListRequest struct {
+}
+
+func (m *ListRequest) Reset()                    { *m = ListRequest{} }
+func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()               {}
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type ListResponse struct {
+	Languages []*Language `protobuf:"bytes,1,rep,name=languages" json:"languages,omitempty"`
+}
+
+func (m *ListResponse) Reset()                    { *m = ListResponse{} }
+func (m *ListResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListResponse) ProtoMessage()               {}
+func (*ListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ListResponse) GetLanguages() []*Language {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Language)(nil), "xmc.srv.core.language.Language")
+	proto.RegisterType((*ListRequest)(nil), "xmc.srv.core.language.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "xmc.srv.core.language.ListResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ client.Option
+var _ server.Option
+
+// Client API for LanguageService service
+
+type LanguageServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...client.CallOption) (*ListResponse, error)
+}
+
+type languageServiceClient struct {
+	c           client.Client
+	serviceName string
+}
+
+func NewLanguageServiceClient(serviceName string, c client.Client) LanguageServiceClient {
+	if c == nil {
+		c = client.NewClient()
+	}
+	if len(serviceName) == 0 {
+		serviceName = "xmc.srv.core.language"
+	}
+	return &languageServiceClient{
+		c:           c,
+		serviceName: serviceName,
+	}
+}
+
+func (c *languageServiceClient) List(ctx context.Context, in *ListRequest, opts ...client.CallOption) (*ListResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "LanguageService.List", in)
+	out := new(ListResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for LanguageService service
+
+type LanguageServiceHandler interface {
+	List(context.Context, *ListRequest, *ListResponse) error
+}
+
+func RegisterLanguageServiceHandler(s server.Server, hdlr LanguageServiceHandler, opts ...server.HandlerOption) {
+	s.Handle(s.NewHandler(&LanguageService{hdlr}, opts...))
+}
+
+type LanguageService struct {
+	LanguageServiceHandler
+}
+
+func (h *LanguageService) List(ctx context.Context, in *ListRequest, out *ListResponse) error {
+	return h.LanguageServiceHandler.List(ctx, in, out)
+}
+
+func init() {
+	proto.RegisterFile("github.com/xmc-dev/xmc/xmc-core/proto/language/language.proto", fileDescriptor0)
+}
+
+var fileDescriptor0 = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0xfb, 0x30,
+	0x10, 0xc5, 0xff, 0xfe, 0x37, 0x94, 0xf4, 0x0a, 0xb4, 0x58, 0x42, 0x8a, 0x3a, 0x40, 0x14, 0x06,
+	0x22, 0x21, 0x5c, 0xa9, 0xcc, 0x5d, 0x18, 0x51, 0x3b, 0x10, 0x36, 0x16, 0xd4, 0xba, 0xa7, 0x62,
+	0xa9, 0x8e, 0x8b, 0xed, 0x44, 0xe1, 0x2b, 0xf2, 0xa9, 0x90, 0x1d, 0x92, 0x66, 0x00, 0xa6, 0xbc,
+	0xfb, 0xdd, 0x8b, 0xdf, 0xf9, 0x0c, 0xf3, 0xad, 0xb0, 0x6f, 0xc5, 0x9a, 0x71, 0x25, 0xa7, 0x95,
+	0xe4, 0x77, 0x1b, 0x2c, 0xdd, 0xd7, 0x6b, 0xae, 0x34, 0x4e, 0xf7, 0x5a, 0x59, 0x35, 0xdd, 0xad,
+	0xf2, 0x6d, 0xb1, 0xda, 0x62, 0x2b, 0x98, 0xe7, 0xf4, 0xa2, 0x92, 0x9c, 0x19, 0x5d, 0x32, 0xe7,
+	0x65, 0x4d, 0x33, 0xf9, 0x24, 0x10, 0x2e, 0xbe, 0x0b, 0x4a, 0x21, 0xe0, 0x6a, 0x83, 0x11, 0x89,
+	0x49, 0x3a, 0xc8, 0xbc, 0x76, 0x2c, 0x5f, 0x49, 0x8c, 0xfe, 0xd7, 0xcc, 0x69, 0x7a, 0x09, 0x80,
+	0x95, 0xc5, 0xdc, 0x08, 0x95, 0x9b, 0x28, 0x88, 0x7b, 0xe9, 0x20, 0xeb, 0x10, 0x7a, 0x03, 0x23,
+	0x2b, 0x24, 0xbe, 0xca, 0x62, 0x67, 0xc5, 0x7e, 0x27, 0x50, 0x47, 0x47, 0x31, 0x49, 0x49, 0x76,
+	0xe6, 0xf0, 0xb2, 0xa5, 0xf4, 0x16, 0xce, 0x25, 0x4a, 0xa5, 0x3f, 0xba, 0xd6, 0xbe, 0xb7, 0x8e,
+	0xeb, 0x46, 0xc7, 0x3c, 0x81, 0xb0, 0x44, 0x5d, 0x67, 0x1e, 0xfb, 0xcc, 0xb6, 0x7e, 0x0c, 0xc2,
+	0xde, 0x38, 0x48, 0x4e, 0x61, 0xb8, 0x10, 0xc6, 0x66, 0xf8, 0x5e, 0xa0, 0xb1, 0xc9, 0x12, 0x4e,
+	0xea, 0xd2, 0xec, 0x55, 0x6e, 0x90, 0xce, 0x61, 0xd0, 0xdc, 0xdb, 0x44, 0x24, 0xee, 0xa5, 0xc3,
+	0xd9, 0x15, 0xfb, 0x71, 0x2d, 0xac, 0x59, 0x49, 0x76, 0xf8, 0x63, 0xb6, 0x81, 0x51, 0x83, 0x9f,
+	0x51, 0x97, 0x82, 0x23, 0x7d, 0x82, 0xc0, 0x25, 0xd0, 0xe4, 0xb7, 0x63, 0x0e, 0xd3, 0x4c, 0xae,
+	0xff, 0xf4, 0xd4, 0x23, 0x26, 0xff, 0x1e, 0xe0, 0x25, 0x6c, 0x5a, 0xeb, 0xbe, 0x7f, 0xba, 0xfb,
+	0xaf, 0x01, 0x00, 0xd1, 0xee, 0xc8, 0x67, 0xfb, 0x01, 0x00, 0x00,
+}
@@ -8,7 +8,9 @@ It is generated from these files:
 	github.com/xmc-dev/xmc/xmc-core/proto/result/result.proto
 
 It has these top-level messages:
+	VerdictValue
 	TestResult
+	GroupResult
 	Result
 */
 package result
@@ -29,18 +31,112 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Verdict is the outcome of the evaluation of a test case or of a whole submission
+type Verdict int32
+
+const (
+	// the submission hasn't been evaluated yet
+	Verdict_NO_VERDICT   Verdict = 0
+	Verdict_ACCEPTED     Verdict = 1
+	Verdict_WRONG_ANSWER Verdict = 2
+	// the score is more than 0 but not the maximum
+	Verdict_PARTIAL               Verdict = 3
+	Verdict_TIME_LIMIT_EXCEEDED   Verdict = 4
+	Verdict_MEMORY_LIMIT_EXCEEDED Verdict = 5
+	Verdict_RUNTIME_ERROR         Verdict = 6
+	Verdict_COMPILATION_ERROR     Verdict = 7
+	// the evaluation failed because of an error that is not the fault of the user program
+	Verdict_SYSTEM_ERROR Verdict = 8
+	// the compilation exceeded its time limit
+	Verdict_COMPILATION_TIMEOUT Verdict = 9
+	// the job was cancelled before it was evaluated
+	Verdict_CANCELLED Verdict = 10
+	// the user program wrote more output than the output limit of the dataset
+	Verdict_OUTPUT_LIMIT_EXCEEDED Verdict = 11
+	// the user program didn't use the CPU for longer than the idleness limit of the dataset
+	Verdict_IDLENESS_LIMIT_EXCEEDED Verdict = 12
+)
+
+var Verdict_name = map[int32]string{
+	0:  "NO_VERDICT",
+	1:  "ACCEPTED",
+	2:  "WRONG_ANSWER",
+	3:  "PARTIAL",
+	4:  "TIME_LIMIT_EXCEEDED",
+	5:  "MEMORY_LIMIT_EXCEEDED",
+	6:  "RUNTIME_ERROR",
+	7:  "COMPILATION_ERROR",
+	8:  "SYSTEM_ERROR",
+	9:  "COMPILATION_TIMEOUT",
+	10: "CANCELLED",
+	11: "OUTPUT_LIMIT_EXCEEDED",
+	12: "IDLENESS_LIMIT_EXCEEDED",
+}
+var Verdict_value = map[string]int32{
+	"NO_VERDICT":              0,
+	"ACCEPTED":                1,
+	"WRONG_ANSWER":            2,
+	"PARTIAL":                 3,
+	"TIME_LIMIT_EXCEEDED":     4,
+	"MEMORY_LIMIT_EXCEEDED":   5,
+	"RUNTIME_ERROR":           6,
+	"COMPILATION_ERROR":       7,
+	"SYSTEM_ERROR":            8,
+	"COMPILATION_TIMEOUT":     9,
+	"CANCELLED":               10,
+	"OUTPUT_LIMIT_EXCEEDED":   11,
+	"IDLENESS_LIMIT_EXCEEDED": 12,
+}
+
+func (x Verdict) String() string {
+	return proto.EnumName(Verdict_name, int32(x))
+}
+func (Verdict) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type VerdictValue struct {
+	Value Verdict `protobuf:"varint,1,opt,name=value,enum=xmc.srv.core.result.Verdict" json:"value,omitempty"`
+}
+
+func (m *VerdictValue) Reset()                    { *m = VerdictValue{} }
+func (m *VerdictValue) String() string            { return proto.CompactTextString(m) }
+func (*VerdictValue) ProtoMessage()               {}
+func (*VerdictValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *VerdictValue) GetValue() Verdict {
+	if m != nil {
+		return m.Value
+	}
+	return Verdict_NO_VERDICT
+}
+
 type TestResult struct {
 	TestNo        int32                     `protobuf:"varint,1,opt,name=test_no,json=testNo" json:"test_no,omitempty"`
 	Score         string                    `protobuf:"bytes,2,opt,name=score" json:"score,omitempty"`
 	GraderMessage string                    `protobuf:"bytes,3,opt,name=grader_message,json=graderMessage" json:"grader_message,omitempty"`
 	Memory        int32                     `protobuf:"varint,4,opt,name=memory" json:"memory,omitempty"`
 	Time          *google_protobuf.Duration `protobuf:"bytes,5,opt,name=time" json:"time,omitempty"`
+	// resources used by the interactor, only set for interactive datasets
+	InteractorMemory int32                     `protobuf:"varint,6,opt,name=interactor_memory,json=interactorMemory" json:"interactor_memory,omitempty"`
+	InteractorTime   *google_protobuf.Duration `protobuf:"bytes,7,opt,name=interactor_time,json=interactorTime" json:"interactor_time,omitempty"`
+	Verdict          Verdict                   `protobuf:"varint,8,opt,name=verdict,enum=xmc.srv.core.result.Verdict" json:"verdict,omitempty"`
+	// message from the grader that is only shown to the admins
+	GraderPrivateMessage string `protobuf:"bytes,9,opt,name=grader_private_message,json=graderPrivateMessage" json:"grader_private_message,omitempty"`
+	// wall clock time taken by the user program
+	WallTime *google_protobuf.Duration `protobuf:"bytes,10,opt,name=wall_time,json=wallTime" json:"wall_time,omitempty"`
+	// peak resident set size of the user program in kilobytes
+	MaxRss int32 `protobuf:"varint,11,opt,name=max_rss,json=maxRss" json:"max_rss,omitempty"`
+	// exit code of the user program, 128 + the signal number if it was killed by a signal
+	ExitCode int32 `protobuf:"varint,12,opt,name=exit_code,json=exitCode" json:"exit_code,omitempty"`
+	// the signal that killed the user program, 0 if it exited normally
+	Signal int32 `protobuf:"varint,13,opt,name=signal" json:"signal,omitempty"`
+	// size in bytes of the output of the user program, whether written to stdout or to the output file
+	StdoutSize int64 `protobuf:"varint,14,opt,name=stdout_size,json=stdoutSize" json:"stdout_size,omitempty"`
 }
 
 func (m *TestResult) Reset()                    { *m = TestResult{} }
 func (m *TestResult) String() string            { return proto.CompactTextString(m) }
 func (*TestResult) ProtoMessage()               {}
-func (*TestResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*TestResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
 func (m *TestResult) GetTestNo() int32 {
 	if m != nil {
@@ -77,18 +173,107 @@ func (m *TestResult) GetTime() *google_protobuf.Duration {
 	return nil
 }
 
+func (m *TestResult) GetInteractorMemory() int32 {
+	if m != nil {
+		return m.InteractorMemory
+	}
+	return 0
+}
+
+func (m *TestResult) GetInteractorTime() *google_protobuf.Duration {
+	if m != nil {
+		return m.InteractorTime
+	}
+	return nil
+}
+
+func (m *TestResult) GetVerdict() Verdict {
+	if m != nil {
+		return m.Verdict
+	}
+	return Verdict_NO_VERDICT
+}
+
+func (m *TestResult) GetGraderPrivateMessage() string {
+	if m != nil {
+		return m.GraderPrivateMessage
+	}
+	return ""
+}
+
+func (m *TestResult) GetWallTime() *google_protobuf.Duration {
+	if m != nil {
+		return m.WallTime
+	}
+	return nil
+}
+
+func (m *TestResult) GetMaxRss() int32 {
+	if m != nil {
+		return m.MaxRss
+	}
+	return 0
+}
+
+func (m *TestResult) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *TestResult) GetSignal() int32 {
+	if m != nil {
+		return m.Signal
+	}
+	return 0
+}
+
+func (m *TestResult) GetStdoutSize() int64 {
+	if m != nil {
+		return m.StdoutSize
+	}
+	return 0
+}
+
+type GroupResult struct {
+	GroupNo int32  `protobuf:"varint,1,opt,name=group_no,json=groupNo" json:"group_no,omitempty"`
+	Score   string `protobuf:"bytes,2,opt,name=score" json:"score,omitempty"`
+}
+
+func (m *GroupResult) Reset()                    { *m = GroupResult{} }
+func (m *GroupResult) String() string            { return proto.CompactTextString(m) }
+func (*GroupResult) ProtoMessage()               {}
+func (*GroupResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *GroupResult) GetGroupNo() int32 {
+	if m != nil {
+		return m.GroupNo
+	}
+	return 0
+}
+
+func (m *GroupResult) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
 type Result struct {
-	ErrorMessage       string        `protobuf:"bytes,1,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
-	CompilationMessage string        `protobuf:"bytes,2,opt,name=compilation_message,json=compilationMessage" json:"compilation_message,omitempty"`
-	TestResults        []*TestResult `protobuf:"bytes,3,rep,name=test_results,json=testResults" json:"test_results,omitempty"`
-	Score              string        `protobuf:"bytes,4,opt,name=score" json:"score,omitempty"`
-	BuildCommand       string        `protobuf:"bytes,5,opt,name=build_command,json=buildCommand" json:"build_command,omitempty"`
+	ErrorMessage       string         `protobuf:"bytes,1,opt,name=error_message,json=errorMessage" json:"error_message,omitempty"`
+	CompilationMessage string         `protobuf:"bytes,2,opt,name=compilation_message,json=compilationMessage" json:"compilation_message,omitempty"`
+	TestResults        []*TestResult  `protobuf:"bytes,3,rep,name=test_results,json=testResults" json:"test_results,omitempty"`
+	Score              string         `protobuf:"bytes,4,opt,name=score" json:"score,omitempty"`
+	BuildCommand       string         `protobuf:"bytes,5,opt,name=build_command,json=buildCommand" json:"build_command,omitempty"`
+	GroupResults       []*GroupResult `protobuf:"bytes,6,rep,name=group_results,json=groupResults" json:"group_results,omitempty"`
+	Verdict            Verdict        `protobuf:"varint,7,opt,name=verdict,enum=xmc.srv.core.result.Verdict" json:"verdict,omitempty"`
 }
 
 func (m *Result) Reset()                    { *m = Result{} }
 func (m *Result) String() string            { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()               {}
-func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *Result) GetErrorMessage() string {
 	if m != nil {
@@ -125,9 +310,26 @@ func (m *Result) GetBuildCommand() string {
 	return ""
 }
 
+func (m *Result) GetGroupResults() []*GroupResult {
+	if m != nil {
+		return m.GroupResults
+	}
+	return nil
+}
+
+func (m *Result) GetVerdict() Verdict {
+	if m != nil {
+		return m.Verdict
+	}
+	return Verdict_NO_VERDICT
+}
+
 func init() {
+	proto.RegisterType((*VerdictValue)(nil), "xmc.srv.core.result.VerdictValue")
 	proto.RegisterType((*TestResult)(nil), "xmc.srv.core.result.TestResult")
+	proto.RegisterType((*GroupResult)(nil), "xmc.srv.core.result.GroupResult")
 	proto.RegisterType((*Result)(nil), "xmc.srv.core.result.Result")
+	proto.RegisterEnum("xmc.srv.core.result.Verdict", Verdict_name, Verdict_value)
 }
 
 func init() {
@@ -135,26 +337,52 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0x3f, 0x4f, 0xfb, 0x30,
-	0x10, 0x95, 0x7f, 0x6d, 0xf3, 0xa3, 0xd7, 0x96, 0xc1, 0x45, 0x10, 0x18, 0x20, 0x6a, 0x85, 0x94,
-	0xa5, 0x8e, 0x54, 0x26, 0xd6, 0xc2, 0x0a, 0x43, 0xc4, 0xc4, 0x52, 0xe5, 0x8f, 0x09, 0x91, 0xe2,
-	0x5e, 0x65, 0x3b, 0x55, 0xf9, 0x48, 0x7c, 0x28, 0xbe, 0x0b, 0x8a, 0x2f, 0x6d, 0x3a, 0x30, 0xd9,
-	0xf7, 0xee, 0xdd, 0xbb, 0x77, 0x0f, 0x1e, 0x8b, 0xd2, 0x7e, 0xd6, 0xa9, 0xc8, 0x50, 0x45, 0x7b,
-	0x95, 0x2d, 0x72, 0xb9, 0x6b, 0x5e, 0xf7, 0xcf, 0x50, 0xcb, 0x68, 0xab, 0xd1, 0x62, 0xa4, 0xa5,
-	0xa9, 0x2b, 0xdb, 0x3e, 0xc2, 0x61, 0x7c, 0xba, 0x57, 0x99, 0x30, 0x7a, 0x27, 0x1a, 0x9e, 0xa0,
-	0xd6, 0xcd, 0x6d, 0x81, 0x58, 0x54, 0xed, 0x58, 0x5a, 0x7f, 0x44, 0x79, 0xad, 0x13, 0x5b, 0xe2,
-	0x86, 0x86, 0x66, 0xdf, 0x0c, 0xe0, 0x4d, 0x1a, 0x1b, 0x3b, 0x3a, 0xbf, 0x82, 0xff, 0x56, 0x1a,
-	0xbb, 0xde, 0xa0, 0xcf, 0x02, 0x16, 0x0e, 0x62, 0xaf, 0x29, 0x5f, 0x91, 0x5f, 0xc0, 0xc0, 0x34,
-	0xba, 0xfe, 0xbf, 0x80, 0x85, 0xc3, 0x98, 0x0a, 0x7e, 0x0f, 0xe7, 0x85, 0x4e, 0x72, 0xa9, 0xd7,
-	0x4a, 0x1a, 0x93, 0x14, 0xd2, 0xef, 0xb9, 0xf6, 0x84, 0xd0, 0x17, 0x02, 0xf9, 0x25, 0x78, 0x4a,
-	0x2a, 0xd4, 0x5f, 0x7e, 0x9f, 0x44, 0xa9, 0xe2, 0x0b, 0xe8, 0xdb, 0x52, 0x49, 0x7f, 0x10, 0xb0,
-	0x70, 0xb4, 0xbc, 0x16, 0xe4, 0x55, 0x1c, 0xbc, 0x8a, 0xe7, 0xd6, 0x6b, 0xec, 0x68, 0xb3, 0x1f,
-	0x06, 0x5e, 0xeb, 0x73, 0x0e, 0x13, 0xa9, 0x35, 0x76, 0x7b, 0x99, 0xdb, 0x3b, 0x76, 0xe0, 0x61,
-	0x6d, 0x04, 0xd3, 0x0c, 0xd5, 0xb6, 0xac, 0x9c, 0xc8, 0x91, 0x4a, 0x17, 0xf0, 0x93, 0xd6, 0x61,
-	0x60, 0x05, 0x63, 0x77, 0x3d, 0x65, 0x67, 0xfc, 0x5e, 0xd0, 0x0b, 0x47, 0xcb, 0x3b, 0xf1, 0x47,
-	0xb0, 0xa2, 0x0b, 0x2d, 0x1e, 0xd9, 0xe3, 0xdf, 0x74, 0x41, 0xf5, 0x4f, 0x83, 0x9a, 0xc3, 0x24,
-	0xad, 0xcb, 0x2a, 0x5f, 0x67, 0xa8, 0x54, 0xb2, 0xc9, 0xdd, 0xc9, 0xc3, 0x78, 0xec, 0xc0, 0x27,
-	0xc2, 0x56, 0x67, 0xef, 0x1e, 0x89, 0xa7, 0x9e, 0x8b, 0xe0, 0xe1, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x92, 0x17, 0x02, 0xf4, 0x0e, 0x02, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xed, 0x6a, 0xe3, 0x46,
+	0x14, 0xad, 0xe3, 0x0f, 0xd9, 0xd7, 0xb2, 0xab, 0x4c, 0x76, 0x37, 0x4a, 0xb7, 0x74, 0x4d, 0x96,
+	0x82, 0x69, 0x59, 0x19, 0xd2, 0xb2, 0xd0, 0x3f, 0x05, 0x47, 0x1e, 0x16, 0x83, 0x2d, 0x99, 0xb1,
+	0x92, 0xed, 0xf6, 0x8f, 0x50, 0xa4, 0xa9, 0x2a, 0x90, 0x3c, 0x61, 0x66, 0xe4, 0xba, 0xfb, 0x20,
+	0x7d, 0x8d, 0xbe, 0x50, 0x1f, 0xa6, 0x68, 0x46, 0x8e, 0x4d, 0x48, 0xd9, 0xee, 0x2f, 0xeb, 0x9e,
+	0x73, 0xef, 0x3d, 0x33, 0x67, 0x8e, 0xe1, 0xa7, 0x34, 0x93, 0xbf, 0x97, 0x77, 0x4e, 0xcc, 0x8a,
+	0xc9, 0xae, 0x88, 0xdf, 0x24, 0x74, 0x5b, 0xfd, 0xaa, 0xef, 0x98, 0x71, 0x3a, 0xb9, 0xe7, 0x4c,
+	0xb2, 0x09, 0xa7, 0xa2, 0xcc, 0x65, 0xfd, 0xe3, 0x28, 0x0c, 0x9d, 0xed, 0x8a, 0xd8, 0x11, 0x7c,
+	0xeb, 0x54, 0x7d, 0x8e, 0xa6, 0xbe, 0xfa, 0x26, 0x65, 0x2c, 0xcd, 0xeb, 0xb1, 0xbb, 0xf2, 0xb7,
+	0x49, 0x52, 0xf2, 0x48, 0x66, 0x6c, 0xa3, 0x87, 0x2e, 0xaf, 0xc1, 0xbc, 0xa5, 0x3c, 0xc9, 0x62,
+	0x79, 0x1b, 0xe5, 0x25, 0x45, 0x57, 0xd0, 0xde, 0x56, 0x1f, 0x76, 0x63, 0xd4, 0x18, 0x0f, 0xaf,
+	0xbe, 0x76, 0x9e, 0x58, 0xea, 0xd4, 0x13, 0x44, 0xb7, 0x5e, 0xfe, 0xdd, 0x02, 0x08, 0xa8, 0x90,
+	0x44, 0xb1, 0xe8, 0x1c, 0x0c, 0x49, 0x85, 0x0c, 0x37, 0x4c, 0x2d, 0x69, 0x93, 0x4e, 0x55, 0x7a,
+	0x0c, 0x3d, 0x83, 0xb6, 0xa8, 0xd6, 0xd8, 0x27, 0xa3, 0xc6, 0xb8, 0x47, 0x74, 0x81, 0xbe, 0x85,
+	0x61, 0xca, 0xa3, 0x84, 0xf2, 0xb0, 0xa0, 0x42, 0x44, 0x29, 0xb5, 0x9b, 0x8a, 0x1e, 0x68, 0x74,
+	0xa9, 0x41, 0xf4, 0x02, 0x3a, 0x05, 0x2d, 0x18, 0xff, 0xd3, 0x6e, 0xe9, 0xa5, 0xba, 0x42, 0x6f,
+	0xa0, 0x25, 0xb3, 0x82, 0xda, 0xed, 0x51, 0x63, 0xdc, 0xbf, 0xba, 0x70, 0xf4, 0x7d, 0x9d, 0xfd,
+	0x7d, 0x9d, 0x59, 0x7d, 0x5f, 0xa2, 0xda, 0xd0, 0xf7, 0x70, 0x9a, 0x6d, 0x24, 0xe5, 0x51, 0x2c,
+	0x19, 0x0f, 0xf5, 0x0e, 0xbb, 0xa3, 0x36, 0x5a, 0x07, 0x62, 0xa9, 0x77, 0x5f, 0xc3, 0x97, 0x47,
+	0xcd, 0x4a, 0xc6, 0xf8, 0x94, 0xcc, 0xf0, 0x30, 0x11, 0x54, 0x82, 0x6f, 0xc1, 0xd8, 0x6a, 0xbb,
+	0xec, 0xee, 0xff, 0xb0, 0x74, 0xdf, 0x8c, 0x7e, 0x84, 0x17, 0xb5, 0x2d, 0xf7, 0x3c, 0xdb, 0x46,
+	0x92, 0x3e, 0xd8, 0xd3, 0x53, 0xf6, 0x3c, 0xd3, 0xec, 0x4a, 0x93, 0x7b, 0x97, 0xde, 0x42, 0xef,
+	0x8f, 0x28, 0xcf, 0xf5, 0x59, 0xe1, 0x53, 0x67, 0xed, 0x56, 0xbd, 0xea, 0x94, 0xe7, 0x60, 0x14,
+	0xd1, 0x2e, 0xe4, 0x42, 0xd8, 0xfd, 0xda, 0xde, 0x68, 0x47, 0x84, 0x40, 0x2f, 0xa1, 0x47, 0x77,
+	0x99, 0x0c, 0x63, 0x96, 0x50, 0xdb, 0x54, 0x54, 0xb7, 0x02, 0x5c, 0x96, 0xa8, 0x37, 0x11, 0x59,
+	0xba, 0x89, 0x72, 0x7b, 0xa0, 0x87, 0x74, 0x85, 0x5e, 0x41, 0x5f, 0xc8, 0x84, 0x95, 0x32, 0x14,
+	0xd9, 0x47, 0x6a, 0x0f, 0x47, 0x8d, 0x71, 0x93, 0x80, 0x86, 0xd6, 0xd9, 0x47, 0x7a, 0xf9, 0x33,
+	0xf4, 0xdf, 0x71, 0x56, 0xde, 0xd7, 0x89, 0xb9, 0x80, 0x6e, 0x5a, 0x95, 0x87, 0xc8, 0x18, 0xaa,
+	0xfe, 0xaf, 0xcc, 0x5c, 0xfe, 0x73, 0x02, 0x9d, 0x7a, 0xf6, 0x35, 0x0c, 0x28, 0xe7, 0xec, 0x90,
+	0x9e, 0x86, 0x6a, 0x34, 0x15, 0xb8, 0xb7, 0x65, 0x02, 0x67, 0x31, 0x2b, 0xee, 0xb3, 0x5c, 0xdd,
+	0xfb, 0xa1, 0x55, 0xef, 0x44, 0x47, 0xd4, 0x7e, 0xe0, 0x1a, 0x4c, 0x95, 0x61, 0xfd, 0x3a, 0xc2,
+	0x6e, 0x8e, 0x9a, 0xe3, 0xfe, 0xd5, 0xab, 0x27, 0x9f, 0xee, 0x10, 0x7d, 0xd2, 0x97, 0x0f, 0xdf,
+	0xe2, 0x70, 0xf4, 0xd6, 0x71, 0xdc, 0x5f, 0xc3, 0xe0, 0xae, 0xcc, 0xf2, 0x24, 0x8c, 0x59, 0x51,
+	0x44, 0x9b, 0x44, 0x05, 0xb7, 0x47, 0x4c, 0x05, 0xba, 0x1a, 0x43, 0x18, 0x06, 0xda, 0x90, 0xbd,
+	0x7e, 0x47, 0xe9, 0x8f, 0x9e, 0xd4, 0x3f, 0x72, 0x92, 0x98, 0xe9, 0xa1, 0x10, 0xc7, 0xd9, 0x33,
+	0x3e, 0x23, 0x7b, 0xdf, 0xfd, 0x75, 0x02, 0x46, 0x0d, 0xa2, 0x21, 0x80, 0xe7, 0x87, 0xb7, 0x98,
+	0xcc, 0xe6, 0x6e, 0x60, 0x7d, 0x81, 0x4c, 0xe8, 0x4e, 0x5d, 0x17, 0xaf, 0x02, 0x3c, 0xb3, 0x1a,
+	0xc8, 0x02, 0xf3, 0x3d, 0xf1, 0xbd, 0x77, 0xe1, 0xd4, 0x5b, 0xbf, 0xc7, 0xc4, 0x3a, 0x41, 0x7d,
+	0x30, 0x56, 0x53, 0x12, 0xcc, 0xa7, 0x0b, 0xab, 0x89, 0xce, 0xe1, 0x2c, 0x98, 0x2f, 0x71, 0xb8,
+	0x98, 0x2f, 0xe7, 0x41, 0x88, 0x7f, 0x71, 0x31, 0x9e, 0xe1, 0x99, 0xd5, 0x42, 0x17, 0xf0, 0x7c,
+	0x89, 0x97, 0x3e, 0xf9, 0xf0, 0x98, 0x6a, 0xa3, 0x53, 0x18, 0x90, 0x1b, 0x4f, 0x8d, 0x61, 0x42,
+	0x7c, 0x62, 0x75, 0xd0, 0x73, 0x38, 0x75, 0xfd, 0xe5, 0x6a, 0xbe, 0x98, 0x06, 0x73, 0xdf, 0xab,
+	0x61, 0xa3, 0x12, 0x5f, 0x7f, 0x58, 0x07, 0x78, 0x59, 0x23, 0xdd, 0x4a, 0xef, 0xb8, 0xb1, 0x5a,
+	0xe2, 0xdf, 0x04, 0x56, 0x0f, 0x0d, 0xa0, 0xe7, 0x4e, 0x3d, 0x17, 0x2f, 0x16, 0x78, 0x66, 0x41,
+	0x25, 0xef, 0xdf, 0x04, 0xab, 0x9b, 0xe0, 0xb1, 0x7c, 0x1f, 0xbd, 0x84, 0xf3, 0xf9, 0x6c, 0x81,
+	0x3d, 0xbc, 0x5e, 0x3f, 0x26, 0xcd, 0xeb, 0xee, 0xaf, 0x1d, 0xed, 0xd9, 0x5d, 0x47, 0xfd, 0x9b,
+	0x7e, 0xf8, 0x77, 0x00, 0xf3, 0x3d, 0x3f, 0x12, 0xb0, 0x05, 0x00, 0x00,
 }
@@ -103,6 +103,8 @@ type Submission struct {
 	BuildCommand string                      `protobuf:"bytes,11,opt,name=build_command,json=buildCommand" json:"build_command,omitempty"`
 	UserId       string                      `protobuf:"bytes,12,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 	Censored     bool                        `protobuf:"varint,13,opt,name=censored" json:"censored,omitempty"`
+	// the result is hidden because the scoreboard of the task list is frozen
+	Frozen bool `protobuf:"varint,14,opt,name=frozen" json:"frozen,omitempty"`
 }
 
 func (m *Submission) Reset()                    { *m = Submission{} }
@@ -201,6 +203,13 @@ func (m *Submission) GetCensored() bool {
 	return false
 }
 
+func (m *Submission) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 type CreateRequest struct {
 	TaskId   string `protobuf:"bytes,1,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
 	Code     []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
@@ -299,6 +308,8 @@ func (m *ReadResponse) GetSubmission() *Submission {
 
 type UpdateRequest struct {
 	Job *xmc_srv_dispatcher_job.Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// removes the result of the submission, before it is evaluated again
+	ResetResult bool `protobuf:"varint,2,opt,name=reset_result,json=resetResult" json:"reset_result,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
@@ -313,6 +324,13 @@ func (m *UpdateRequest) GetJob() *xmc_srv_dispatcher_job.Job {
 	return nil
 }
 
+func (m *UpdateRequest) GetResetResult() bool {
+	if m != nil {
+		return m.ResetResult
+	}
+	return false
+}
+
 type UpdateResponse struct {
 }
 
@@ -360,6 +378,8 @@ type SearchRequest struct {
 	IncludeResult      bool                                 `protobuf:"varint,12,opt,name=include_result,json=includeResult" json:"include_result,omitempty"`
 	IncludeTestResults bool                                 `protobuf:"varint,13,opt,name=include_test_results,json=includeTestResults" json:"include_test_results,omitempty"`
 	UserId             string                               `protobuf:"bytes,14,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	Verdict            *xmc_srv_core_result.VerdictValue    `protobuf:"bytes,15,opt,name=verdict" json:"verdict,omitempty"`
+	TaskListId         string                               `protobuf:"bytes,16,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
 }
 
 func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
@@ -465,6 +485,20 @@ func (m *SearchRequest) GetUserId() string {
 	return ""
 }
 
+func (m *SearchRequest) GetVerdict() *xmc_srv_core_result.VerdictValue {
+	if m != nil {
+		return m.Verdict
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
 type SearchResponse struct {
 	Submissions []*Submission                 `protobuf:"bytes,1,rep,name=submissions" json:"submissions,omitempty"`
 	Meta        *xmc_srv_core_searchmeta.Meta `protobuf:"bytes,2,opt,name=meta" json:"meta,omitempty"`
@@ -631,65 +665,68 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xed, 0x8e, 0xdb, 0x44,
-	0x14, 0xdd, 0x7c, 0x6c, 0x36, 0xb9, 0x89, 0xa3, 0x65, 0xa8, 0xa8, 0x15, 0x54, 0x1a, 0x79, 0x5b,
-	0x58, 0x21, 0xad, 0x03, 0x29, 0x7f, 0x56, 0x95, 0x90, 0xb6, 0xe9, 0x0a, 0x05, 0xa9, 0x2d, 0x72,
-	0xb6, 0x80, 0xe8, 0x8f, 0x68, 0xe2, 0x99, 0xcd, 0x4e, 0xb1, 0x3d, 0xc1, 0x33, 0x8e, 0xfa, 0x0c,
-	0xbc, 0x11, 0x2f, 0xc3, 0x0b, 0xf0, 0x12, 0x68, 0x3e, 0x1c, 0xdb, 0x81, 0x64, 0xd3, 0x1f, 0x91,
-	0xe7, 0xe3, 0xde, 0x93, 0xeb, 0x73, 0xef, 0x39, 0x86, 0xab, 0x25, 0x93, 0x77, 0xd9, 0xc2, 0x0f,
-	0x79, 0x3c, 0xfa, 0x10, 0x87, 0x17, 0x84, 0xae, 0xd5, 0x53, 0xaf, 0x43, 0x9e, 0xd2, 0xd1, 0x2a,
-	0xe5, 0x92, 0x8f, 0x44, 0xb6, 0x88, 0x99, 0x10, 0x8c, 0x27, 0xa5, 0xa5, 0xaf, 0xef, 0xd0, 0xc3,
-	0x0f, 0x71, 0xe8, 0x8b, 0x74, 0xed, 0xab, 0x78, 0xbf, 0xb8, 0x1e, 0x5c, 0x1e, 0x86, 0x9d, 0x52,
-	0x91, 0x45, 0xd2, 0x3e, 0x0c, 0xe6, 0xe0, 0xd0, 0xb2, 0x28, 0x4e, 0xc3, 0xbb, 0x98, 0x4a, 0x5c,
-	0x5a, 0x5a, 0x88, 0xe7, 0x87, 0x41, 0x48, 0x91, 0xe2, 0x64, 0x49, 0xf3, 0xa7, 0x4d, 0xde, 0x55,
-	0x3a, 0x61, 0x62, 0x85, 0x65, 0x78, 0x47, 0xd3, 0x0b, 0x91, 0xae, 0x2d, 0xc4, 0x7b, 0xbe, 0x50,
-	0x3f, 0x9b, 0xfa, 0x78, 0xc9, 0xf9, 0x32, 0xb2, 0xf0, 0x8b, 0xec, 0x76, 0x24, 0x59, 0x4c, 0x85,
-	0xc4, 0xf1, 0xca, 0x04, 0x78, 0x2f, 0x00, 0x66, 0x12, 0x4b, 0xfa, 0x33, 0x8e, 0x32, 0x8a, 0xbe,
-	0x83, 0xe3, 0xb5, 0x5a, 0xb8, 0xb5, 0x61, 0xed, 0xbc, 0x3f, 0xfe, 0xc2, 0xdf, 0xc1, 0xa6, 0xaf,
-	0x73, 0x02, 0x13, 0xec, 0xfd, 0xd3, 0x00, 0x98, 0x6d, 0xee, 0x50, 0x1f, 0xea, 0x8c, 0x68, 0x84,
-	0x4e, 0x50, 0x67, 0x04, 0x3d, 0x84, 0x13, 0x89, 0xc5, 0xef, 0x73, 0x46, 0xdc, 0xba, 0x3e, 0x6c,
-	0xa9, 0xed, 0x94, 0xa0, 0x47, 0x00, 0x04, 0x4b, 0x2c, 0xa8, 0x54, 0x77, 0x0d, 0x7d, 0xd7, 0xb1,
-	0x27, 0x53, 0x82, 0xce, 0xc0, 0xc1, 0x52, 0x62, 0xc5, 0x63, 0xa2, 0x23, 0x9a, 0x3a, 0xa2, 0x57,
-	0x1c, 0x4e, 0x35, 0x38, 0x5d, 0xe3, 0x48, 0x5d, 0x1f, 0x1b, 0x70, 0xb5, 0x9d, 0x12, 0xf4, 0x0c,
-	0x5a, 0xa6, 0x89, 0x6e, 0x6b, 0x58, 0x3b, 0xef, 0x8e, 0x3f, 0xaf, 0xbe, 0x8b, 0x6d, 0x70, 0xa0,
-	0x1f, 0x81, 0x0d, 0x45, 0x97, 0x00, 0x61, 0x4a, 0xb1, 0xa4, 0x64, 0x8e, 0xa5, 0x7b, 0xa2, 0x13,
-	0x07, 0xbe, 0xe1, 0xd0, 0xcf, 0x39, 0xf4, 0x6f, 0x72, 0x0e, 0x83, 0x8e, 0x8d, 0xbe, 0x92, 0xe8,
-	0x39, 0x74, 0x6f, 0x59, 0xc2, 0xc4, 0x9d, 0xc9, 0x6d, 0xdf, 0x9b, 0x0b, 0x79, 0xf8, 0x95, 0x54,
-	0xbc, 0x0b, 0xc5, 0xa8, 0xdb, 0x39, 0x8c, 0x77, 0x1d, 0x8c, 0x06, 0xd0, 0x8e, 0x70, 0xb2, 0xcc,
-	0xf0, 0x92, 0xba, 0xa0, 0x5f, 0x7e, 0xb3, 0x57, 0xe4, 0x2d, 0x32, 0x16, 0x91, 0x79, 0xc8, 0xe3,
-	0x18, 0x27, 0xc4, 0xed, 0x1a, 0xf2, 0xf4, 0xe1, 0xc4, 0x9c, 0x29, 0xf2, 0x32, 0x41, 0x53, 0x45,
-	0x5e, 0xcf, 0x90, 0xa7, 0xb6, 0x53, 0xa2, 0x90, 0x43, 0x9a, 0x08, 0x9e, 0x52, 0xe2, 0x3a, 0xc3,
-	0xda, 0x79, 0x3b, 0xd8, 0xec, 0xbd, 0x5f, 0xc1, 0x99, 0xe8, 0xb7, 0x0e, 0xe8, 0x1f, 0x19, 0x15,
-	0xb2, 0xdc, 0xdf, 0x5a, 0xa5, 0xbf, 0x08, 0x9a, 0x21, 0x27, 0x54, 0x77, 0xbd, 0x17, 0xe8, 0x75,
-	0xa5, 0xe6, 0x46, 0xb5, 0x66, 0x6f, 0x08, 0xfd, 0x1c, 0x59, 0xac, 0x78, 0x22, 0xe8, 0xf6, 0x28,
-	0x79, 0x6b, 0xe8, 0x06, 0x14, 0x93, 0xfc, 0x9f, 0xb7, 0x27, 0xed, 0x29, 0xf4, 0x59, 0x12, 0x46,
-	0x19, 0xa1, 0x73, 0xdb, 0xfb, 0xba, 0x2e, 0xde, 0xb1, 0xa7, 0xa6, 0xdb, 0xe8, 0x1b, 0x78, 0x90,
-	0x87, 0x49, 0x2a, 0xa4, 0x8d, 0x15, 0xba, 0x9e, 0x76, 0x80, 0xec, 0xdd, 0x0d, 0x15, 0xd2, 0x24,
-	0x08, 0x6f, 0x06, 0x3d, 0xf3, 0xbf, 0xb6, 0xae, 0x09, 0x40, 0xd1, 0x14, 0x5d, 0x40, 0x77, 0x7c,
-	0xb6, 0xbb, 0x69, 0x9b, 0x65, 0x50, 0x4a, 0xf3, 0xbe, 0x07, 0xe7, 0xed, 0x8a, 0x94, 0x88, 0xbc,
-	0x80, 0xc6, 0x7b, 0xbe, 0xb0, 0x70, 0xc5, 0xbc, 0x16, 0x32, 0xf7, 0x95, 0xb0, 0x7f, 0xe4, 0x8b,
-	0x40, 0xc5, 0x79, 0xa7, 0xd0, 0xcf, 0xf3, 0x4d, 0x59, 0xde, 0x63, 0x70, 0x5e, 0xd2, 0x88, 0x16,
-	0x88, 0xdb, 0xfc, 0x9d, 0x42, 0x3f, 0x0f, 0xb0, 0x29, 0x7f, 0x35, 0xc1, 0x99, 0x69, 0xb7, 0xca,
-	0x73, 0x1e, 0xc0, 0x71, 0xc4, 0x62, 0x26, 0x75, 0x9a, 0x13, 0x98, 0x0d, 0xfa, 0x0c, 0x5a, 0xfc,
-	0xf6, 0x56, 0x50, 0x43, 0xa9, 0x13, 0xd8, 0x5d, 0xb9, 0xf9, 0x8d, 0x3d, 0xe2, 0x6e, 0x6e, 0x8b,
-	0x7b, 0xa7, 0x6e, 0x2f, 0x73, 0x29, 0xb4, 0xee, 0x63, 0x75, 0x63, 0x5b, 0xff, 0xa7, 0x87, 0x93,
-	0x2d, 0x3d, 0x4c, 0x2a, 0xca, 0x36, 0xea, 0x7c, 0x52, 0xc5, 0xce, 0x4d, 0xb7, 0x90, 0xa8, 0xda,
-	0x96, 0x35, 0x7e, 0x5d, 0xd5, 0x78, 0xe7, 0x23, 0x50, 0xca, 0x6a, 0x3f, 0x03, 0x87, 0xa6, 0x29,
-	0x4f, 0xe7, 0x31, 0x15, 0xa2, 0x10, 0x6f, 0x4f, 0x1f, 0xbe, 0x32, 0x67, 0x68, 0x04, 0x9f, 0x86,
-	0x3c, 0x5e, 0xb1, 0x08, 0x4b, 0xc6, 0x93, 0x4d, 0xa8, 0x91, 0x31, 0x2a, 0x5d, 0xe5, 0x09, 0xff,
-	0x1d, 0xfe, 0xde, 0xc7, 0x0c, 0xbf, 0xb3, 0x6b, 0xf8, 0xcb, 0x2e, 0xd1, 0x2f, 0xbb, 0x84, 0xf7,
-	0x67, 0x0d, 0xfa, 0xf9, 0xec, 0x58, 0x61, 0x5c, 0x43, 0xb7, 0x68, 0x91, 0x70, 0x6b, 0xc3, 0xc6,
-	0xa1, 0xca, 0x28, 0xe7, 0xa1, 0x6f, 0xa1, 0xa9, 0x3e, 0x9e, 0x7a, 0xd6, 0xba, 0xe3, 0x47, 0x5b,
-	0xf9, 0xc5, 0xc7, 0xf5, 0x15, 0x95, 0x38, 0xd0, 0xa1, 0x5f, 0xfb, 0x70, 0xac, 0x27, 0x02, 0x75,
-	0xe1, 0xe4, 0x97, 0xab, 0xe9, 0xcd, 0xf4, 0xf5, 0x0f, 0xa7, 0x47, 0xa8, 0x0f, 0xf0, 0x53, 0xf0,
-	0x66, 0x72, 0x3d, 0x9b, 0xa9, 0x7d, 0x0d, 0xb5, 0xa1, 0xf9, 0xf2, 0xcd, 0xeb, 0xeb, 0xd3, 0xfa,
-	0xf8, 0xef, 0x06, 0x7c, 0x52, 0xfc, 0xfd, 0x8c, 0xa6, 0x6b, 0x16, 0x52, 0xf4, 0x0e, 0x5a, 0xc6,
-	0x82, 0xd0, 0x97, 0x3b, 0x8b, 0xae, 0xb8, 0xdf, 0xe0, 0xab, 0x7b, 0xe3, 0xac, 0xd2, 0x8e, 0xd0,
-	0x5b, 0x68, 0x2a, 0x17, 0x41, 0x4f, 0x76, 0xa6, 0x94, 0xcc, 0x6d, 0xf0, 0xf4, 0x9e, 0xa8, 0x0d,
-	0xec, 0x3b, 0x68, 0x19, 0x1f, 0xd8, 0x53, 0x73, 0xc5, 0x68, 0xf6, 0xd4, 0xbc, 0x65, 0x28, 0x1a,
-	0xdc, 0x38, 0xc6, 0x1e, 0xf0, 0x8a, 0xe7, 0xec, 0x01, 0xdf, 0xb2, 0x1e, 0x0d, 0x6e, 0xe6, 0x67,
-	0x0f, 0x78, 0xc5, 0x9c, 0xf6, 0x80, 0x57, 0x07, 0xd1, 0x3b, 0x7a, 0xd1, 0xfb, 0xad, 0x64, 0xb6,
-	0x8b, 0x96, 0xfe, 0x02, 0x3f, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x86, 0x6d, 0x53, 0x22, 0x63,
-	0x0a, 0x00, 0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x8e, 0xfe, 0xa5, 0x91, 0xa8, 0xba, 0xdb, 0xa0, 0x21, 0x54, 0xa4, 0x51, 0xe8, 0xa4, 0x36,
+	0x0a, 0x98, 0x6a, 0x95, 0x5e, 0x0c, 0x9f, 0x1c, 0xc5, 0x28, 0x54, 0x34, 0x49, 0x41, 0x39, 0x69,
+	0xd1, 0x1c, 0x84, 0x15, 0x77, 0x25, 0x6f, 0xca, 0x1f, 0x95, 0xbb, 0x14, 0x82, 0x5e, 0x7b, 0xeb,
+	0x13, 0xf4, 0xe9, 0xfa, 0x2c, 0xc5, 0xfe, 0x50, 0x22, 0x95, 0xc8, 0x92, 0x0f, 0x06, 0x77, 0x66,
+	0xbe, 0xf9, 0x38, 0x9c, 0x9d, 0xf9, 0x64, 0xb8, 0x5c, 0x30, 0x71, 0x93, 0xce, 0x5c, 0x3f, 0x0e,
+	0x07, 0x1f, 0x42, 0xff, 0x8c, 0xd0, 0x95, 0x7c, 0xaa, 0xb3, 0x1f, 0x27, 0x74, 0xb0, 0x4c, 0x62,
+	0x11, 0x0f, 0x78, 0x3a, 0x0b, 0x19, 0xe7, 0x2c, 0x8e, 0x72, 0x47, 0x57, 0xc5, 0xd0, 0x83, 0x0f,
+	0xa1, 0xef, 0xf2, 0x64, 0xe5, 0x4a, 0xbc, 0xbb, 0x09, 0xf7, 0xce, 0x0f, 0xe3, 0x4e, 0x28, 0x4f,
+	0x03, 0x61, 0x1e, 0x9a, 0xb3, 0x77, 0x68, 0x59, 0x14, 0x27, 0xfe, 0x4d, 0x48, 0x05, 0xce, 0x1d,
+	0x0d, 0xc5, 0xc5, 0x61, 0x14, 0x82, 0x27, 0x38, 0x5a, 0xd0, 0xec, 0x69, 0x92, 0x77, 0x95, 0x4e,
+	0x18, 0x5f, 0x62, 0xe1, 0xdf, 0xd0, 0xe4, 0x8c, 0x27, 0x2b, 0x43, 0xf1, 0x3e, 0x9e, 0xc9, 0x3f,
+	0x93, 0xfa, 0x68, 0x11, 0xc7, 0x8b, 0xc0, 0xd0, 0xcf, 0xd2, 0xf9, 0x40, 0xb0, 0x90, 0x72, 0x81,
+	0xc3, 0xa5, 0x06, 0x38, 0xcf, 0x01, 0x26, 0x02, 0x0b, 0xfa, 0x16, 0x07, 0x29, 0x45, 0x3f, 0x40,
+	0x6d, 0x25, 0x0f, 0x76, 0xa9, 0x5f, 0x3a, 0xed, 0x0e, 0xbf, 0x76, 0x77, 0x74, 0xd3, 0x55, 0x39,
+	0x9e, 0x06, 0x3b, 0x7f, 0x57, 0x01, 0x26, 0xeb, 0x18, 0xea, 0x42, 0x99, 0x11, 0xc5, 0xd0, 0xf2,
+	0xca, 0x8c, 0xa0, 0x07, 0xd0, 0x10, 0x98, 0xff, 0x31, 0x65, 0xc4, 0x2e, 0x2b, 0x67, 0x5d, 0x9a,
+	0x63, 0x82, 0x1e, 0x02, 0x10, 0x2c, 0x30, 0xa7, 0x42, 0xc6, 0x2a, 0x2a, 0xd6, 0x32, 0x9e, 0x31,
+	0x41, 0xc7, 0x60, 0x61, 0x21, 0xb0, 0xec, 0x63, 0xa4, 0x10, 0x55, 0x85, 0xe8, 0x6c, 0x9c, 0x63,
+	0x45, 0x4e, 0x57, 0x38, 0x90, 0xe1, 0x9a, 0x26, 0x97, 0xe6, 0x98, 0xa0, 0x67, 0x50, 0xd7, 0x97,
+	0x68, 0xd7, 0xfb, 0xa5, 0xd3, 0xf6, 0xf0, 0xab, 0xe2, 0xb7, 0xe8, 0x98, 0xeb, 0xa9, 0x87, 0x67,
+	0xa0, 0xe8, 0x1c, 0xc0, 0x4f, 0x28, 0x16, 0x94, 0x4c, 0xb1, 0xb0, 0x1b, 0x2a, 0xb1, 0xe7, 0xea,
+	0x1e, 0xba, 0x59, 0x0f, 0xdd, 0xeb, 0xac, 0x87, 0x5e, 0xcb, 0xa0, 0x2f, 0x05, 0xba, 0x80, 0xf6,
+	0x9c, 0x45, 0x8c, 0xdf, 0xe8, 0xdc, 0xe6, 0xde, 0x5c, 0xc8, 0xe0, 0x97, 0x42, 0xf6, 0x9d, 0xcb,
+	0x8e, 0xda, 0xad, 0xc3, 0xfa, 0xae, 0xc0, 0xa8, 0x07, 0xcd, 0x00, 0x47, 0x8b, 0x14, 0x2f, 0xa8,
+	0x0d, 0xea, 0xe3, 0xd7, 0xb6, 0x6c, 0xde, 0x2c, 0x65, 0x01, 0x99, 0xfa, 0x71, 0x18, 0xe2, 0x88,
+	0xd8, 0x6d, 0xdd, 0x3c, 0xe5, 0x1c, 0x69, 0x9f, 0x6c, 0x5e, 0xca, 0x69, 0x22, 0x9b, 0xd7, 0xd1,
+	0xcd, 0x93, 0xe6, 0x98, 0x48, 0x66, 0x9f, 0x46, 0x3c, 0x4e, 0x28, 0xb1, 0xad, 0x7e, 0xe9, 0xb4,
+	0xe9, 0xad, 0x6d, 0xf4, 0x25, 0xd4, 0xe7, 0x49, 0xfc, 0x17, 0x8d, 0xec, 0xae, 0x8a, 0x18, 0xcb,
+	0xf9, 0x0d, 0xac, 0x91, 0xea, 0x86, 0x47, 0xff, 0x4c, 0x29, 0x17, 0xf9, 0x7b, 0x2f, 0x15, 0xee,
+	0x1d, 0x41, 0xd5, 0x8f, 0x09, 0x55, 0xd3, 0xd0, 0xf1, 0xd4, 0xb9, 0xf0, 0x2d, 0x95, 0xe2, 0xb7,
+	0x38, 0x7d, 0xe8, 0x66, 0xcc, 0x7c, 0x19, 0x47, 0x9c, 0x6e, 0x8f, 0x98, 0xb3, 0x82, 0xb6, 0x47,
+	0x31, 0xc9, 0xde, 0xbc, 0x3d, 0x81, 0x4f, 0xa1, 0xcb, 0x22, 0x3f, 0x48, 0x09, 0x9d, 0x9a, 0x99,
+	0x28, 0xab, 0xd2, 0x2d, 0xe3, 0xd5, 0x53, 0x80, 0xbe, 0x83, 0xfb, 0x19, 0x4c, 0x50, 0x2e, 0x0c,
+	0x96, 0xab, 0x7a, 0x9a, 0x1e, 0x32, 0xb1, 0x6b, 0xca, 0x85, 0x4e, 0xe0, 0xce, 0x04, 0x3a, 0xfa,
+	0xbd, 0xa6, 0xae, 0x11, 0xc0, 0xe6, 0xb2, 0x54, 0x01, 0xed, 0xe1, 0xf1, 0xee, 0xcb, 0x5c, 0x1f,
+	0xbd, 0x5c, 0x9a, 0x83, 0xc1, 0x7a, 0xb3, 0x24, 0xb9, 0x46, 0x9e, 0x41, 0xe5, 0x7d, 0x3c, 0xb3,
+	0x4b, 0x5b, 0x73, 0xbc, 0x59, 0x7f, 0x57, 0x2e, 0xfc, 0x4f, 0xf1, 0xcc, 0x93, 0x38, 0xf4, 0x18,
+	0x3a, 0x09, 0xe5, 0x34, 0xab, 0xdf, 0x7c, 0x6b, 0x5b, 0xf9, 0x74, 0xe1, 0xce, 0x11, 0x74, 0xb3,
+	0x57, 0xe8, 0xca, 0x9d, 0x47, 0x60, 0xbd, 0xa0, 0x01, 0x15, 0x74, 0x47, 0x0f, 0x65, 0x4a, 0x06,
+	0x30, 0x29, 0xff, 0xd6, 0xc0, 0x9a, 0x28, 0xa1, 0xcb, 0x72, 0xee, 0x43, 0x2d, 0x60, 0x21, 0x13,
+	0x2a, 0xcd, 0xf2, 0xb4, 0x21, 0x07, 0x26, 0x9e, 0xcf, 0x39, 0xd5, 0x95, 0x58, 0x9e, 0xb1, 0xf2,
+	0xf3, 0x51, 0xb9, 0x45, 0x17, 0xaa, 0xdb, 0xba, 0xb0, 0x73, 0xe5, 0xcf, 0xb3, 0x2d, 0xaa, 0xef,
+	0x6b, 0xfc, 0x5a, 0xf1, 0x3e, 0xb5, 0x4a, 0x8d, 0xad, 0x55, 0x1a, 0x15, 0x44, 0x41, 0x2f, 0xf6,
+	0x93, 0x22, 0x77, 0xa6, 0xd7, 0x9b, 0xed, 0x96, 0x66, 0x5e, 0x1e, 0xae, 0x8a, 0xf2, 0xd0, 0xba,
+	0x03, 0x4b, 0x5e, 0x28, 0x8e, 0xc1, 0xa2, 0x49, 0x12, 0x27, 0xd3, 0x90, 0x72, 0xbe, 0xd9, 0xfb,
+	0x8e, 0x72, 0xbe, 0xd4, 0x3e, 0x34, 0x80, 0x2f, 0xfc, 0x38, 0x5c, 0xb2, 0x00, 0x0b, 0x16, 0x47,
+	0x6b, 0xa8, 0x56, 0x00, 0x94, 0x0b, 0x65, 0x09, 0x1f, 0xef, 0x47, 0xe7, 0x2e, 0xfb, 0x61, 0xed,
+	0xda, 0x8f, 0xbc, 0xc0, 0x74, 0x0b, 0x02, 0x73, 0x01, 0x8d, 0x15, 0x4d, 0x08, 0xf3, 0x85, 0xfd,
+	0x99, 0x6a, 0xc5, 0xe3, 0x4f, 0xca, 0xf3, 0x5b, 0x8d, 0xd1, 0x57, 0x95, 0x65, 0xa0, 0x3e, 0x74,
+	0xd4, 0xe0, 0x04, 0x8c, 0xab, 0x09, 0x39, 0x52, 0xd4, 0x20, 0x7d, 0x3f, 0x33, 0x2e, 0xc6, 0xc4,
+	0xf9, 0xa7, 0x04, 0xdd, 0x6c, 0x34, 0xcd, 0x6a, 0x5e, 0x41, 0x7b, 0x33, 0x01, 0xdc, 0x2e, 0xf5,
+	0x2b, 0x87, 0xee, 0x66, 0x3e, 0x0f, 0x7d, 0x0f, 0x55, 0xf9, 0xb3, 0xae, 0x46, 0xb9, 0x3d, 0x7c,
+	0xb8, 0x95, 0xbf, 0xf9, 0xd9, 0x7f, 0x49, 0x05, 0xf6, 0x14, 0xf4, 0x5b, 0x17, 0x6a, 0x6a, 0xe0,
+	0x50, 0x1b, 0x1a, 0xbf, 0x5e, 0x8e, 0xaf, 0xc7, 0xaf, 0x7e, 0x3c, 0xba, 0x87, 0xba, 0x00, 0xbf,
+	0x78, 0xaf, 0x47, 0x57, 0x93, 0x89, 0xb4, 0x4b, 0xa8, 0x09, 0xd5, 0x17, 0xaf, 0x5f, 0x5d, 0x1d,
+	0x95, 0x87, 0xff, 0x55, 0xe0, 0xf3, 0xcd, 0xeb, 0x27, 0x34, 0x59, 0x31, 0x9f, 0xa2, 0x77, 0x50,
+	0xd7, 0x22, 0x88, 0xbe, 0xd9, 0x59, 0x74, 0x41, 0x7f, 0x7b, 0x27, 0x7b, 0x71, 0x66, 0x91, 0xef,
+	0xa1, 0x37, 0x50, 0x95, 0x3a, 0x86, 0x9e, 0xec, 0x4c, 0xc9, 0xc9, 0x6b, 0xef, 0xe9, 0x1e, 0xd4,
+	0x9a, 0xf6, 0x1d, 0xd4, 0xb5, 0xcc, 0xdc, 0x52, 0x73, 0x41, 0xea, 0x7a, 0x27, 0x7b, 0x71, 0x79,
+	0x72, 0x2d, 0x48, 0xb7, 0x90, 0x17, 0x24, 0xad, 0x77, 0xb2, 0x17, 0x97, 0x27, 0xd7, 0xf3, 0x73,
+	0x0b, 0x79, 0x41, 0xfb, 0x7a, 0x27, 0x7b, 0x71, 0x19, 0xf9, 0xf3, 0xce, 0xef, 0x39, 0xb9, 0x9f,
+	0xd5, 0xd5, 0xff, 0x06, 0xcf, 0xfe, 0x1f, 0x00, 0xe2, 0x75, 0xaf, 0xe3, 0xfd, 0x0a, 0x00, 0x00,
 }
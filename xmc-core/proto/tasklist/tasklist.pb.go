@@ -27,13 +27,29 @@ It has these top-level messages:
 	CancelParticipationResponse
 	GetParticipantsRequest
 	GetParticipantsResponse
+	Participant
+	Participation
+	StartParticipationRequest
+	StartParticipationResponse
+	ReadParticipationRequest
+	ReadParticipationResponse
+	ScoringModeValue
+	ScoreboardTask
+	TaskScore
+	ScoreboardEntry
+	GetScoreboardRequest
+	GetScoreboardResponse
+	RevealScoreboardRequest
+	RevealScoreboardResponse
 */
 package tasklist
 
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import google_protobuf "github.com/golang/protobuf/ptypes/wrappers"
+import google_protobuf "github.com/golang/protobuf/ptypes/duration"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
+import google_protobuf2 "github.com/golang/protobuf/ptypes/wrappers"
 import xmc_srv_core_tsrange "github.com/xmc-dev/xmc/xmc-core/proto/tsrange"
 import xmc_srv_core_searchmeta "github.com/xmc-dev/xmc/xmc-core/proto/searchmeta"
 
@@ -54,6 +70,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ScoringMode is the way the score of a participant on a task is computed from their submissions
+type ScoringMode int32
+
+const (
+	// the best score of the submissions
+	ScoringMode_IOI_MAX_SCORE ScoringMode = 0
+	// the score of the last submission
+	ScoringMode_IOI_LAST_SCORE ScoringMode = 1
+	// a task is solved if a submission is accepted, participants are ranked by the number of solved tasks
+	// and then by the penalty time. Requires a time range.
+	ScoringMode_ICPC ScoringMode = 2
+)
+
+var ScoringMode_name = map[int32]string{
+	0: "IOI_MAX_SCORE",
+	1: "IOI_LAST_SCORE",
+	2: "ICPC",
+}
+var ScoringMode_value = map[string]int32{
+	"IOI_MAX_SCORE":  0,
+	"IOI_LAST_SCORE": 1,
+	"ICPC":           2,
+}
+
+func (x ScoringMode) String() string {
+	return proto.EnumName(ScoringMode_name, int32(x))
+}
+func (ScoringMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type TaskList struct {
 	Id                 string                               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Name               string                               `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
@@ -64,6 +109,22 @@ type TaskList struct {
 	PublicSubmissions  bool                                 `protobuf:"varint,7,opt,name=public_submissions,json=publicSubmissions" json:"public_submissions,omitempty"`
 	Path               string                               `protobuf:"bytes,8,opt,name=path" json:"path,omitempty"`
 	WithParticipations bool                                 `protobuf:"varint,9,opt,name=with_participations,json=withParticipations" json:"with_participations,omitempty"`
+	// how the scoreboard is computed
+	ScoringMode ScoringMode `protobuf:"varint,10,opt,name=scoring_mode,json=scoringMode,enum=xmc.srv.core.tasklist.ScoringMode" json:"scoring_mode,omitempty"`
+	// the results of the submissions made from the freeze time until the end of the task list
+	// are hidden from the users that don't manage submissions. It must be in the time range
+	// and it can't be used with participation_duration.
+	FreezeTime *google_protobuf1.Timestamp `protobuf:"bytes,11,opt,name=freeze_time,json=freezeTime" json:"freeze_time,omitempty"`
+	// the hidden results of the submissions made before this time are revealed.
+	// It is set by RevealScoreboard and it is unset while the scoreboard is fully frozen.
+	RevealedUntil *google_protobuf1.Timestamp `protobuf:"bytes,12,opt,name=revealed_until,json=revealedUntil" json:"revealed_until,omitempty"`
+	// if it is set, each participant has their own time window that lasts this long, or until the end time.
+	// The window begins when the participant calls StartParticipation.
+	ParticipationDuration *google_protobuf.Duration `protobuf:"bytes,13,opt,name=participation_duration,json=participationDuration" json:"participation_duration,omitempty"`
+	// if it is set, users can participate only in this time range
+	RegistrationTimeRange *xmc_srv_core_tsrange.TimestampRange `protobuf:"bytes,14,opt,name=registration_time_range,json=registrationTimeRange" json:"registration_time_range,omitempty"`
+	// zero means no limit
+	MaxParticipants uint32 `protobuf:"varint,15,opt,name=max_participants,json=maxParticipants" json:"max_participants,omitempty"`
 }
 
 func (m *TaskList) Reset()                    { *m = TaskList{} }
@@ -134,6 +195,48 @@ func (m *TaskList) GetWithParticipations() bool {
 	return false
 }
 
+func (m *TaskList) GetScoringMode() ScoringMode {
+	if m != nil {
+		return m.ScoringMode
+	}
+	return ScoringMode_IOI_MAX_SCORE
+}
+
+func (m *TaskList) GetFreezeTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.FreezeTime
+	}
+	return nil
+}
+
+func (m *TaskList) GetRevealedUntil() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.RevealedUntil
+	}
+	return nil
+}
+
+func (m *TaskList) GetParticipationDuration() *google_protobuf.Duration {
+	if m != nil {
+		return m.ParticipationDuration
+	}
+	return nil
+}
+
+func (m *TaskList) GetRegistrationTimeRange() *xmc_srv_core_tsrange.TimestampRange {
+	if m != nil {
+		return m.RegistrationTimeRange
+	}
+	return nil
+}
+
+func (m *TaskList) GetMaxParticipants() uint32 {
+	if m != nil {
+		return m.MaxParticipants
+	}
+	return 0
+}
+
 type CreateRequest struct {
 	TaskList *TaskList `protobuf:"bytes,1,opt,name=task_list,json=taskList" json:"task_list,omitempty"`
 }
@@ -237,8 +340,17 @@ type UpdateRequest struct {
 	TimeRange          *xmc_srv_core_tsrange.TimestampRange `protobuf:"bytes,4,opt,name=time_range,json=timeRange" json:"time_range,omitempty"`
 	SetNullTime        bool                                 `protobuf:"varint,5,opt,name=set_null_time,json=setNullTime" json:"set_null_time,omitempty"`
 	Title              string                               `protobuf:"bytes,6,opt,name=title" json:"title,omitempty"`
-	PublicSubmissions  *google_protobuf.BoolValue           `protobuf:"bytes,7,opt,name=public_submissions,json=publicSubmissions" json:"public_submissions,omitempty"`
-	WithParticipations *google_protobuf.BoolValue           `protobuf:"bytes,8,opt,name=with_participations,json=withParticipations" json:"with_participations,omitempty"`
+	PublicSubmissions  *google_protobuf2.BoolValue          `protobuf:"bytes,7,opt,name=public_submissions,json=publicSubmissions" json:"public_submissions,omitempty"`
+	WithParticipations *google_protobuf2.BoolValue          `protobuf:"bytes,8,opt,name=with_participations,json=withParticipations" json:"with_participations,omitempty"`
+	ScoringMode        *ScoringModeValue                    `protobuf:"bytes,9,opt,name=scoring_mode,json=scoringMode" json:"scoring_mode,omitempty"`
+	// changing the freeze time freezes the scoreboard again
+	FreezeTime        *google_protobuf1.Timestamp `protobuf:"bytes,10,opt,name=freeze_time,json=freezeTime" json:"freeze_time,omitempty"`
+	SetNullFreezeTime bool                        `protobuf:"varint,11,opt,name=set_null_freeze_time,json=setNullFreezeTime" json:"set_null_freeze_time,omitempty"`
+	// a zero duration removes the personal time windows
+	ParticipationDuration   *google_protobuf.Duration            `protobuf:"bytes,12,opt,name=participation_duration,json=participationDuration" json:"participation_duration,omitempty"`
+	RegistrationTimeRange   *xmc_srv_core_tsrange.TimestampRange `protobuf:"bytes,13,opt,name=registration_time_range,json=registrationTimeRange" json:"registration_time_range,omitempty"`
+	SetNullRegistrationTime bool                                 `protobuf:"varint,14,opt,name=set_null_registration_time,json=setNullRegistrationTime" json:"set_null_registration_time,omitempty"`
+	MaxParticipants         *google_protobuf2.UInt32Value        `protobuf:"bytes,15,opt,name=max_participants,json=maxParticipants" json:"max_participants,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
@@ -288,20 +400,69 @@ func (m *UpdateRequest) GetTitle() string {
 	return ""
 }
 
-func (m *UpdateRequest) GetPublicSubmissions() *google_protobuf.BoolValue {
+func (m *UpdateRequest) GetPublicSubmissions() *google_protobuf2.BoolValue {
 	if m != nil {
 		return m.PublicSubmissions
 	}
 	return nil
 }
 
-func (m *UpdateRequest) GetWithParticipations() *google_protobuf.BoolValue {
+func (m *UpdateRequest) GetWithParticipations() *google_protobuf2.BoolValue {
 	if m != nil {
 		return m.WithParticipations
 	}
 	return nil
 }
 
+func (m *UpdateRequest) GetScoringMode() *ScoringModeValue {
+	if m != nil {
+		return m.ScoringMode
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetFreezeTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.FreezeTime
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetSetNullFreezeTime() bool {
+	if m != nil {
+		return m.SetNullFreezeTime
+	}
+	return false
+}
+
+func (m *UpdateRequest) GetParticipationDuration() *google_protobuf.Duration {
+	if m != nil {
+		return m.ParticipationDuration
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetRegistrationTimeRange() *xmc_srv_core_tsrange.TimestampRange {
+	if m != nil {
+		return m.RegistrationTimeRange
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetSetNullRegistrationTime() bool {
+	if m != nil {
+		return m.SetNullRegistrationTime
+	}
+	return false
+}
+
+func (m *UpdateRequest) GetMaxParticipants() *google_protobuf2.UInt32Value {
+	if m != nil {
+		return m.MaxParticipants
+	}
+	return nil
+}
+
 type UpdateResponse struct {
 }
 
@@ -349,9 +510,9 @@ type SearchRequest struct {
 	Description        string                               `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
 	TimeRange          *xmc_srv_core_tsrange.TimestampRange `protobuf:"bytes,5,opt,name=time_range,json=timeRange" json:"time_range,omitempty"`
 	Title              string                               `protobuf:"bytes,6,opt,name=title" json:"title,omitempty"`
-	IsPermanent        *google_protobuf.BoolValue           `protobuf:"bytes,7,opt,name=is_permanent,json=isPermanent" json:"is_permanent,omitempty"`
-	PublicSubmissions  *google_protobuf.BoolValue           `protobuf:"bytes,8,opt,name=public_submissions,json=publicSubmissions" json:"public_submissions,omitempty"`
-	WithParticipations *google_protobuf.BoolValue           `protobuf:"bytes,9,opt,name=with_participations,json=withParticipations" json:"with_participations,omitempty"`
+	IsPermanent        *google_protobuf2.BoolValue          `protobuf:"bytes,7,opt,name=is_permanent,json=isPermanent" json:"is_permanent,omitempty"`
+	PublicSubmissions  *google_protobuf2.BoolValue          `protobuf:"bytes,8,opt,name=public_submissions,json=publicSubmissions" json:"public_submissions,omitempty"`
+	WithParticipations *google_protobuf2.BoolValue          `protobuf:"bytes,9,opt,name=with_participations,json=withParticipations" json:"with_participations,omitempty"`
 }
 
 func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
@@ -401,21 +562,21 @@ func (m *SearchRequest) GetTitle() string {
 	return ""
 }
 
-func (m *SearchRequest) GetIsPermanent() *google_protobuf.BoolValue {
+func (m *SearchRequest) GetIsPermanent() *google_protobuf2.BoolValue {
 	if m != nil {
 		return m.IsPermanent
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetPublicSubmissions() *google_protobuf.BoolValue {
+func (m *SearchRequest) GetPublicSubmissions() *google_protobuf2.BoolValue {
 	if m != nil {
 		return m.PublicSubmissions
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetWithParticipations() *google_protobuf.BoolValue {
+func (m *SearchRequest) GetWithParticipations() *google_protobuf2.BoolValue {
 	if m != nil {
 		return m.WithParticipations
 	}
@@ -512,6 +673,8 @@ func (m *GetParticipantsRequest) GetTaskListId() string {
 
 type GetParticipantsResponse struct {
 	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds" json:"user_ids,omitempty"`
+	// in the same order as user_ids
+	Participants []*Participant `protobuf:"bytes,2,rep,name=participants" json:"participants,omitempty"`
 }
 
 func (m *GetParticipantsResponse) Reset()                    { *m = GetParticipantsResponse{} }
@@ -526,6 +689,470 @@ func (m *GetParticipantsResponse) GetUserIds() []string {
 	return nil
 }
 
+func (m *GetParticipantsResponse) GetParticipants() []*Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+type Participant struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// the username and the name of the account
+	ClientId     string                      `protobuf:"bytes,2,opt,name=client_id,json=clientId" json:"client_id,omitempty"`
+	Name         string                      `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	RegisteredAt *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=registered_at,json=registeredAt" json:"registered_at,omitempty"`
+	// the personal time window, unset until the participation is started
+	StartTime *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	EndTime   *google_protobuf1.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+}
+
+func (m *Participant) Reset()                    { *m = Participant{} }
+func (m *Participant) String() string            { return proto.CompactTextString(m) }
+func (*Participant) ProtoMessage()               {}
+func (*Participant) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *Participant) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Participant) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Participant) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Participant) GetRegisteredAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return nil
+}
+
+func (m *Participant) GetStartTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Participant) GetEndTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type Participation struct {
+	TaskListId string `protobuf:"bytes,1,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// the personal time window, unset until the participation is started
+	StartTime    *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	EndTime      *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime" json:"end_time,omitempty"`
+	RegisteredAt *google_protobuf1.Timestamp `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt" json:"registered_at,omitempty"`
+}
+
+func (m *Participation) Reset()                    { *m = Participation{} }
+func (m *Participation) String() string            { return proto.CompactTextString(m) }
+func (*Participation) ProtoMessage()               {}
+func (*Participation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *Participation) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+func (m *Participation) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Participation) GetStartTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Participation) GetEndTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *Participation) GetRegisteredAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return nil
+}
+
+type StartParticipationRequest struct {
+	TaskListId string `protobuf:"bytes,1,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+}
+
+func (m *StartParticipationRequest) Reset()                    { *m = StartParticipationRequest{} }
+func (m *StartParticipationRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartParticipationRequest) ProtoMessage()               {}
+func (*StartParticipationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *StartParticipationRequest) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+type StartParticipationResponse struct {
+	Participation *Participation `protobuf:"bytes,1,opt,name=participation" json:"participation,omitempty"`
+}
+
+func (m *StartParticipationResponse) Reset()                    { *m = StartParticipationResponse{} }
+func (m *StartParticipationResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartParticipationResponse) ProtoMessage()               {}
+func (*StartParticipationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *StartParticipationResponse) GetParticipation() *Participation {
+	if m != nil {
+		return m.Participation
+	}
+	return nil
+}
+
+type ReadParticipationRequest struct {
+	TaskListId string `protobuf:"bytes,1,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+}
+
+func (m *ReadParticipationRequest) Reset()                    { *m = ReadParticipationRequest{} }
+func (m *ReadParticipationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadParticipationRequest) ProtoMessage()               {}
+func (*ReadParticipationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *ReadParticipationRequest) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+type ReadParticipationResponse struct {
+	Participation *Participation `protobuf:"bytes,1,opt,name=participation" json:"participation,omitempty"`
+}
+
+func (m *ReadParticipationResponse) Reset()                    { *m = ReadParticipationResponse{} }
+func (m *ReadParticipationResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadParticipationResponse) ProtoMessage()               {}
+func (*ReadParticipationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *ReadParticipationResponse) GetParticipation() *Participation {
+	if m != nil {
+		return m.Participation
+	}
+	return nil
+}
+
+type ScoringModeValue struct {
+	Value ScoringMode `protobuf:"varint,1,opt,name=value,enum=xmc.srv.core.tasklist.ScoringMode" json:"value,omitempty"`
+}
+
+func (m *ScoringModeValue) Reset()                    { *m = ScoringModeValue{} }
+func (m *ScoringModeValue) String() string            { return proto.CompactTextString(m) }
+func (*ScoringModeValue) ProtoMessage()               {}
+func (*ScoringModeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *ScoringModeValue) GetValue() ScoringMode {
+	if m != nil {
+		return m.Value
+	}
+	return ScoringMode_IOI_MAX_SCORE
+}
+
+type ScoreboardTask struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Title string `protobuf:"bytes,3,opt,name=title" json:"title,omitempty"`
+}
+
+func (m *ScoreboardTask) Reset()                    { *m = ScoreboardTask{} }
+func (m *ScoreboardTask) String() string            { return proto.CompactTextString(m) }
+func (*ScoreboardTask) ProtoMessage()               {}
+func (*ScoreboardTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *ScoreboardTask) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ScoreboardTask) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ScoreboardTask) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+type TaskScore struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId" json:"task_id,omitempty"`
+	// in ICPC mode the score is 1 if the task is solved and 0 otherwise
+	Score string `protobuf:"bytes,2,opt,name=score" json:"score,omitempty"`
+	// number of submissions up to and including the one that got the score
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts" json:"attempts,omitempty"`
+	// time from the start of the task list to the submission that got the score
+	Time *google_protobuf.Duration `protobuf:"bytes,4,opt,name=time" json:"time,omitempty"`
+	// empty if the participant has no submissions for the task
+	SubmissionId string `protobuf:"bytes,5,opt,name=submission_id,json=submissionId" json:"submission_id,omitempty"`
+	// number of submissions whose results are hidden because the scoreboard is frozen
+	Pending uint32 `protobuf:"varint,6,opt,name=pending" json:"pending,omitempty"`
+}
+
+func (m *TaskScore) Reset()                    { *m = TaskScore{} }
+func (m *TaskScore) String() string            { return proto.CompactTextString(m) }
+func (*TaskScore) ProtoMessage()               {}
+func (*TaskScore) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *TaskScore) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *TaskScore) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
+func (m *TaskScore) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *TaskScore) GetTime() *google_protobuf.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *TaskScore) GetSubmissionId() string {
+	if m != nil {
+		return m.SubmissionId
+	}
+	return ""
+}
+
+func (m *TaskScore) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+type ScoreboardEntry struct {
+	// participants that are tied have the same rank
+	Rank   uint32 `protobuf:"varint,1,opt,name=rank" json:"rank,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// in ICPC mode the total is the number of solved tasks
+	Total string `protobuf:"bytes,3,opt,name=total" json:"total,omitempty"`
+	// the time of the last submission that got a task score
+	Time *google_protobuf.Duration `protobuf:"bytes,4,opt,name=time" json:"time,omitempty"`
+	// number of submissions made before the ones that got the task scores.
+	// In ICPC mode it is the penalty time in minutes: the sum of the times of the accepted submissions
+	// plus 20 minutes for each rejected submission before them
+	Penalty uint32 `protobuf:"varint,5,opt,name=penalty" json:"penalty,omitempty"`
+	// in the same order as the tasks of the scoreboard
+	TaskScores []*TaskScore `protobuf:"bytes,6,rep,name=task_scores,json=taskScores" json:"task_scores,omitempty"`
+}
+
+func (m *ScoreboardEntry) Reset()                    { *m = ScoreboardEntry{} }
+func (m *ScoreboardEntry) String() string            { return proto.CompactTextString(m) }
+func (*ScoreboardEntry) ProtoMessage()               {}
+func (*ScoreboardEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *ScoreboardEntry) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *ScoreboardEntry) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ScoreboardEntry) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *ScoreboardEntry) GetTime() *google_protobuf.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *ScoreboardEntry) GetPenalty() uint32 {
+	if m != nil {
+		return m.Penalty
+	}
+	return 0
+}
+
+func (m *ScoreboardEntry) GetTaskScores() []*TaskScore {
+	if m != nil {
+		return m.TaskScores
+	}
+	return nil
+}
+
+type GetScoreboardRequest struct {
+	TaskListId string `protobuf:"bytes,1,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+	Limit      uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Offset     uint32 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *GetScoreboardRequest) Reset()                    { *m = GetScoreboardRequest{} }
+func (m *GetScoreboardRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetScoreboardRequest) ProtoMessage()               {}
+func (*GetScoreboardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GetScoreboardRequest) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+func (m *GetScoreboardRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetScoreboardRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type GetScoreboardResponse struct {
+	Tasks   []*ScoreboardTask             `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
+	Entries []*ScoreboardEntry            `protobuf:"bytes,2,rep,name=entries" json:"entries,omitempty"`
+	Meta    *xmc_srv_core_searchmeta.Meta `protobuf:"bytes,3,opt,name=meta" json:"meta,omitempty"`
+	// true if the results of some submissions are hidden
+	Frozen bool `protobuf:"varint,4,opt,name=frozen" json:"frozen,omitempty"`
+}
+
+func (m *GetScoreboardResponse) Reset()                    { *m = GetScoreboardResponse{} }
+func (m *GetScoreboardResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetScoreboardResponse) ProtoMessage()               {}
+func (*GetScoreboardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GetScoreboardResponse) GetTasks() []*ScoreboardTask {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *GetScoreboardResponse) GetEntries() []*ScoreboardEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *GetScoreboardResponse) GetMeta() *xmc_srv_core_searchmeta.Meta {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *GetScoreboardResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type RevealScoreboardRequest struct {
+	TaskListId string `protobuf:"bytes,1,opt,name=task_list_id,json=taskListId" json:"task_list_id,omitempty"`
+	// how much the revealed time moves forward. If it is zero all the results are revealed.
+	Step *google_protobuf.Duration `protobuf:"bytes,2,opt,name=step" json:"step,omitempty"`
+}
+
+func (m *RevealScoreboardRequest) Reset()                    { *m = RevealScoreboardRequest{} }
+func (m *RevealScoreboardRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevealScoreboardRequest) ProtoMessage()               {}
+func (*RevealScoreboardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *RevealScoreboardRequest) GetTaskListId() string {
+	if m != nil {
+		return m.TaskListId
+	}
+	return ""
+}
+
+func (m *RevealScoreboardRequest) GetStep() *google_protobuf.Duration {
+	if m != nil {
+		return m.Step
+	}
+	return nil
+}
+
+type RevealScoreboardResponse struct {
+	RevealedUntil *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=revealed_until,json=revealedUntil" json:"revealed_until,omitempty"`
+}
+
+func (m *RevealScoreboardResponse) Reset()                    { *m = RevealScoreboardResponse{} }
+func (m *RevealScoreboardResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevealScoreboardResponse) ProtoMessage()               {}
+func (*RevealScoreboardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *RevealScoreboardResponse) GetRevealedUntil() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.RevealedUntil
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskList)(nil), "xmc.srv.core.tasklist.TaskList")
 	proto.RegisterType((*CreateRequest)(nil), "xmc.srv.core.tasklist.CreateRequest")
@@ -546,6 +1173,21 @@ func init() {
 	proto.RegisterType((*CancelParticipationResponse)(nil), "xmc.srv.core.tasklist.CancelParticipationResponse")
 	proto.RegisterType((*GetParticipantsRequest)(nil), "xmc.srv.core.tasklist.GetParticipantsRequest")
 	proto.RegisterType((*GetParticipantsResponse)(nil), "xmc.srv.core.tasklist.GetParticipantsResponse")
+	proto.RegisterType((*Participant)(nil), "xmc.srv.core.tasklist.Participant")
+	proto.RegisterType((*Participation)(nil), "xmc.srv.core.tasklist.Participation")
+	proto.RegisterType((*StartParticipationRequest)(nil), "xmc.srv.core.tasklist.StartParticipationRequest")
+	proto.RegisterType((*StartParticipationResponse)(nil), "xmc.srv.core.tasklist.StartParticipationResponse")
+	proto.RegisterType((*ReadParticipationRequest)(nil), "xmc.srv.core.tasklist.ReadParticipationRequest")
+	proto.RegisterType((*ReadParticipationResponse)(nil), "xmc.srv.core.tasklist.ReadParticipationResponse")
+	proto.RegisterType((*ScoringModeValue)(nil), "xmc.srv.core.tasklist.ScoringModeValue")
+	proto.RegisterType((*ScoreboardTask)(nil), "xmc.srv.core.tasklist.ScoreboardTask")
+	proto.RegisterType((*TaskScore)(nil), "xmc.srv.core.tasklist.TaskScore")
+	proto.RegisterType((*ScoreboardEntry)(nil), "xmc.srv.core.tasklist.ScoreboardEntry")
+	proto.RegisterType((*GetScoreboardRequest)(nil), "xmc.srv.core.tasklist.GetScoreboardRequest")
+	proto.RegisterType((*GetScoreboardResponse)(nil), "xmc.srv.core.tasklist.GetScoreboardResponse")
+	proto.RegisterType((*RevealScoreboardRequest)(nil), "xmc.srv.core.tasklist.RevealScoreboardRequest")
+	proto.RegisterType((*RevealScoreboardResponse)(nil), "xmc.srv.core.tasklist.RevealScoreboardResponse")
+	proto.RegisterEnum("xmc.srv.core.tasklist.ScoringMode", ScoringMode_name, ScoringMode_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -565,6 +1207,10 @@ type TaskListServiceClient interface {
 	Participate(ctx context.Context, in *ParticipateRequest, opts ...client.CallOption) (*ParticipateResponse, error)
 	CancelParticipation(ctx context.Context, in *CancelParticipationRequest, opts ...client.CallOption) (*CancelParticipationResponse, error)
 	GetParticipants(ctx context.Context, in *GetParticipantsRequest, opts ...client.CallOption) (*GetParticipantsResponse, error)
+	StartParticipation(ctx context.Context, in *StartParticipationRequest, opts ...client.CallOption) (*StartParticipationResponse, error)
+	ReadParticipation(ctx context.Context, in *ReadParticipationRequest, opts ...client.CallOption) (*ReadParticipationResponse, error)
+	GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...client.CallOption) (*GetScoreboardResponse, error)
+	RevealScoreboard(ctx context.Context, in *RevealScoreboardRequest, opts ...client.CallOption) (*RevealScoreboardResponse, error)
 }
 
 type taskListServiceClient struct {
@@ -675,6 +1321,46 @@ func (c *taskListServiceClient) GetParticipants(ctx context.Context, in *GetPart
 	return out, nil
 }
 
+func (c *taskListServiceClient) StartParticipation(ctx context.Context, in *StartParticipationRequest, opts ...client.CallOption) (*StartParticipationResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "TaskListService.StartParticipation", in)
+	out := new(StartParticipationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) ReadParticipation(ctx context.Context, in *ReadParticipationRequest, opts ...client.CallOption) (*ReadParticipationResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "TaskListService.ReadParticipation", in)
+	out := new(ReadParticipationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...client.CallOption) (*GetScoreboardResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "TaskListService.GetScoreboard", in)
+	out := new(GetScoreboardResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskListServiceClient) RevealScoreboard(ctx context.Context, in *RevealScoreboardRequest, opts ...client.CallOption) (*RevealScoreboardResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "TaskListService.RevealScoreboard", in)
+	out := new(RevealScoreboardResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TaskListService service
 
 type TaskListServiceHandler interface {
@@ -687,6 +1373,10 @@ type TaskListServiceHandler interface {
 	Participate(context.Context, *ParticipateRequest, *ParticipateResponse) error
 	CancelParticipation(context.Context, *CancelParticipationRequest, *CancelParticipationResponse) error
 	GetParticipants(context.Context, *GetParticipantsRequest, *GetParticipantsResponse) error
+	StartParticipation(context.Context, *StartParticipationRequest, *StartParticipationResponse) error
+	ReadParticipation(context.Context, *ReadParticipationRequest, *ReadParticipationResponse) error
+	GetScoreboard(context.Context, *GetScoreboardRequest, *GetScoreboardResponse) error
+	RevealScoreboard(context.Context, *RevealScoreboardRequest, *RevealScoreboardResponse) error
 }
 
 func RegisterTaskListServiceHandler(s server.Server, hdlr TaskListServiceHandler, opts ...server.HandlerOption) {
@@ -733,68 +1423,137 @@ func (h *TaskListService) GetParticipants(ctx context.Context, in *GetParticipan
 	return h.TaskListServiceHandler.GetParticipants(ctx, in, out)
 }
 
+func (h *TaskListService) StartParticipation(ctx context.Context, in *StartParticipationRequest, out *StartParticipationResponse) error {
+	return h.TaskListServiceHandler.StartParticipation(ctx, in, out)
+}
+
+func (h *TaskListService) ReadParticipation(ctx context.Context, in *ReadParticipationRequest, out *ReadParticipationResponse) error {
+	return h.TaskListServiceHandler.ReadParticipation(ctx, in, out)
+}
+
+func (h *TaskListService) GetScoreboard(ctx context.Context, in *GetScoreboardRequest, out *GetScoreboardResponse) error {
+	return h.TaskListServiceHandler.GetScoreboard(ctx, in, out)
+}
+
+func (h *TaskListService) RevealScoreboard(ctx context.Context, in *RevealScoreboardRequest, out *RevealScoreboardResponse) error {
+	return h.TaskListServiceHandler.RevealScoreboard(ctx, in, out)
+}
+
 func init() {
 	proto.RegisterFile("github.com/xmc-dev/xmc/xmc-core/proto/tasklist/tasklist.proto", fileDescriptor0)
 }
 
 var fileDescriptor0 = []byte{
-	// 921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xee, 0xfe, 0x64, 0xe3, 0x3d, 0xce, 0xa6, 0x30, 0xe9, 0x8f, 0x31, 0x0a, 0x5d, 0x4c, 0x91,
-	0x02, 0x52, 0xbc, 0xea, 0x82, 0xb8, 0x00, 0x5a, 0x41, 0x83, 0x14, 0x45, 0xfd, 0x51, 0x71, 0x0a,
-	0x95, 0xb8, 0xb1, 0x66, 0xed, 0x93, 0xcd, 0xa8, 0xfe, 0xc3, 0x33, 0x4e, 0x7b, 0xc5, 0x0d, 0xcf,
-	0xc2, 0x3b, 0xf0, 0x50, 0x5c, 0xf2, 0x00, 0xc8, 0x63, 0x8f, 0x77, 0xbd, 0xdd, 0x69, 0x96, 0x92,
-	0x0b, 0xae, 0x3c, 0x33, 0xe7, 0x9c, 0xef, 0x78, 0xce, 0xf7, 0x9d, 0x33, 0x70, 0x7f, 0xce, 0xc4,
-	0x79, 0x31, 0x73, 0x83, 0x34, 0x9e, 0xbc, 0x8e, 0x83, 0xc3, 0x10, 0x2f, 0xca, 0xaf, 0x5c, 0x07,
-	0x69, 0x8e, 0x93, 0x2c, 0x4f, 0x45, 0x3a, 0x11, 0x94, 0xbf, 0x8c, 0x18, 0x17, 0xcd, 0xc2, 0x95,
-	0xe7, 0xe4, 0xe6, 0xeb, 0x38, 0x70, 0x79, 0x7e, 0xe1, 0x96, 0xbe, 0xae, 0x32, 0xda, 0x1f, 0xcd,
-	0xd3, 0x74, 0x1e, 0xd5, 0xc1, 0xb3, 0xe2, 0x6c, 0xf2, 0x2a, 0xa7, 0x59, 0x86, 0x39, 0xaf, 0xc2,
-	0xec, 0x6f, 0x36, 0xcc, 0xca, 0x73, 0x9a, 0xcc, 0x51, 0x7d, 0xeb, 0xe0, 0xef, 0x37, 0x0b, 0xe6,
-	0x48, 0xf3, 0xe0, 0x3c, 0x46, 0x41, 0x97, 0x96, 0x15, 0x84, 0xf3, 0x67, 0x17, 0x8c, 0xe7, 0x94,
-	0xbf, 0x7c, 0xcc, 0xb8, 0x20, 0xbb, 0xd0, 0x65, 0xa1, 0xd5, 0x19, 0x77, 0x0e, 0x86, 0x5e, 0x97,
-	0x85, 0x84, 0x40, 0x3f, 0xa1, 0x31, 0x5a, 0x5d, 0x79, 0x22, 0xd7, 0x64, 0x0c, 0x66, 0x88, 0x3c,
-	0xc8, 0x59, 0x26, 0x58, 0x9a, 0x58, 0x3d, 0x69, 0x5a, 0x3e, 0x22, 0x47, 0x00, 0x82, 0xc5, 0xe8,
-	0xcb, 0x3f, 0xb5, 0xfa, 0xe3, 0xce, 0x81, 0x39, 0xbd, 0xeb, 0xb6, 0xcb, 0x53, 0x5f, 0xe3, 0x39,
-	0x8b, 0x91, 0x0b, 0x1a, 0x67, 0x5e, 0xb9, 0xf5, 0x86, 0x65, 0x9c, 0x5c, 0x92, 0xdb, 0xb0, 0x9d,
-	0xd1, 0x39, 0xfa, 0x2c, 0xb4, 0xb6, 0x64, 0x8a, 0x41, 0xb9, 0x3d, 0x09, 0xc9, 0x0d, 0xd8, 0x12,
-	0x4c, 0x44, 0x68, 0x0d, 0xe4, 0x71, 0xb5, 0x21, 0x87, 0x40, 0xb2, 0x62, 0x16, 0xb1, 0xc0, 0xe7,
-	0xc5, 0x2c, 0x66, 0x9c, 0xb3, 0x34, 0xe1, 0xd6, 0xf6, 0xb8, 0x73, 0x60, 0x78, 0xef, 0x57, 0x96,
-	0xd3, 0x85, 0xa1, 0xbc, 0x58, 0x46, 0xc5, 0xb9, 0x65, 0x54, 0x17, 0x2b, 0xd7, 0x64, 0x02, 0x7b,
-	0xaf, 0x98, 0x38, 0xf7, 0x33, 0x9a, 0x0b, 0x16, 0xb0, 0x8c, 0x0a, 0x89, 0x31, 0x94, 0x18, 0xa4,
-	0x34, 0x3d, 0x6b, 0x59, 0x9c, 0x27, 0x30, 0x3a, 0xca, 0x91, 0x0a, 0xf4, 0xf0, 0xd7, 0x02, 0xb9,
-	0x20, 0xdf, 0xc2, 0xb0, 0xe4, 0xdd, 0x2f, 0x89, 0x97, 0x55, 0x34, 0xa7, 0x77, 0xdc, 0xb5, 0xb2,
-	0x70, 0x55, 0xc9, 0x3d, 0x43, 0xd4, 0x2b, 0x67, 0x0c, 0xbb, 0x0a, 0x8e, 0x67, 0x69, 0xc2, 0x71,
-	0x95, 0x0e, 0x67, 0x1f, 0x4c, 0x0f, 0x69, 0xa8, 0xd2, 0xad, 0x9a, 0x1f, 0xc3, 0x4e, 0x65, 0xae,
-	0xc3, 0xff, 0xeb, 0xef, 0xc0, 0x31, 0x0a, 0x95, 0x4b, 0x29, 0xa1, 0xb3, 0x50, 0x82, 0xf3, 0x08,
-	0x4c, 0xe9, 0x71, 0x25, 0xe9, 0xfe, 0xea, 0xc2, 0xe8, 0xa7, 0x2c, 0x5c, 0xaa, 0xe6, 0xff, 0x48,
-	0x8c, 0x0e, 0x8c, 0x38, 0x0a, 0x3f, 0x29, 0xa2, 0xc8, 0x2f, 0x4f, 0xa5, 0x24, 0x0d, 0xcf, 0xe4,
-	0x28, 0x9e, 0x16, 0x51, 0x54, 0x06, 0x6a, 0x74, 0x79, 0xa2, 0xd5, 0xa5, 0x39, 0xb5, 0xdd, 0x6a,
-	0x36, 0xb8, 0x6a, 0x36, 0xb8, 0x0f, 0xd3, 0x34, 0xfa, 0x99, 0x46, 0x05, 0xae, 0xd3, 0xec, 0xa3,
-	0xf5, 0xfa, 0x34, 0x2e, 0xc5, 0x5a, 0xa7, 0xdd, 0xf7, 0x60, 0x57, 0x55, 0xbb, 0xa2, 0xcf, 0xf9,
-	0x0e, 0x46, 0x3f, 0x60, 0x84, 0xfa, 0xfa, 0xdf, 0x01, 0x33, 0x42, 0x7a, 0x81, 0x7e, 0xc9, 0x19,
-	0x97, 0x34, 0x18, 0x1e, 0xc8, 0xa3, 0x92, 0x4f, 0x89, 0xa9, 0x10, 0x6a, 0xcc, 0x3f, 0x7a, 0x30,
-	0x3a, 0x95, 0x13, 0x47, 0x81, 0xde, 0x80, 0xad, 0x88, 0xc5, 0xac, 0x12, 0xc8, 0xc8, 0xab, 0x36,
-	0xe4, 0x16, 0x0c, 0xd2, 0xb3, 0x33, 0x8e, 0x42, 0xa2, 0x8e, 0xbc, 0x7a, 0xd7, 0x50, 0xde, 0xd3,
-	0x53, 0xde, 0xbf, 0x8c, 0xf2, 0xad, 0x77, 0xa3, 0x7c, 0x3d, 0x9d, 0xf7, 0x61, 0x87, 0x71, 0x3f,
-	0xc3, 0x3c, 0xa6, 0x09, 0x26, 0x62, 0x03, 0x22, 0x4d, 0xc6, 0x9f, 0x29, 0x77, 0x8d, 0x1a, 0x8c,
-	0x2b, 0x54, 0xc3, 0xf0, 0x9d, 0xd4, 0xf0, 0x7b, 0x07, 0x76, 0x15, 0x4f, 0x75, 0x37, 0x3f, 0x00,
-	0x68, 0xba, 0x99, 0x5b, 0x9d, 0x71, 0x6f, 0x93, 0x76, 0x1e, 0xaa, 0x76, 0xe6, 0xe4, 0x1e, 0xf4,
-	0xcb, 0x57, 0x46, 0x12, 0x6a, 0x4e, 0xf7, 0xdb, 0x91, 0x4b, 0xaf, 0xd0, 0x13, 0x14, 0xd4, 0x93,
-	0xae, 0xce, 0x57, 0x40, 0x16, 0xff, 0xd5, 0xc8, 0x70, 0x0c, 0x3b, 0xcd, 0x8f, 0xf8, 0x8d, 0x20,
-	0x41, 0x65, 0x3a, 0x09, 0x9d, 0x9b, 0xb0, 0xd7, 0x8a, 0xab, 0xc5, 0xf7, 0x00, 0xec, 0x23, 0x9a,
-	0x04, 0x18, 0xb5, 0x2e, 0xbb, 0x39, 0xec, 0x3e, 0x7c, 0xb8, 0x36, 0xbe, 0x86, 0xff, 0x1a, 0x6e,
-	0x1d, 0xa3, 0x68, 0x6c, 0x89, 0xe0, 0x9b, 0x43, 0x7f, 0x09, 0xb7, 0xdf, 0x88, 0xad, 0xeb, 0xfe,
-	0x01, 0x18, 0x05, 0xc7, 0xdc, 0x67, 0x61, 0x55, 0xf5, 0xa1, 0xb7, 0x5d, 0xee, 0x4f, 0x42, 0x3e,
-	0xfd, 0x7b, 0x00, 0xd7, 0x55, 0xa9, 0x4f, 0x31, 0xbf, 0x60, 0x01, 0x92, 0x17, 0x30, 0xa8, 0x1e,
-	0x0d, 0x72, 0x57, 0x43, 0x4e, 0xeb, 0x89, 0xb2, 0x3f, 0xbd, 0xc4, 0xab, 0xbe, 0xdc, 0x35, 0xf2,
-	0x23, 0xf4, 0xcb, 0xc7, 0x84, 0x38, 0x9a, 0x80, 0xa5, 0x87, 0xc8, 0xfe, 0xe4, 0xad, 0x3e, 0x0d,
-	0xe4, 0x53, 0xe8, 0x1d, 0xa3, 0x20, 0x1f, 0x6b, 0xbc, 0x17, 0xaf, 0x8d, 0xed, 0xbc, 0xcd, 0xa5,
-	0xc1, 0x7b, 0x01, 0x83, 0x6a, 0x86, 0x69, 0xef, 0xde, 0x7a, 0x50, 0xb4, 0x77, 0x5f, 0x19, 0x84,
-	0x12, 0xb8, 0x1a, 0x64, 0x5a, 0xe0, 0xd6, 0xa4, 0xd4, 0x02, 0xaf, 0x4c, 0x43, 0x09, 0x5c, 0xb5,
-	0x99, 0x16, 0xb8, 0x35, 0x2d, 0xb5, 0xc0, 0xed, 0x5e, 0x75, 0xae, 0x91, 0x33, 0x30, 0x97, 0x5a,
-	0x80, 0x7c, 0xa6, 0x89, 0x7b, 0xb3, 0xbd, 0xec, 0xcf, 0x37, 0x71, 0x6d, 0xf2, 0xfc, 0x06, 0x7b,
-	0x6b, 0x7a, 0x82, 0xdc, 0xd3, 0xa9, 0x4a, 0xdb, 0x7f, 0xf6, 0xf4, 0xdf, 0x84, 0x34, 0xf9, 0x73,
-	0xb8, 0xbe, 0xd2, 0x38, 0xe4, 0x50, 0xaf, 0x95, 0x35, 0xcd, 0x69, 0xbb, 0x9b, 0xba, 0xab, 0x9c,
-	0x0f, 0xe1, 0x17, 0x43, 0x79, 0xcd, 0x06, 0x72, 0xa0, 0x7e, 0xf1, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x36, 0x8b, 0x80, 0x8d, 0x2c, 0x0c, 0x00, 0x00,
+	// 1767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdd, 0x72, 0xe4, 0x46,
+	0x15, 0x8e, 0xe6, 0xcf, 0x9a, 0xa3, 0xd1, 0xd8, 0xdb, 0xbb, 0x5e, 0x6b, 0x15, 0x96, 0x1d, 0x14,
+	0x0c, 0x4e, 0xc0, 0xe3, 0xac, 0x53, 0x50, 0xc0, 0x66, 0x97, 0x38, 0xce, 0xc6, 0x35, 0x9b, 0xdd,
+	0xc4, 0xc8, 0x5e, 0x42, 0x51, 0x50, 0x2a, 0x79, 0xd4, 0x1e, 0x8b, 0xd5, 0x48, 0x42, 0xdd, 0xe3,
+	0x38, 0x81, 0xe2, 0x86, 0x3b, 0xde, 0x83, 0x37, 0xe1, 0x01, 0xb8, 0xa6, 0xb8, 0x80, 0x07, 0xa1,
+	0x8a, 0xea, 0x6e, 0xfd, 0xce, 0x48, 0x33, 0xb2, 0x77, 0xa9, 0xe2, 0x4a, 0x7d, 0xba, 0xcf, 0x5f,
+	0x9f, 0x3e, 0xe7, 0xeb, 0xa3, 0x86, 0xc7, 0x13, 0x97, 0x5e, 0xcc, 0xce, 0x86, 0xe3, 0x60, 0xba,
+	0x77, 0x35, 0x1d, 0xef, 0x3a, 0xf8, 0x92, 0x7d, 0xf9, 0x78, 0x1c, 0x44, 0x78, 0x2f, 0x8c, 0x02,
+	0x1a, 0xec, 0x51, 0x9b, 0xbc, 0xf2, 0x5c, 0x42, 0xd3, 0xc1, 0x90, 0xcf, 0xa3, 0xcd, 0xab, 0xe9,
+	0x78, 0x48, 0xa2, 0xcb, 0x21, 0xe3, 0x1d, 0x26, 0x8b, 0xfa, 0xb7, 0x27, 0x41, 0x30, 0xf1, 0x62,
+	0xe1, 0xb3, 0xd9, 0xf9, 0x9e, 0x33, 0x8b, 0x6c, 0xea, 0x06, 0xbe, 0x10, 0xd3, 0x1f, 0xcc, 0xaf,
+	0x53, 0x77, 0x8a, 0x09, 0xb5, 0xa7, 0x61, 0xcc, 0xb0, 0xa0, 0xe0, 0xab, 0xc8, 0x0e, 0x43, 0x1c,
+	0x91, 0x78, 0xfd, 0x51, 0x4d, 0xb7, 0x49, 0x64, 0xfb, 0x13, 0x9c, 0x7c, 0x63, 0xe1, 0x83, 0x7a,
+	0xc2, 0x04, 0xdb, 0xd1, 0xf8, 0x62, 0x8a, 0xa9, 0x9d, 0x1b, 0x0a, 0x15, 0xc6, 0x3f, 0xda, 0x20,
+	0x9f, 0xda, 0xe4, 0xd5, 0x73, 0x97, 0x50, 0xd4, 0x87, 0x86, 0xeb, 0x68, 0xd2, 0x40, 0xda, 0xe9,
+	0x9a, 0x0d, 0xd7, 0x41, 0x08, 0x5a, 0xbe, 0x3d, 0xc5, 0x5a, 0x83, 0xcf, 0xf0, 0x31, 0x1a, 0x80,
+	0xe2, 0x60, 0x32, 0x8e, 0xdc, 0x90, 0x85, 0x41, 0x6b, 0xf2, 0xa5, 0xfc, 0x14, 0x3a, 0x04, 0x60,
+	0x51, 0xb0, 0xb8, 0xa7, 0x5a, 0x6b, 0x20, 0xed, 0x28, 0xfb, 0xdf, 0x1d, 0x16, 0xe3, 0x1b, 0x6f,
+	0xe3, 0x34, 0x89, 0x96, 0xc9, 0x48, 0xb3, 0xcb, 0xe4, 0xf8, 0x10, 0x6d, 0xc1, 0x5a, 0x68, 0x4f,
+	0xb0, 0xe5, 0x3a, 0x5a, 0x9b, 0x9b, 0xe8, 0x30, 0x72, 0xe4, 0xa0, 0x3b, 0xd0, 0xa6, 0x2e, 0xf5,
+	0xb0, 0xd6, 0xe1, 0xd3, 0x82, 0x40, 0xbb, 0x80, 0xc2, 0xd9, 0x99, 0xe7, 0x8e, 0x2d, 0x32, 0x3b,
+	0x9b, 0xba, 0x84, 0xb8, 0x81, 0x4f, 0xb4, 0xb5, 0x81, 0xb4, 0x23, 0x9b, 0xb7, 0xc4, 0xca, 0x49,
+	0xb6, 0xc0, 0x36, 0x16, 0xda, 0xf4, 0x42, 0x93, 0xc5, 0xc6, 0xd8, 0x18, 0xed, 0xc1, 0xed, 0xaf,
+	0x5c, 0x7a, 0x61, 0x85, 0x76, 0x44, 0xdd, 0xb1, 0x1b, 0xf2, 0x63, 0x26, 0x5a, 0x97, 0xeb, 0x40,
+	0x6c, 0xe9, 0xb8, 0xb0, 0x82, 0x9e, 0x42, 0x8f, 0x8c, 0x83, 0xc8, 0xf5, 0x27, 0xd6, 0x34, 0x70,
+	0xb0, 0x06, 0x03, 0x69, 0xa7, 0xbf, 0x6f, 0x0c, 0x4b, 0x33, 0x69, 0x78, 0x22, 0x58, 0x5f, 0x04,
+	0x0e, 0x36, 0x15, 0x92, 0x11, 0xe8, 0x11, 0x28, 0xe7, 0x11, 0xc6, 0xdf, 0x60, 0x8b, 0xed, 0x5e,
+	0x53, 0x78, 0xbc, 0xf4, 0xa1, 0xc8, 0x9b, 0x61, 0x92, 0x37, 0xb9, 0x50, 0x81, 0x60, 0x67, 0x13,
+	0xe8, 0x00, 0xfa, 0x11, 0xbe, 0xc4, 0xb6, 0x87, 0x1d, 0x6b, 0xe6, 0x53, 0xd7, 0xd3, 0x7a, 0x2b,
+	0xe5, 0xd5, 0x44, 0xe2, 0x25, 0x13, 0x40, 0xc7, 0x70, 0xb7, 0xb0, 0x65, 0x2b, 0x49, 0x71, 0x4d,
+	0xe5, 0xaa, 0xee, 0x2d, 0xa8, 0xfa, 0x24, 0x66, 0x30, 0x37, 0x0b, 0x82, 0xc9, 0x34, 0xfa, 0x0d,
+	0x6c, 0x45, 0x78, 0xe2, 0x12, 0x2a, 0x68, 0x2b, 0x97, 0x0d, 0xfd, 0x6b, 0x64, 0xc3, 0x66, 0x5e,
+	0xc9, 0x69, 0x9a, 0x19, 0xef, 0xc2, 0xc6, 0xd4, 0xbe, 0xca, 0x8e, 0xc9, 0xa7, 0x44, 0x5b, 0x1f,
+	0x48, 0x3b, 0xaa, 0xb9, 0x3e, 0xb5, 0xaf, 0x8e, 0x73, 0xd3, 0xc6, 0x0b, 0x50, 0x0f, 0x23, 0x6c,
+	0x53, 0x6c, 0xe2, 0xdf, 0xcf, 0x30, 0xa1, 0xe8, 0x43, 0xe8, 0xb2, 0x03, 0xb1, 0xd8, 0x89, 0xf0,
+	0x3c, 0x57, 0xf6, 0x1f, 0x54, 0x9c, 0x57, 0x52, 0x14, 0xa6, 0x4c, 0xe3, 0x91, 0x31, 0x80, 0x7e,
+	0xa2, 0x8e, 0x84, 0x81, 0x4f, 0xf0, 0x7c, 0xc1, 0x18, 0xf7, 0x41, 0x31, 0xb1, 0xed, 0x24, 0xe6,
+	0xe6, 0x97, 0x9f, 0x43, 0x4f, 0x2c, 0xc7, 0xe2, 0xaf, 0xeb, 0x0e, 0x1c, 0x61, 0x9a, 0xd8, 0x4a,
+	0x6a, 0x55, 0xca, 0x6a, 0xd5, 0xf8, 0x0c, 0x14, 0xce, 0xf1, 0x46, 0xcc, 0xfd, 0xb3, 0x03, 0xea,
+	0xcb, 0xd0, 0xc9, 0x45, 0xf3, 0xff, 0x08, 0x2e, 0x0c, 0x50, 0x09, 0xa6, 0x96, 0x3f, 0xf3, 0x3c,
+	0x51, 0x46, 0x6d, 0x5e, 0xb6, 0x0a, 0xc1, 0xf4, 0xf3, 0x99, 0xe7, 0xf1, 0x5a, 0x29, 0x47, 0x8e,
+	0x51, 0x25, 0x72, 0x94, 0x55, 0xd1, 0xc7, 0x41, 0xe0, 0xfd, 0xd2, 0xf6, 0x66, 0xb8, 0x0c, 0x55,
+	0x3e, 0x2b, 0x47, 0x10, 0x79, 0xa5, 0xae, 0x32, 0x74, 0x79, 0x36, 0x87, 0x2e, 0x5d, 0xae, 0xe5,
+	0xfb, 0xab, 0xd1, 0x45, 0xa8, 0x5c, 0x06, 0x31, 0x70, 0x2d, 0x88, 0xd9, 0x83, 0x3b, 0x69, 0x68,
+	0xe7, 0x81, 0x4a, 0x36, 0x6f, 0xc5, 0x11, 0xfe, 0x34, 0x13, 0xa8, 0x06, 0x94, 0xde, 0x9b, 0x07,
+	0x14, 0xf5, 0xf5, 0x01, 0xe5, 0x11, 0xe8, 0xe9, 0x06, 0x17, 0xcc, 0x70, 0xc4, 0x92, 0xcd, 0xad,
+	0x78, 0x9b, 0xe6, 0x9c, 0x06, 0x74, 0x54, 0x81, 0x46, 0xca, 0xfe, 0xb7, 0x16, 0xb6, 0xf9, 0x72,
+	0xe4, 0xd3, 0x0f, 0xf6, 0xc5, 0xf9, 0x2c, 0x60, 0xd5, 0x06, 0xf4, 0x93, 0xea, 0x12, 0xe5, 0x6a,
+	0x7c, 0x04, 0xea, 0x27, 0xd8, 0xc3, 0xd5, 0xf5, 0xf6, 0x00, 0x14, 0x0f, 0xdb, 0x97, 0xd8, 0x62,
+	0x69, 0x40, 0x78, 0xd9, 0xc9, 0x26, 0xf0, 0x29, 0x56, 0xbf, 0x5c, 0x67, 0xa2, 0x21, 0xd6, 0xf9,
+	0xd7, 0x26, 0xa8, 0x27, 0xbc, 0x07, 0x48, 0x94, 0xde, 0x81, 0xb6, 0xe7, 0x4e, 0x5d, 0x01, 0x08,
+	0xaa, 0x29, 0x08, 0x74, 0x17, 0x3a, 0xc1, 0xf9, 0x39, 0xc1, 0x94, 0x6b, 0x55, 0xcd, 0x98, 0x4a,
+	0x4b, 0xbc, 0x59, 0x5d, 0xe2, 0xad, 0x55, 0x25, 0xde, 0xbe, 0x59, 0x89, 0x97, 0x97, 0xef, 0x63,
+	0xe8, 0xb9, 0xc4, 0x0a, 0x71, 0x34, 0xb5, 0x7d, 0xec, 0xd3, 0x1a, 0x85, 0xab, 0xb8, 0xe4, 0x38,
+	0x61, 0xaf, 0xa8, 0x7e, 0xf9, 0x0d, 0x56, 0x7f, 0xf7, 0x26, 0xd5, 0x6f, 0xfc, 0x59, 0x82, 0x7e,
+	0x72, 0x4e, 0x31, 0x7a, 0x3f, 0x01, 0x48, 0xd1, 0x9b, 0x68, 0xd2, 0xa0, 0x59, 0x07, 0xbe, 0xbb,
+	0x09, 0x7c, 0x13, 0xf4, 0x10, 0x5a, 0x53, 0x4c, 0x6d, 0x7e, 0xa0, 0xca, 0xfe, 0xfd, 0xa2, 0x64,
+	0xae, 0x2f, 0x7c, 0x81, 0xa9, 0x6d, 0x72, 0x56, 0xe3, 0xc7, 0x80, 0x32, 0xbf, 0xd2, 0x34, 0x1c,
+	0x40, 0x2f, 0x75, 0xc4, 0x4a, 0x13, 0x12, 0x12, 0x4b, 0x23, 0xc7, 0xd8, 0x84, 0xdb, 0x05, 0xb9,
+	0x38, 0xf9, 0x9e, 0x80, 0x7e, 0x68, 0xfb, 0x63, 0xec, 0x15, 0x36, 0x5b, 0x5f, 0xed, 0x7d, 0x78,
+	0xbb, 0x54, 0x3e, 0x56, 0xff, 0x33, 0xb8, 0x7b, 0x84, 0x69, 0xbe, 0xa8, 0xea, 0xab, 0xfe, 0x23,
+	0x6c, 0x2d, 0xc8, 0xc6, 0x71, 0xbf, 0x07, 0xf2, 0x8c, 0xe0, 0xc8, 0x72, 0x1d, 0x11, 0xf5, 0xae,
+	0xb9, 0xc6, 0xe8, 0x91, 0x43, 0xd0, 0xa7, 0xd0, 0x2b, 0x14, 0x7e, 0x83, 0x1f, 0x4a, 0x55, 0x07,
+	0x98, 0xd3, 0x6e, 0x16, 0xe4, 0x8c, 0xbf, 0x34, 0x40, 0xc9, 0xad, 0xb2, 0xe6, 0x37, 0x36, 0x19,
+	0xbb, 0xda, 0x11, 0x16, 0xd1, 0xdb, 0xd0, 0x1d, 0x7b, 0x2e, 0xf6, 0xf9, 0x2e, 0xc4, 0x35, 0x2b,
+	0x8b, 0x89, 0x91, 0x53, 0x5a, 0x9b, 0x3f, 0x07, 0x55, 0x40, 0x1a, 0x8e, 0xb0, 0x63, 0xd9, 0x54,
+	0x6b, 0x55, 0xa4, 0x63, 0x56, 0x77, 0xbd, 0x4c, 0xe0, 0x80, 0xa2, 0x9f, 0x02, 0x10, 0x6a, 0x47,
+	0x34, 0xbb, 0x55, 0x97, 0x4b, 0x77, 0x39, 0x37, 0xa3, 0xd1, 0x8f, 0x40, 0xc6, 0xbe, 0x23, 0x04,
+	0x3b, 0x2b, 0x05, 0xd7, 0xb0, 0xef, 0x30, 0xca, 0xf8, 0x8f, 0x04, 0x6a, 0xe1, 0x80, 0x57, 0x1f,
+	0x5f, 0x3e, 0x60, 0x8d, 0x42, 0xc0, 0x8a, 0xee, 0x37, 0x6f, 0xea, 0x7e, 0xab, 0xb6, 0xfb, 0x8b,
+	0x11, 0x6f, 0x5f, 0x2f, 0xe2, 0xc6, 0x63, 0xb8, 0x77, 0xc2, 0x9c, 0xb8, 0x61, 0x91, 0x5c, 0x80,
+	0x5e, 0x26, 0x1e, 0x27, 0xf3, 0x33, 0x50, 0x0b, 0xf8, 0xa4, 0x49, 0xa5, 0x60, 0xbc, 0x90, 0xb2,
+	0x5c, 0x49, 0x51, 0xd4, 0xf8, 0x10, 0x34, 0xd6, 0xcd, 0xde, 0xd0, 0xcf, 0x09, 0xdc, 0x2b, 0x91,
+	0xfe, 0x1f, 0xb8, 0xf9, 0x1c, 0x36, 0xe6, 0xbb, 0x23, 0xf4, 0x13, 0x68, 0x5f, 0xb2, 0x81, 0x26,
+	0xd5, 0xfe, 0x67, 0x13, 0x02, 0xc6, 0x33, 0xe8, 0xb3, 0x59, 0x7c, 0x16, 0xd8, 0x91, 0xc3, 0x60,
+	0xb6, 0x56, 0x17, 0x9c, 0xde, 0x5d, 0xcd, 0xdc, 0xdd, 0x65, 0xfc, 0x4d, 0x82, 0x2e, 0x53, 0xc1,
+	0x15, 0xb2, 0x1c, 0xe6, 0x21, 0xcb, 0x8a, 0x9e, 0x91, 0xe2, 0x8f, 0x97, 0x30, 0x8e, 0x58, 0xa3,
+	0x20, 0x90, 0x0e, 0xb2, 0x4d, 0x29, 0x9e, 0x86, 0x94, 0x70, 0xad, 0xaa, 0x99, 0xd2, 0x68, 0x17,
+	0x5a, 0xb9, 0xb4, 0x5d, 0xd2, 0x6f, 0x71, 0x36, 0xf4, 0x0e, 0xa8, 0xd9, 0xed, 0x97, 0xfd, 0x71,
+	0xf7, 0xb2, 0xc9, 0x91, 0x83, 0x34, 0x58, 0x0b, 0xb1, 0xef, 0xb8, 0xfe, 0x84, 0x17, 0xb3, 0x6a,
+	0x26, 0xa4, 0xf1, 0x2f, 0x09, 0xd6, 0xb3, 0x98, 0x3c, 0xf5, 0x69, 0xf4, 0x35, 0x0b, 0x42, 0x64,
+	0xfb, 0xaf, 0xe2, 0xa6, 0x82, 0x8f, 0xab, 0x8b, 0x94, 0x45, 0x27, 0xa0, 0xb6, 0x97, 0x46, 0x87,
+	0x11, 0xd7, 0xdd, 0x84, 0xf0, 0xcf, 0xf6, 0xe8, 0xd7, 0x5a, 0x3b, 0xf5, 0x8f, 0x91, 0xe8, 0x00,
+	0x14, 0x1e, 0x58, 0x1e, 0x37, 0xa2, 0x75, 0x38, 0x48, 0x0f, 0x96, 0xdc, 0x9c, 0x7c, 0x33, 0x22,
+	0x59, 0xf9, 0x90, 0x18, 0x1e, 0xdc, 0x39, 0xc2, 0x34, 0xdb, 0x64, 0xed, 0x34, 0xcf, 0xda, 0xab,
+	0x46, 0x79, 0x7b, 0xd5, 0xcc, 0xb7, 0x57, 0xcf, 0x5a, 0x72, 0x6b, 0xa3, 0x6d, 0xfc, 0x5b, 0x82,
+	0xcd, 0x39, 0x73, 0x71, 0x5d, 0x3c, 0x82, 0xb6, 0xe8, 0xf5, 0xc4, 0xf5, 0xbf, 0xbd, 0x24, 0x6f,
+	0xb3, 0x0c, 0x35, 0x85, 0x0c, 0xfa, 0x08, 0xd6, 0xb0, 0x4f, 0x23, 0x17, 0x27, 0x17, 0xd5, 0xf7,
+	0x56, 0x8a, 0xf3, 0xc3, 0x34, 0x13, 0xb1, 0xb4, 0x85, 0x68, 0xd6, 0x6e, 0x21, 0xd8, 0x4e, 0xcf,
+	0xa3, 0xe0, 0x1b, 0x2c, 0xfa, 0x42, 0xd9, 0x8c, 0x29, 0xe3, 0x77, 0xb0, 0x65, 0xf2, 0x67, 0x88,
+	0x9b, 0x04, 0x75, 0x17, 0x5a, 0x84, 0xe2, 0x50, 0x6b, 0xac, 0x4c, 0x0d, 0xc6, 0x66, 0xfc, 0x16,
+	0xb4, 0x45, 0x5b, 0x71, 0x44, 0x17, 0x1f, 0x50, 0xa4, 0x6b, 0x3e, 0xa0, 0xbc, 0xf7, 0x04, 0x94,
+	0x1c, 0x50, 0xa0, 0x5b, 0xa0, 0x8e, 0xbe, 0x18, 0x59, 0x2f, 0x0e, 0x7e, 0x65, 0x9d, 0x1c, 0x7e,
+	0x61, 0x3e, 0xdd, 0x78, 0x0b, 0x21, 0xe8, 0xb3, 0xa9, 0xe7, 0x07, 0x27, 0xa7, 0xf1, 0x9c, 0x84,
+	0x64, 0x68, 0x8d, 0x0e, 0x8f, 0x0f, 0x37, 0x1a, 0xfb, 0x7f, 0x07, 0x58, 0x4f, 0x1a, 0xb6, 0x13,
+	0x1c, 0x5d, 0xba, 0x63, 0x8c, 0xbe, 0x84, 0x8e, 0x78, 0x6a, 0x40, 0x55, 0x98, 0x57, 0x78, 0xd8,
+	0xd0, 0xb7, 0x57, 0x70, 0xc5, 0x2d, 0xd2, 0x5b, 0xe8, 0x17, 0xd0, 0x62, 0xb0, 0x8b, 0xaa, 0x20,
+	0x2f, 0xf7, 0x7c, 0xa1, 0xbf, 0xb3, 0x94, 0x27, 0x55, 0xf9, 0x39, 0x34, 0x8f, 0x30, 0x45, 0xdf,
+	0xa9, 0xe0, 0xce, 0xde, 0x28, 0x74, 0x63, 0x19, 0x4b, 0xaa, 0xef, 0x4b, 0xe8, 0x88, 0x3f, 0xa1,
+	0xca, 0xbd, 0x17, 0x9e, 0x21, 0xf4, 0xed, 0x15, 0x5c, 0x79, 0xc5, 0xe2, 0x77, 0xa8, 0x52, 0x71,
+	0xe1, 0x7f, 0x4b, 0xdf, 0x5e, 0xc1, 0x95, 0x57, 0x2c, 0x9a, 0xf5, 0x4a, 0xc5, 0x85, 0x7f, 0x2e,
+	0x7d, 0x7b, 0x05, 0x57, 0xaa, 0xf8, 0x3c, 0xd7, 0x17, 0x52, 0x8c, 0xde, 0x5d, 0x79, 0xff, 0xa5,
+	0xbe, 0xbf, 0x57, 0x87, 0x35, 0xb5, 0xf3, 0x27, 0xb8, 0x5d, 0xd2, 0x59, 0xa3, 0x87, 0x55, 0x59,
+	0x55, 0xd9, 0xc5, 0xeb, 0xfb, 0xd7, 0x11, 0x49, 0xed, 0x47, 0xb0, 0x3e, 0xd7, 0x7e, 0xa3, 0xdd,
+	0xea, 0x5c, 0x29, 0x69, 0xf1, 0xf5, 0x61, 0x5d, 0xf6, 0xd4, 0xe6, 0x1f, 0x00, 0x2d, 0x36, 0x4a,
+	0xe8, 0xfd, 0xaa, 0xa3, 0xa9, 0x6a, 0xc9, 0xf4, 0x87, 0xd7, 0x90, 0x48, 0x8d, 0x5f, 0xc1, 0xad,
+	0x85, 0xee, 0x07, 0xed, 0x2d, 0xa9, 0xb7, 0x52, 0xd3, 0xef, 0xd7, 0x17, 0x48, 0x2d, 0x7b, 0xa0,
+	0x16, 0xee, 0x16, 0xf4, 0x83, 0xea, 0xc8, 0x2d, 0x60, 0xb3, 0xfe, 0xc3, 0x7a, 0xcc, 0xa9, 0xb5,
+	0x19, 0x6c, 0xcc, 0x43, 0x2f, 0x1a, 0x56, 0x7a, 0x5d, 0x7a, 0x1f, 0xe8, 0x7b, 0xb5, 0xf9, 0x13,
+	0xb3, 0x1f, 0xc3, 0xaf, 0xe5, 0x84, 0xed, 0xac, 0xc3, 0x11, 0xfc, 0x83, 0xff, 0x0e, 0x00, 0xd7,
+	0xce, 0xbf, 0xe2, 0x21, 0x1a, 0x00, 0x00,
 }
@@ -0,0 +1,207 @@
+// Package scoreboard ranks the participants of a task list by the scores of their submissions.
+package scoreboard
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Mode is the way the score of a participant on a task is computed from their submissions
+type Mode int
+
+const (
+	// MaxScore takes the best score of the submissions
+	MaxScore Mode = iota
+	// LastScore takes the score of the last submission
+	LastScore
+	// ICPC solves a task with the first accepted submission. Participants are ranked
+	// by the number of solved tasks and then by their penalty time.
+	ICPC
+)
+
+// ICPCPenalty is the penalty time of a rejected submission for a task that is solved afterwards
+const ICPCPenalty = 20 * time.Minute
+
+// Submission is an evaluated submission that counts for the scoreboard
+type Submission struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TaskID    uuid.UUID
+	CreatedAt time.Time
+	Score     decimal.Decimal
+	Accepted  bool
+	// Hidden is true if the result of the submission is hidden because the scoreboard is frozen
+	Hidden bool
+	// Invalid is true if the submission isn't evaluated yet, didn't compile or failed to be evaluated.
+	// Invalid submissions only count as pending while they are hidden.
+	Invalid bool
+}
+
+// TaskScore is the score of a participant on a task
+type TaskScore struct {
+	TaskID uuid.UUID
+	Score  decimal.Decimal
+	// Attempts is the number of submissions up to and including the one that got the score
+	Attempts int
+	// Time is the time from the start to the submission that got the score
+	Time time.Duration
+	// SubmissionID is the submission that got the score, uuid.Nil if there are no submissions
+	SubmissionID uuid.UUID
+	// Pending is the number of hidden submissions after the ones that count
+	Pending int
+}
+
+// Entry is the row of a participant in the scoreboard
+type Entry struct {
+	// Rank starts from 1. Participants that are tied have the same rank.
+	Rank   int
+	UserID uuid.UUID
+	Total  decimal.Decimal
+	// Time is the time of the last submission that got a task score, which is when
+	// the participant reached their total
+	Time time.Duration
+	// Penalty is the number of submissions made before the ones that got the task scores.
+	// In ICPC mode it is the penalty time in minutes.
+	Penalty int
+	// TaskScores are in the same order as the tasks given to Compute
+	TaskScores []*TaskScore
+}
+
+// Compute ranks the participants of a task list. The submissions must be sorted by creation time.
+//
+// If participants is nil, everybody who made a submission is ranked,
+// otherwise only the participants are, including the ones without submissions.
+// The times are measured from start or, if it is zero, from the first submission of each participant.
+// The participants in userStarts have their own start times instead.
+// Hidden submissions don't change the scores, they are only counted as pending whatever their result.
+// Invalid submissions that aren't hidden are ignored.
+//
+// Participants are ranked by their total score, then by their time and then by their penalty.
+// In ICPC mode the score of a task is 1 if it is solved and participants are ranked by their total,
+// then by their penalty. Participants with the same total and penalty have the same rank and are listed by their time.
+func Compute(mode Mode, taskIDs []uuid.UUID, participants []uuid.UUID, submissions []*Submission, start time.Time,
+	userStarts map[uuid.UUID]time.Time) []*Entry {
+	taskIndex := make(map[uuid.UUID]int)
+	for i, id := range taskIDs {
+		taskIndex[id] = i
+	}
+	entries := make(map[uuid.UUID]*Entry)
+	newEntry := func(userID uuid.UUID) *Entry {
+		e := &Entry{UserID: userID}
+		for _, id := range taskIDs {
+			e.TaskScores = append(e.TaskScores, &TaskScore{TaskID: id})
+		}
+		entries[userID] = e
+
+		return e
+	}
+	for _, p := range participants {
+		newEntry(p)
+	}
+
+	starts := make(map[uuid.UUID]time.Time)
+	attempts := make(map[*TaskScore]int)
+	for _, s := range submissions {
+		i, ok := taskIndex[s.TaskID]
+		if !ok || (s.Invalid && !s.Hidden) {
+			continue
+		}
+		e, ok := entries[s.UserID]
+		if !ok {
+			if participants != nil {
+				continue
+			}
+			e = newEntry(s.UserID)
+		}
+		if _, ok := starts[s.UserID]; !ok {
+			starts[s.UserID] = start
+			if us, ok := userStarts[s.UserID]; ok {
+				starts[s.UserID] = us
+			} else if start.IsZero() {
+				starts[s.UserID] = s.CreatedAt
+			}
+		}
+
+		ts := e.TaskScores[i]
+		if mode == ICPC && ts.Score.Sign() > 0 {
+			// the submissions after the accepted one don't count
+			continue
+		}
+		if s.Hidden {
+			ts.Pending++
+			continue
+		}
+		score := s.Score
+		if mode == ICPC {
+			score = decimal.Zero
+			if s.Accepted {
+				score = decimal.New(1, 0)
+			}
+		}
+		attempts[ts]++
+		if mode != MaxScore || ts.SubmissionID == uuid.Nil || score.GreaterThan(ts.Score) {
+			ts.Score = score
+			ts.Attempts = attempts[ts]
+			ts.Time = s.CreatedAt.Sub(starts[s.UserID])
+			ts.SubmissionID = s.ID
+		}
+	}
+
+	ranked := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		for _, ts := range e.TaskScores {
+			if ts.Score.Sign() <= 0 {
+				continue
+			}
+			e.Total = e.Total.Add(ts.Score)
+			if mode == ICPC {
+				e.Penalty += int((ts.Time + time.Duration(ts.Attempts-1)*ICPCPenalty) / time.Minute)
+			} else {
+				e.Penalty += ts.Attempts - 1
+			}
+			if ts.Time > e.Time {
+				e.Time = ts.Time
+			}
+		}
+		ranked = append(ranked, e)
+	}
+	rank(mode, ranked)
+
+	return ranked
+}
+
+// tied returns true if the participants of the entries must have the same rank.
+// In ICPC mode the time isn't used for ranking, only to order the participants that are tied.
+func tied(mode Mode, a, b *Entry) bool {
+	if mode == ICPC {
+		return a.Total.Equal(b.Total) && a.Penalty == b.Penalty
+	}
+	return a.Total.Equal(b.Total) && a.Time == b.Time && a.Penalty == b.Penalty
+}
+
+func rank(mode Mode, entries []*Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case !a.Total.Equal(b.Total):
+			return a.Total.GreaterThan(b.Total)
+		case mode == ICPC && a.Penalty != b.Penalty:
+			return a.Penalty < b.Penalty
+		case a.Time != b.Time:
+			return a.Time < b.Time
+		case a.Penalty != b.Penalty:
+			return a.Penalty < b.Penalty
+		}
+
+		return a.UserID.String() < b.UserID.String()
+	})
+	for i, e := range entries {
+		e.Rank = i + 1
+		if i > 0 && tied(mode, entries[i-1], e) {
+			e.Rank = entries[i-1].Rank
+		}
+	}
+}
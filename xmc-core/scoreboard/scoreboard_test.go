@@ -0,0 +1,269 @@
+package scoreboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	userA = uuid.Must(uuid.Parse("00000000-0000-0000-0000-00000000000a"))
+	userB = uuid.Must(uuid.Parse("00000000-0000-0000-0000-00000000000b"))
+	userC = uuid.Must(uuid.Parse("00000000-0000-0000-0000-00000000000c"))
+	task1 = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000001"))
+	task2 = uuid.Must(uuid.Parse("00000000-0000-0000-0000-000000000002"))
+
+	start = time.Date(2018, 8, 1, 10, 0, 0, 0, time.UTC)
+)
+
+// invalid returns a submission that didn't compile, made the given number of minutes after start
+func invalid(user, task uuid.UUID, minutes int, hidden bool) *Submission {
+	s := sub(user, task, minutes, 0, false, hidden)
+	s.Invalid = true
+
+	return s
+}
+
+// sub returns a submission made the given number of minutes after start
+func sub(user, task uuid.UUID, minutes int, score int64, accepted, hidden bool) *Submission {
+	return &Submission{
+		ID:        uuid.New(),
+		UserID:    user,
+		TaskID:    task,
+		CreatedAt: start.Add(time.Duration(minutes) * time.Minute),
+		Score:     decimal.New(score, 0),
+		Accepted:  accepted,
+		Hidden:    hidden,
+	}
+}
+
+type expectedEntry struct {
+	user    uuid.UUID
+	rank    int
+	total   int64
+	minutes int
+	penalty int
+	pending int
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         Mode
+		participants []uuid.UUID
+		submissions  []*Submission
+		start        time.Time
+		userStarts   map[uuid.UUID]time.Time
+		expected     []expectedEntry
+	}{
+		{
+			name: "max score",
+			mode: MaxScore,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 100, true, false),
+				sub(userA, task1, 20, 50, false, false),
+				sub(userA, task2, 30, 30, false, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 130, minutes: 30},
+			},
+		},
+		{
+			name: "last score overwrites better scores",
+			mode: LastScore,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 100, true, false),
+				sub(userA, task1, 20, 50, false, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 50, minutes: 20, penalty: 1},
+			},
+		},
+		{
+			name: "tied participants have the same rank",
+			mode: MaxScore,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 100, true, false),
+				sub(userB, task1, 10, 100, true, false),
+				sub(userC, task1, 5, 50, false, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 100, minutes: 10},
+				{user: userB, rank: 1, total: 100, minutes: 10},
+				{user: userC, rank: 3, total: 50, minutes: 5},
+			},
+		},
+		{
+			name: "time and penalty break ties",
+			mode: MaxScore,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 0, false, false),
+				sub(userA, task1, 20, 100, true, false),
+				sub(userB, task1, 20, 100, true, false),
+				sub(userC, task1, 15, 100, true, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userC, rank: 1, total: 100, minutes: 15},
+				{user: userB, rank: 2, total: 100, minutes: 20},
+				{user: userA, rank: 3, total: 100, minutes: 20, penalty: 1},
+			},
+		},
+		{
+			name: "icpc penalty",
+			mode: ICPC,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 0, false, false),
+				sub(userA, task1, 20, 0, false, false),
+				sub(userA, task1, 30, 100, true, false),
+				// after the accepted submission, doesn't count
+				sub(userA, task1, 40, 0, false, false),
+				// rejected submissions for unsolved tasks don't add penalty
+				sub(userA, task2, 50, 50, false, false),
+				sub(userB, task1, 90, 100, true, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 1, minutes: 30, penalty: 30 + 2*20},
+				{user: userB, rank: 2, total: 1, minutes: 90, penalty: 90},
+			},
+		},
+		{
+			name: "icpc ties ignore the time",
+			mode: ICPC,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 0, false, false),
+				sub(userA, task1, 20, 100, true, false),
+				sub(userB, task1, 40, 100, true, false),
+				sub(userC, task1, 30, 100, true, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userC, rank: 1, total: 1, minutes: 30, penalty: 30},
+				{user: userA, rank: 2, total: 1, minutes: 20, penalty: 20 + 20},
+				{user: userB, rank: 2, total: 1, minutes: 40, penalty: 40},
+			},
+		},
+		{
+			name: "hidden submissions are pending",
+			mode: MaxScore,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 50, false, false),
+				sub(userA, task1, 20, 100, true, true),
+				sub(userA, task2, 30, 100, true, true),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 50, minutes: 10, pending: 2},
+			},
+		},
+		{
+			name: "invalid submissions are pending only while hidden",
+			mode: ICPC,
+			submissions: []*Submission{
+				invalid(userA, task1, 10, false),
+				sub(userA, task1, 20, 100, true, false),
+				invalid(userA, task2, 30, true),
+				invalid(userB, task1, 10, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 1, minutes: 20, penalty: 20, pending: 1},
+			},
+		},
+		{
+			name: "hidden submissions after an accepted one aren't pending in icpc mode",
+			mode: ICPC,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 100, true, false),
+				sub(userA, task1, 20, 100, true, true),
+				sub(userA, task2, 30, 0, false, true),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 1, minutes: 10, penalty: 10, pending: 1},
+			},
+		},
+		{
+			name:         "only participants are ranked",
+			mode:         MaxScore,
+			participants: []uuid.UUID{userA, userC},
+			submissions: []*Submission{
+				sub(userA, task1, 10, 100, true, false),
+				sub(userB, task1, 10, 100, true, false),
+			},
+			start: start,
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 100, minutes: 10},
+				{user: userC, rank: 2},
+			},
+		},
+		{
+			name: "times from the first submission without a start",
+			mode: MaxScore,
+			submissions: []*Submission{
+				sub(userA, task1, 10, 50, false, false),
+				sub(userA, task2, 25, 50, false, false),
+			},
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 100, minutes: 15},
+			},
+		},
+		{
+			name: "per user start times",
+			mode: MaxScore,
+			submissions: []*Submission{
+				sub(userA, task1, 70, 100, true, false),
+				sub(userB, task1, 30, 100, true, false),
+			},
+			start: start,
+			userStarts: map[uuid.UUID]time.Time{
+				userA: start.Add(60 * time.Minute),
+			},
+			expected: []expectedEntry{
+				{user: userA, rank: 1, total: 100, minutes: 10},
+				{user: userB, rank: 2, total: 100, minutes: 30},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		entries := Compute(test.mode, []uuid.UUID{task1, task2}, test.participants, test.submissions, test.start,
+			test.userStarts)
+		if len(entries) != len(test.expected) {
+			t.Errorf("%s: expected %d entries, got %d", test.name, len(test.expected), len(entries))
+			continue
+		}
+		for i, ee := range test.expected {
+			e := entries[i]
+			pending := 0
+			for _, ts := range e.TaskScores {
+				pending += ts.Pending
+			}
+			if e.UserID != ee.user {
+				t.Errorf("%s: expected user %v at position %d, got %v", test.name, ee.user, i, e.UserID)
+				continue
+			}
+			if e.Rank != ee.rank {
+				t.Errorf("%s: expected rank %d for %v, got %d", test.name, ee.rank, ee.user, e.Rank)
+			}
+			if !e.Total.Equal(decimal.New(ee.total, 0)) {
+				t.Errorf("%s: expected total %d for %v, got %v", test.name, ee.total, ee.user, e.Total)
+			}
+			if e.Time != time.Duration(ee.minutes)*time.Minute {
+				t.Errorf("%s: expected time %dm for %v, got %v", test.name, ee.minutes, ee.user, e.Time)
+			}
+			if e.Penalty != ee.penalty {
+				t.Errorf("%s: expected penalty %d for %v, got %d", test.name, ee.penalty, ee.user, e.Penalty)
+			}
+			if pending != ee.pending {
+				t.Errorf("%s: expected %d pending for %v, got %d", test.name, ee.pending, ee.user, pending)
+			}
+		}
+	}
+}
@@ -27,6 +27,9 @@ type Service struct {
 
 	OAuth2Token string
 
+	// LanguagesFile is the path of the language registry file
+	LanguagesFile string
+
 	Debug bool
 }
 
@@ -110,6 +113,12 @@ func NewService() *Service {
 				EnvVar:      "CFG_TOKEN",
 				Destination: &s.OAuth2Token,
 			},
+			cli.StringFlag{
+				Name:        "languages_file",
+				EnvVar:      "CFG_LANGUAGES_FILE",
+				Usage:       "Path to the YAML file that declares the available programming languages. If not set, only C, C++ and Go are available",
+				Destination: &s.LanguagesFile,
+			},
 		),
 	)
 
@@ -27,7 +27,7 @@ func MakeAttachment(d *db.Datastore, req *pattachment.CreateRequest) (uuid.UUID,
 	if err != nil {
 		return uuid.Nil, err
 	}
-	err = d.SetAttachmentSize(id, int32(len(req.Contents)))
+	err = d.SetAttachmentContents(id, req.Contents)
 	if err != nil {
 		return uuid.Nil, err
 	}